@@ -2,6 +2,7 @@
 package adapter
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -21,12 +22,32 @@ type Database interface {
 	TableNames() ([]string, error)
 	DumpTableDDL(table string) (string, error)
 	Views() ([]string, error)
+	Triggers() ([]string, error)
 	DB() *sql.DB
 	Close() error
 }
 
+// Optionally implemented by databases having user-defined types
+type TypesDatabase interface {
+	Types() ([]string, error)
+}
+
+// Optionally implemented by databases enforcing foreign keys depending on the connection
+type ForeignKeysDatabase interface {
+	ForeignKeysEnabled() (bool, error)
+}
+
 func DumpDDLs(d Database) (string, error) {
-	ddls := []string{}
+	var ddls []string
+	// Types are dumped first since columns may refer to them
+	if typesDatabase, ok := d.(TypesDatabase); ok {
+		typeDDLs, err := typesDatabase.Types()
+		if err != nil {
+			return "", err
+		}
+		ddls = append(ddls, typeDDLs...)
+	}
+
 	tableNames, err := d.TableNames()
 	if err != nil {
 		return "", err
@@ -47,23 +68,58 @@ func DumpDDLs(d Database) (string, error) {
 	}
 	ddls = append(ddls, viewDDLs...)
 
+	// Triggers are dumped last since they may be on views and call functions
+	triggerDDLs, err := d.Triggers()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, triggerDDLs...)
+
 	return strings.Join(ddls, ";\n\n"), nil
 }
 
-func RunDDLs(d Database, ddls []string, skipDrop bool) error {
-	transaction, err := d.DB().Begin()
+// Run DDLs in a transaction. In-flight statement is cancelled when `ctx` is done.
+// DDLs which can't run in a transaction, like CREATE INDEX CONCURRENTLY, are run alone between transactions.
+// They run on a single connection, so that a setting of the connection like SQLite3 `PRAGMA foreign_keys` applies to the following transactions.
+func RunDDLs(ctx context.Context, d Database, ddls []string, skipped func(string) bool, nonTransactional func(string) bool) error {
+	conn, err := d.DB().Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	transaction, err := conn.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	fmt.Println("-- Apply --")
 	for _, ddl := range ddls {
-		if skipDrop && strings.Contains(ddl, "DROP") {
+		if skipped(ddl) {
 			fmt.Printf("-- Skipped: %s;\n", ddl)
 			continue
 		}
 		fmt.Printf("%s;\n", ddl)
-		if _, err := transaction.Exec(ddl); err != nil {
+		if nonTransactional(ddl) {
+			if err := transaction.Commit(); err != nil {
+				return err
+			}
+			_, err = conn.ExecContext(ctx, ddl)
+			if err == nil {
+				transaction, err = conn.BeginTx(ctx, nil)
+			}
+			if err != nil {
+				if ctx.Err() == context.DeadlineExceeded {
+					return fmt.Errorf("timeout exceeded while executing '%s': %s", ddl, err)
+				}
+				return err
+			}
+			continue
+		}
+		if _, err := transaction.ExecContext(ctx, ddl); err != nil {
 			transaction.Rollback()
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("timeout exceeded while executing '%s': %s", ddl, err)
+			}
 			return err
 		}
 	}
@@ -63,10 +63,18 @@ func (d *MssqlDatabase) DumpTableDDL(table string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return buildDumpTableDDL(table, cols, indexDefs, foreignDefs), nil
+	checkDefs, err := d.getTableCheckDefs(table)
+	if err != nil {
+		return "", err
+	}
+	comment, err := d.getTableComment(table)
+	if err != nil {
+		return "", err
+	}
+	return buildDumpTableDDL(table, cols, indexDefs, foreignDefs, checkDefs, comment), nil
 }
 
-func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, foreignDefs []string) string {
+func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, foreignDefs []string, checkDefs []string, comment *string) string {
 	var queryBuilder strings.Builder
 	fmt.Fprintf(&queryBuilder, "CREATE TABLE %s (", table)
 	for i, col := range columns {
@@ -74,6 +82,13 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 			fmt.Fprint(&queryBuilder, ",")
 		}
 		fmt.Fprint(&queryBuilder, "\n"+indent)
+		if col.ComputedDefinition != "" {
+			fmt.Fprintf(&queryBuilder, "%s AS %s", col.Name, col.ComputedDefinition)
+			if col.IsPersisted {
+				fmt.Fprint(&queryBuilder, " PERSISTED")
+			}
+			continue
+		}
 		fmt.Fprintf(&queryBuilder, "%s %s", col.Name, col.dataType)
 		if col.dataType == "char" || col.dataType == "varchar" || col.dataType == "binary" || col.dataType == "varbinary" {
 			fmt.Fprintf(&queryBuilder, "(%s)", col.Length)
@@ -96,6 +111,8 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 		fmt.Fprint(&queryBuilder, ",\n"+indent)
 		if indexDef.primary {
 			fmt.Fprintf(&queryBuilder, "CONSTRAINT [%s] PRIMARY KEY", indexDef.name)
+		} else if indexDef.uniqueConstraint {
+			fmt.Fprintf(&queryBuilder, "CONSTRAINT [%s] UNIQUE", indexDef.name)
 		} else {
 			fmt.Fprintf(&queryBuilder, "INDEX [%s]", indexDef.name)
 			if indexDef.unique {
@@ -105,7 +122,10 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 		if indexDef.indexType == "CLUSTERED" || indexDef.indexType == "NONCLUSTERED" {
 			fmt.Fprintf(&queryBuilder, " %s", indexDef.indexType)
 		}
-		fmt.Fprintf(&queryBuilder, " ([%s])", strings.Join(indexDef.columns, ", "))
+		fmt.Fprintf(&queryBuilder, " ([%s])", strings.Join(indexDef.columns, "], ["))
+		if len(indexDef.includedColumns) > 0 {
+			fmt.Fprintf(&queryBuilder, " INCLUDE ([%s])", strings.Join(indexDef.includedColumns, "], ["))
+		}
 		if len(indexDef.options) > 0 {
 			fmt.Fprint(&queryBuilder, " WITH (")
 			for i, option := range indexDef.options {
@@ -123,22 +143,40 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 		fmt.Fprint(&queryBuilder, v)
 	}
 
+	for _, v := range checkDefs {
+		fmt.Fprint(&queryBuilder, ",\n"+indent)
+		fmt.Fprint(&queryBuilder, v)
+	}
+
 	fmt.Fprintf(&queryBuilder, "\n);\n")
+
+	// Comments are extended properties, which are dumped like PostgreSQL's
+	if comment != nil {
+		fmt.Fprintf(&queryBuilder, "COMMENT ON TABLE %s IS '%s';\n", table, strings.ReplaceAll(*comment, "'", "''"))
+	}
+	for _, col := range columns {
+		if col.Comment != nil {
+			fmt.Fprintf(&queryBuilder, "COMMENT ON COLUMN %s.[%s] IS '%s';\n", table, col.Name, strings.ReplaceAll(*col.Comment, "'", "''"))
+		}
+	}
 	return strings.TrimSuffix(queryBuilder.String(), ";\n")
 }
 
 type column struct {
-	Name            string
-	dataType        string
-	Length          string
-	Nullable        bool
-	IsIdentity      bool
-	SeedValue       string
-	IncrementValue  string
-	DefaultName     string
-	DefaultVal      string
-	CheckName       string
-	CheckDefinition string
+	Name               string
+	dataType           string
+	Length             string
+	Nullable           bool
+	IsIdentity         bool
+	SeedValue          string
+	IncrementValue     string
+	DefaultName        string
+	DefaultVal         string
+	CheckName          string
+	CheckDefinition    string
+	ComputedDefinition string
+	IsPersisted        bool
+	Comment            *string
 }
 
 func (d *MssqlDatabase) getColumns(table string) ([]column, error) {
@@ -155,11 +193,18 @@ func (d *MssqlDatabase) getColumns(table string) ([]column, error) {
 	default_name = OBJECT_NAME(c.default_object_id),
 	default_definition = OBJECT_DEFINITION(c.default_object_id),
 	cc.name,
-	cc.definition
+	cc.definition,
+	cmp.definition,
+	is_persisted = ISNULL(cmp.is_persisted, 0),
+	comment = CAST(ep.value AS nvarchar(max))
 FROM sys.columns c WITH(NOLOCK)
 	JOIN sys.types tp WITH(NOLOCK) ON c.user_type_id = tp.user_type_id
 	LEFT JOIN sys.check_constraints cc WITH(NOLOCK) ON c.[object_id] = cc.parent_object_id
 		AND cc.parent_column_id = c.column_id
+	LEFT JOIN sys.computed_columns cmp WITH(NOLOCK) ON c.[object_id] = cmp.[object_id]
+		AND cmp.column_id = c.column_id
+	LEFT JOIN sys.extended_properties ep WITH(NOLOCK) ON ep.class = 1 AND ep.major_id = c.[object_id]
+		AND ep.minor_id = c.column_id AND ep.name = 'MS_Description'
 WHERE c.[object_id] = OBJECT_ID('%s.%s', 'U')`, schema, table)
 
 	rows, err := d.db.Query(query)
@@ -172,9 +217,9 @@ WHERE c.[object_id] = OBJECT_ID('%s.%s', 'U')`, schema, table)
 	for rows.Next() {
 		col := column{}
 		var colName, dataType, maxLen, defaultId string
-		var seedValue, incrementValue, defaultName, defaultVal, checkName, checkDefinition *string
-		var isNullable, isIdentity bool
-		err = rows.Scan(&colName, &dataType, &maxLen, &isNullable, &isIdentity, &seedValue, &incrementValue, &defaultId, &defaultName, &defaultVal, &checkName, &checkDefinition)
+		var seedValue, incrementValue, defaultName, defaultVal, checkName, checkDefinition, computedDefinition, comment *string
+		var isNullable, isIdentity, isPersisted bool
+		err = rows.Scan(&colName, &dataType, &maxLen, &isNullable, &isIdentity, &seedValue, &incrementValue, &defaultId, &defaultName, &defaultVal, &checkName, &checkDefinition, &computedDefinition, &isPersisted, &comment)
 		if err != nil {
 			return nil, err
 		}
@@ -195,18 +240,25 @@ WHERE c.[object_id] = OBJECT_ID('%s.%s', 'U')`, schema, table)
 			col.CheckName = *checkName
 			col.CheckDefinition = *checkDefinition
 		}
+		if computedDefinition != nil {
+			col.ComputedDefinition = *computedDefinition
+			col.IsPersisted = isPersisted
+		}
+		col.Comment = comment
 		cols = append(cols, col)
 	}
 	return cols, nil
 }
 
 type indexDef struct {
-	name      string
-	columns   []string
-	primary   bool
-	unique    bool
-	indexType string
-	options   []indexOption
+	name             string
+	columns          []string
+	includedColumns  []string
+	primary          bool
+	unique           bool
+	uniqueConstraint bool
+	indexType        string
+	options          []indexOption
 }
 
 type indexOption struct {
@@ -219,8 +271,10 @@ func (d *MssqlDatabase) getIndexDefs(table string) ([]*indexDef, error) {
 	query := fmt.Sprintf(`SELECT
 	ind.name AS index_name,
 	COL_NAME(ic.object_id, ic.column_id) AS column_name,
+	ic.is_included_column,
 	ind.is_primary_key,
 	ind.is_unique,
+	ind.is_unique_constraint,
 	ind.type_desc,
 	ind.is_padded,
 	ind.fill_factor,
@@ -232,7 +286,8 @@ func (d *MssqlDatabase) getIndexDefs(table string) ([]*indexDef, error) {
 FROM sys.indexes ind
 INNER JOIN sys.index_columns ic ON ind.object_id = ic.object_id AND ind.index_id = ic.index_id
 INNER JOIN sys.stats st ON ind.object_id = st.object_id AND ind.index_id = st.stats_id
-WHERE ind.object_id = OBJECT_ID('[%s].[%s]')`, schema, table)
+WHERE ind.object_id = OBJECT_ID('[%s].[%s]')
+ORDER BY ind.index_id, ic.key_ordinal, ic.index_column_id`, schema, table)
 
 	rows, err := d.db.Query(query)
 	if err != nil {
@@ -242,9 +297,9 @@ WHERE ind.object_id = OBJECT_ID('[%s].[%s]')`, schema, table)
 
 	indexDefMap := make(map[string]*indexDef)
 	var indexName, columnName, typeDesc, fillfactor string
-	var isPrimary, isUnique, padIndex, ignoreDupKey, noRecompute, incremental, rowLocks, pageLocks bool
+	var isIncluded, isPrimary, isUnique, isUniqueConstraint, padIndex, ignoreDupKey, noRecompute, incremental, rowLocks, pageLocks bool
 	for rows.Next() {
-		err = rows.Scan(&indexName, &columnName, &isPrimary, &isUnique, &typeDesc, &padIndex, &fillfactor, &ignoreDupKey, &noRecompute, &incremental, &rowLocks, &pageLocks)
+		err = rows.Scan(&indexName, &columnName, &isIncluded, &isPrimary, &isUnique, &isUniqueConstraint, &typeDesc, &padIndex, &fillfactor, &ignoreDupKey, &noRecompute, &incremental, &rowLocks, &pageLocks)
 		if err != nil {
 			return nil, err
 		}
@@ -260,8 +315,12 @@ WHERE ind.object_id = OBJECT_ID('[%s].[%s]')`, schema, table)
 				{name: "ALLOW_PAGE_LOCKS", value: boolToOnOff(pageLocks)},
 			}
 
-			definition := &indexDef{name: indexName, columns: []string{columnName}, primary: isPrimary, unique: isUnique, indexType: typeDesc, options: options}
+			definition := &indexDef{name: indexName, primary: isPrimary, unique: isUnique, uniqueConstraint: isUniqueConstraint, indexType: typeDesc, options: options}
 			indexDefMap[indexName] = definition
+		}
+
+		if isIncluded {
+			indexDefMap[indexName].includedColumns = append(indexDefMap[indexName].includedColumns, columnName)
 		} else {
 			indexDefMap[indexName].columns = append(indexDefMap[indexName].columns, columnName)
 		}
@@ -308,6 +367,45 @@ WHERE f.parent_object_id = OBJECT_ID('[%s].[%s]')`, schema, table)
 	return defs, nil
 }
 
+// Table-level checks. Column-level ones are dumped by getColumns.
+func (d *MssqlDatabase) getTableCheckDefs(table string) ([]string, error) {
+	schema, table := splitTableName(table)
+	query := fmt.Sprintf(`SELECT name, definition
+FROM sys.check_constraints
+WHERE parent_object_id = OBJECT_ID('[%s].[%s]') AND parent_column_id = 0
+ORDER BY name`, schema, table)
+
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	defs := make([]string, 0)
+	for rows.Next() {
+		var constraintName, definition string
+		if err = rows.Scan(&constraintName, &definition); err != nil {
+			return nil, err
+		}
+		defs = append(defs, fmt.Sprintf("CONSTRAINT [%s] CHECK %s", constraintName, definition))
+	}
+
+	return defs, nil
+}
+
+func (d *MssqlDatabase) getTableComment(table string) (*string, error) {
+	schema, table := splitTableName(table)
+	query := fmt.Sprintf(`SELECT CAST(value AS nvarchar(max))
+FROM sys.extended_properties
+WHERE class = 1 AND major_id = OBJECT_ID('[%s].[%s]') AND minor_id = 0 AND name = 'MS_Description'`, schema, table)
+
+	var comment *string
+	if err := d.db.QueryRow(query).Scan(&comment); err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	return comment, nil
+}
+
 func boolToOnOff(in bool) string {
 	if in {
 		return "ON"
@@ -355,6 +453,10 @@ INNER JOIN sys.sql_modules
 	return ddls, nil
 }
 
+func (d *MssqlDatabase) Triggers() ([]string, error) {
+	return nil, nil
+}
+
 func (d *MssqlDatabase) DB() *sql.DB {
 	return d.db
 }
@@ -78,6 +78,27 @@ func (d *MysqlDatabase) Views() ([]string, error) {
 	return ddls, nil
 }
 
+func (d *MysqlDatabase) Triggers() ([]string, error) {
+	rows, err := d.db.Query(
+		`select TRIGGER_NAME, ACTION_TIMING, EVENT_MANIPULATION, EVENT_OBJECT_TABLE, ACTION_STATEMENT from INFORMATION_SCHEMA.TRIGGERS
+		 where TRIGGER_SCHEMA = database() order by EVENT_OBJECT_TABLE, ACTION_ORDER;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var name, timing, event, table, statement string
+		if err = rows.Scan(&name, &timing, &event, &table, &statement); err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, fmt.Sprintf("CREATE TRIGGER `%s` %s %s ON `%s` FOR EACH ROW %s", name, timing, event, table, statement))
+	}
+	return ddls, rows.Err()
+}
+
 func (d *MysqlDatabase) DB() *sql.DB {
 	return d.db
 }
@@ -36,7 +36,12 @@ func (d *PostgresDatabase) TableNames() ([]string, error) {
 		`select table_schema, table_name from information_schema.tables
 		 where table_schema not in ('information_schema', 'pg_catalog')
 		 and (table_schema != 'public' or table_name != 'pg_buffercache')
-		 and table_type = 'BASE TABLE';`,
+		 and table_type = 'BASE TABLE'
+		 and (table_schema, table_name) not in (
+		   select n.nspname, c.relname from pg_class c
+		   join pg_namespace n on n.oid = c.relnamespace
+		   where c.relispartition
+		 );`,
 	)
 	if err != nil {
 		return nil, err
@@ -57,6 +62,15 @@ func (d *PostgresDatabase) TableNames() ([]string, error) {
 var (
 	suffixSemicolon = regexp.MustCompile(`;$`)
 	spaces          = regexp.MustCompile(`[ ]+`)
+
+	// PostgreSQL shows a negative number default like '-1'::integer
+	negativeNumberDefault = regexp.MustCompile(`^'(-[0-9]+(\.[0-9]+)?)'::(smallint|integer|bigint|numeric|real|double precision)$`)
+)
+
+// A unique constraint of one column named like `<table>_<column>_key` is dumped as a column-level UNIQUE, and the others as table-level constraints.
+const (
+	columnUniqueCondition    = `pc.contype = 'u' AND array_length(pc.conkey, 1) = 1 AND pc.conname = c.relname || '_' || (SELECT attname FROM pg_attribute WHERE attrelid = c.oid AND attnum = pc.conkey[1]) || '_key'`
+	tableConstraintCondition = `(pc.contype = 'x' OR pc.contype = 'u' AND NOT (` + columnUniqueCondition + `))`
 )
 
 func (d *PostgresDatabase) Views() ([]string, error) {
@@ -78,19 +92,195 @@ func (d *PostgresDatabase) Views() ([]string, error) {
 		if err := rows.Scan(&schema, &name, &definition); err != nil {
 			return nil, err
 		}
-		definition = strings.TrimSpace(definition)
-		definition = strings.ReplaceAll(definition, "\n", "")
-		definition = suffixSemicolon.ReplaceAllString(definition, "")
-		definition = spaces.ReplaceAllString(definition, " ")
 		ddls = append(
 			ddls, fmt.Sprintf(
-				"CREATE VIEW %s AS %s", schema+"."+name, definition,
+				"CREATE VIEW %s AS %s", schema+"."+name, normalizeViewDefinition(definition),
+			),
+		)
+	}
+
+	materializedViewDDLs, err := d.materializedViews()
+	if err != nil {
+		return nil, err
+	}
+	return append(ddls, materializedViewDDLs...), nil
+}
+
+func (d *PostgresDatabase) materializedViews() ([]string, error) {
+	rows, err := d.db.Query(
+		`select schemaname, matviewname, definition from pg_matviews
+		 where schemaname not in ('information_schema', 'pg_catalog');`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, name, definition string
+		if err := rows.Scan(&schema, &name, &definition); err != nil {
+			return nil, err
+		}
+		ddls = append(
+			ddls, fmt.Sprintf(
+				"CREATE MATERIALIZED VIEW %s AS %s", schema+"."+name, normalizeViewDefinition(definition),
 			),
 		)
+
+		indexDefs, err := d.getIndexDefs(schema + "." + name)
+		if err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, indexDefs...)
 	}
 	return ddls, nil
 }
 
+func (d *PostgresDatabase) Types() ([]string, error) {
+	rows, err := d.db.Query(
+		`select n.nspname, t.typname, e.enumlabel from pg_type t
+		 join pg_namespace n on n.oid = t.typnamespace
+		 join pg_enum e on e.enumtypid = t.oid
+		 where n.nspname not in ('information_schema', 'pg_catalog')
+		 order by n.nspname, t.typname, e.enumsortorder;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var typeNames []string
+	enumValues := map[string][]string{}
+	for rows.Next() {
+		var schema, name, value string
+		if err := rows.Scan(&schema, &name, &value); err != nil {
+			return nil, err
+		}
+		typeName := schema + "." + name
+		if _, ok := enumValues[typeName]; !ok {
+			typeNames = append(typeNames, typeName)
+		}
+		enumValues[typeName] = append(enumValues[typeName], "'"+strings.ReplaceAll(value, "'", "''")+"'")
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var ddls []string
+	for _, typeName := range typeNames {
+		ddls = append(ddls, fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", typeName, strings.Join(enumValues[typeName], ", ")))
+	}
+
+	// Domains may be based on the enum types above
+	domainDDLs, err := d.domains()
+	if err != nil {
+		return nil, err
+	}
+	ddls = append(ddls, domainDDLs...)
+
+	// Functions may take the types above, and may be used by column defaults and checks
+	functionDDLs, err := d.functions()
+	if err != nil {
+		return nil, err
+	}
+	return append(ddls, functionDDLs...), nil
+}
+
+func (d *PostgresDatabase) Triggers() ([]string, error) {
+	rows, err := d.db.Query(
+		`select pg_get_triggerdef(t.oid) from pg_trigger t
+		 join pg_class c on c.oid = t.tgrelid
+		 join pg_namespace n on n.oid = c.relnamespace
+		 where not t.tgisinternal and n.nspname not in ('information_schema', 'pg_catalog')
+		 order by n.nspname, c.relname, t.tgname;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var definition string
+		if err := rows.Scan(&definition); err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, definition)
+	}
+	return ddls, rows.Err()
+}
+
+func (d *PostgresDatabase) functions() ([]string, error) {
+	rows, err := d.db.Query(
+		`select pg_get_functiondef(p.oid) from pg_proc p
+		 join pg_namespace n on n.oid = p.pronamespace
+		 where p.prokind = 'f' and n.nspname not in ('information_schema', 'pg_catalog')
+		 and not exists (select 1 from pg_depend d where d.classid = 'pg_proc'::regclass and d.objid = p.oid and d.deptype = 'e')
+		 order by n.nspname, p.proname, p.oid;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var definition string
+		if err := rows.Scan(&definition); err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, strings.TrimSpace(definition))
+	}
+	return ddls, rows.Err()
+}
+
+func (d *PostgresDatabase) domains() ([]string, error) {
+	rows, err := d.db.Query(
+		`select n.nspname, t.typname, format_type(t.typbasetype, t.typtypmod), t.typnotnull, t.typdefault,
+		 (select string_agg('CONSTRAINT ' || quote_ident(c.conname) || ' ' || pg_get_constraintdef(c.oid, true), ' ' order by c.conname)
+		  from pg_constraint c where c.contypid = t.oid and c.contype = 'c')
+		 from pg_type t
+		 join pg_namespace n on n.oid = t.typnamespace
+		 where t.typtype = 'd' and n.nspname not in ('information_schema', 'pg_catalog')
+		 order by n.nspname, t.typname;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, name, dataType string
+		var notNull bool
+		var defaultDef, checkDefs *string
+		if err := rows.Scan(&schema, &name, &dataType, &notNull, &defaultDef, &checkDefs); err != nil {
+			return nil, err
+		}
+		ddl := fmt.Sprintf("CREATE DOMAIN %s AS %s", schema+"."+name, dataType)
+		if defaultDef != nil {
+			ddl += " DEFAULT " + *defaultDef
+		}
+		if notNull {
+			ddl += " NOT NULL"
+		}
+		if checkDefs != nil {
+			ddl += " " + *checkDefs
+		}
+		ddls = append(ddls, ddl)
+	}
+	return ddls, rows.Err()
+}
+
+func normalizeViewDefinition(definition string) string {
+	definition = strings.TrimSpace(definition)
+	definition = strings.ReplaceAll(definition, "\n", "")
+	definition = suffixSemicolon.ReplaceAllString(definition, "")
+	return spaces.ReplaceAllString(definition, " ")
+}
+
 func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	cols, err := d.getColumns(table)
 	if err != nil {
@@ -100,6 +290,10 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	pkeyOptions, err := d.getPrimaryKeyStorageParameters(table)
+	if err != nil {
+		return "", err
+	}
 	indexDefs, err := d.getIndexDefs(table)
 	if err != nil {
 		return "", err
@@ -112,10 +306,42 @@ func (d *PostgresDatabase) DumpTableDDL(table string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return buildDumpTableDDL(table, cols, pkeyCols, indexDefs, foreginDefs, policyDefs), nil
+	comment, err := d.getTableComment(table)
+	if err != nil {
+		return "", err
+	}
+	partitionDef, err := d.getPartitionDef(table)
+	if err != nil {
+		return "", err
+	}
+	checkDefs, err := d.getTableCheckDefs(table)
+	if err != nil {
+		return "", err
+	}
+	constraintDefs, err := d.getConstraintDefs(table)
+	if err != nil {
+		return "", err
+	}
+	inherits, err := d.getInheritedTables(table)
+	if err != nil {
+		return "", err
+	}
+	storageParameters, err := d.getStorageParameters(table)
+	if err != nil {
+		return "", err
+	}
+	tablespace, err := d.getTablespace(table)
+	if err != nil {
+		return "", err
+	}
+	clusterIndex, err := d.getClusterIndex(table)
+	if err != nil {
+		return "", err
+	}
+	return buildDumpTableDDL(table, cols, pkeyCols, pkeyOptions, checkDefs, constraintDefs, indexDefs, foreginDefs, policyDefs, comment, partitionDef, inherits, storageParameters, tablespace, clusterIndex), nil
 }
 
-func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, foreginDefs, policyDefs []string) string {
+func buildDumpTableDDL(table string, columns []column, pkeyCols, pkeyOptions, checkDefs, constraintDefs, indexDefs, foreginDefs, policyDefs []string, comment *string, partitionDef string, inherits, storageParameters []string, tablespace, clusterIndex string) string {
 	var queryBuilder strings.Builder
 	fmt.Fprintf(&queryBuilder, "CREATE TABLE %s (", table)
 	for i, col := range columns {
@@ -127,6 +353,9 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 		if col.Length > 0 {
 			fmt.Fprintf(&queryBuilder, "(%d)", col.Length)
 		}
+		if col.Collation != "" {
+			fmt.Fprintf(&queryBuilder, " COLLATE \"%s\"", col.Collation)
+		}
 		if col.IsUnique {
 			fmt.Fprint(&queryBuilder, " UNIQUE")
 		}
@@ -139,6 +368,9 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 		if col.IdentityGeneration != "" {
 			fmt.Fprintf(&queryBuilder, " GENERATED %s AS IDENTITY", col.IdentityGeneration)
 		}
+		if col.GenerationExpr != "" {
+			fmt.Fprintf(&queryBuilder, " GENERATED ALWAYS AS (%s) STORED", col.GenerationExpr)
+		}
 		if col.Check != "" {
 			fmt.Fprintf(&queryBuilder, " %s", col.Check)
 		}
@@ -146,17 +378,50 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 	if len(pkeyCols) > 0 {
 		fmt.Fprint(&queryBuilder, ",\n"+indent)
 		fmt.Fprintf(&queryBuilder, "PRIMARY KEY (\"%s\")", strings.Join(pkeyCols, "\", \""))
+		if len(pkeyOptions) > 0 {
+			fmt.Fprintf(&queryBuilder, " WITH (%s)", strings.Join(pkeyOptions, ", "))
+		}
+	}
+	for _, v := range checkDefs {
+		fmt.Fprint(&queryBuilder, ",\n"+indent+v)
+	}
+	for _, v := range constraintDefs {
+		fmt.Fprint(&queryBuilder, ",\n"+indent+v)
+	}
+	fmt.Fprint(&queryBuilder, "\n)")
+	if partitionDef != "" {
+		fmt.Fprintf(&queryBuilder, " PARTITION BY %s", partitionDef)
+	}
+	if len(inherits) > 0 {
+		fmt.Fprintf(&queryBuilder, " INHERITS (%s)", strings.Join(inherits, ", "))
+	}
+	if len(storageParameters) > 0 {
+		fmt.Fprintf(&queryBuilder, " WITH (%s)", strings.Join(storageParameters, ", "))
+	}
+	if tablespace != "" {
+		fmt.Fprintf(&queryBuilder, " TABLESPACE \"%s\"", tablespace)
 	}
-	fmt.Fprintf(&queryBuilder, "\n);\n")
+	fmt.Fprint(&queryBuilder, ";\n")
 	for _, v := range indexDefs {
 		fmt.Fprintf(&queryBuilder, "%s;\n", v)
 	}
+	if clusterIndex != "" {
+		fmt.Fprintf(&queryBuilder, "ALTER TABLE %s CLUSTER ON \"%s\";\n", table, clusterIndex)
+	}
 	for _, v := range foreginDefs {
 		fmt.Fprintf(&queryBuilder, "%s;\n", v)
 	}
 	for _, v := range policyDefs {
 		fmt.Fprintf(&queryBuilder, "%s;\n", v)
 	}
+	if comment != nil {
+		fmt.Fprintf(&queryBuilder, "COMMENT ON TABLE %s IS '%s';\n", table, strings.ReplaceAll(*comment, "'", "''"))
+	}
+	for _, col := range columns {
+		if col.Comment != nil {
+			fmt.Fprintf(&queryBuilder, "COMMENT ON COLUMN %s.\"%s\" IS '%s';\n", table, col.Name, strings.ReplaceAll(*col.Comment, "'", "''"))
+		}
+	}
 	return strings.TrimSuffix(queryBuilder.String(), ";\n")
 }
 
@@ -170,6 +435,9 @@ type column struct {
 	IsUnique           bool
 	Check              string
 	IdentityGeneration string
+	Collation          string
+	GenerationExpr     string
+	Comment            *string
 }
 
 func (c *column) GetDataType() string {
@@ -203,17 +471,21 @@ func (c *column) GetDataType() string {
 }
 
 func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
-	const query = `SELECT s.column_name, s.column_default, s.is_nullable, s.character_maximum_length,
-	CASE WHEN s.data_type IN ('ARRAY', 'USER-DEFINED') THEN format_type(f.atttypid, f.atttypmod) ELSE s.data_type END,
+	const query = `SELECT s.column_name, s.column_default, s.is_nullable,
+	CASE WHEN s.domain_name IS NULL THEN s.character_maximum_length ELSE NULL END,
+	CASE WHEN s.domain_name IS NOT NULL OR s.data_type IN ('ARRAY', 'USER-DEFINED', 'numeric') THEN format_type(f.atttypid, f.atttypmod) ELSE s.data_type END,
 	CASE WHEN p.contype = 'u' THEN true ELSE false END AS uniquekey,
-	CASE WHEN pc.contype = 'c' THEN pg_get_constraintdef(pc.oid, true) ELSE NULL END AS check,
-	s.identity_generation
+	CASE WHEN pc.contype = 'c' THEN 'CONSTRAINT ' || quote_ident(pc.conname) || ' ' || pg_get_constraintdef(pc.oid, true) ELSE NULL END AS check,
+	s.identity_generation, CASE WHEN s.domain_name IS NULL THEN s.collation_name ELSE NULL END,
+	CASE WHEN s.is_generated = 'ALWAYS' THEN pg_get_expr(d.adbin, d.adrelid, true) ELSE NULL END AS generated,
+	col_description(c.oid, f.attnum),
+	COALESCE(s.column_default = format('nextval(%L::regclass)', to_regclass(format('%I.%I', n.nspname, c.relname || '_' || f.attname || '_seq'))), false) AS serial
 FROM pg_attribute f
 	JOIN pg_class c ON c.oid = f.attrelid JOIN pg_type t ON t.oid = f.atttypid
 	LEFT JOIN pg_attrdef d ON d.adrelid = c.oid AND d.adnum = f.attnum
 	LEFT JOIN pg_namespace n ON n.oid = c.relnamespace
-	LEFT JOIN pg_constraint p ON p.conrelid = c.oid AND f.attnum = ANY (p.conkey) AND p.contype = 'u'
-	LEFT JOIN pg_constraint pc ON pc.conrelid = c.oid AND f.attnum = ANY (pc.conkey) AND pc.contype = 'c'
+	LEFT JOIN pg_constraint p ON p.conrelid = c.oid AND f.attnum = ANY (p.conkey) AND p.oid IN (SELECT pc.oid FROM pg_constraint pc WHERE pc.conrelid = c.oid AND ` + columnUniqueCondition + `)
+	LEFT JOIN pg_constraint pc ON pc.conrelid = c.oid AND f.attnum = ANY (pc.conkey) AND pc.contype = 'c' AND array_length(pc.conkey, 1) = 1 AND pc.conislocal
 	LEFT JOIN information_schema.columns s ON s.column_name=f.attname AND s.table_name = c.relname
 WHERE c.relkind = 'r'::char AND n.nspname = $1 AND c.relname = $2 AND f.attnum > 0 ORDER BY f.attnum;`
 
@@ -228,9 +500,9 @@ WHERE c.relkind = 'r'::char AND n.nspname = $1 AND c.relname = $2 AND f.attnum >
 	for rows.Next() {
 		col := column{}
 		var colName, isNullable, dataType string
-		var maxLenStr, colDefault, check, idGen *string
-		var isUnique bool
-		err = rows.Scan(&colName, &colDefault, &isNullable, &maxLenStr, &dataType, &isUnique, &check, &idGen)
+		var maxLenStr, colDefault, check, idGen, collation, generated, comment *string
+		var isUnique, isSerial bool
+		err = rows.Scan(&colName, &colDefault, &isNullable, &maxLenStr, &dataType, &isUnique, &check, &idGen, &collation, &generated, &comment, &isSerial)
 		if err != nil {
 			return nil, err
 		}
@@ -243,12 +515,11 @@ WHERE c.relkind = 'r'::char AND n.nspname = $1 AND c.relname = $2 AND f.attnum >
 		}
 		col.Name = strings.Trim(colName, `" `)
 		if colDefault != nil {
-			col.Default = *colDefault
+			col.Default = negativeNumberDefault.ReplaceAllString(*colDefault, "$1")
 		}
 		col.IsUnique = isUnique
-		if colDefault != nil && strings.HasPrefix(*colDefault, "nextval(") {
-			col.IsAutoIncrement = true
-		}
+		// Only the default of the sequence `serial` creates is dumped as serial. Others keep their nextval defaults.
+		col.IsAutoIncrement = isSerial
 		col.Nullable = isNullable == "YES"
 		col.dataType = dataType
 		col.Length = maxLen
@@ -258,13 +529,23 @@ WHERE c.relkind = 'r'::char AND n.nspname = $1 AND c.relname = $2 AND f.attnum >
 		if idGen != nil {
 			col.IdentityGeneration = *idGen
 		}
+		if collation != nil {
+			col.Collation = *collation
+		}
+		if generated != nil {
+			col.GenerationExpr = *generated
+		}
+		col.Comment = comment
 		cols = append(cols, col)
 	}
 	return cols, nil
 }
 
 func (d *PostgresDatabase) getIndexDefs(table string) ([]string, error) {
-	const query = "SELECT indexName, indexdef FROM pg_indexes WHERE schemaname=$1 AND tablename=$2"
+	// An index of a constraint given by getConstraintDefs is created by the constraint
+	const query = `SELECT indexName, indexdef FROM pg_indexes WHERE schemaname=$1 AND tablename=$2
+	AND NOT EXISTS (SELECT 1 FROM pg_constraint pc JOIN pg_class c ON c.oid = pc.conrelid JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE ` + tableConstraintCondition + ` AND pc.conname = indexname AND n.nspname = schemaname AND c.relname = tablename)`
 	schema, table := splitTableName(table)
 	rows, err := d.db.Query(query, schema, table)
 	if err != nil {
@@ -316,7 +597,196 @@ WHERE constraint_type = 'PRIMARY KEY' AND tc.table_schema=$1 AND tc.table_name=$
 	return columnNames, nil
 }
 
+// Storage parameters like `fillfactor=70` of the primary key index
+func (d *PostgresDatabase) getPrimaryKeyStorageParameters(table string) ([]string, error) {
+	const query = `SELECT unnest(ic.reloptions)
+FROM pg_index i
+	JOIN pg_class ic ON ic.oid = i.indexrelid
+	JOIN pg_class tc ON tc.oid = i.indrelid
+	JOIN pg_namespace n ON n.oid = tc.relnamespace
+WHERE i.indisprimary AND n.nspname = $1 AND tc.relname = $2`
+	schema, table := splitTableName(table)
+	rows, err := d.db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := make([]string, 0)
+	for rows.Next() {
+		var option string
+		if err := rows.Scan(&option); err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+// Storage parameters like `fillfactor=70` of the table
+func (d *PostgresDatabase) getStorageParameters(table string) ([]string, error) {
+	const query = `SELECT unnest(c.reloptions)
+FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2`
+	schema, table := splitTableName(table)
+	rows, err := d.db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	params := make([]string, 0)
+	for rows.Next() {
+		var param string
+		if err := rows.Scan(&param); err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// Returns "" when the table is in the default tablespace of the database
+func (d *PostgresDatabase) getTablespace(table string) (string, error) {
+	const query = `SELECT coalesce(t.spcname, '')
+FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	LEFT JOIN pg_tablespace t ON t.oid = c.reltablespace
+WHERE n.nspname = $1 AND c.relname = $2`
+	schema, table := splitTableName(table)
+	var tablespace string
+	if err := d.db.QueryRow(query, schema, table).Scan(&tablespace); err != nil {
+		return "", err
+	}
+	return tablespace, nil
+}
+
+// Returns "" unless the table is marked to be clustered on an index
+func (d *PostgresDatabase) getClusterIndex(table string) (string, error) {
+	const query = `SELECT ic.relname
+FROM pg_index i
+	JOIN pg_class ic ON ic.oid = i.indexrelid
+	JOIN pg_class tc ON tc.oid = i.indrelid
+	JOIN pg_namespace n ON n.oid = tc.relnamespace
+WHERE i.indisclustered AND n.nspname = $1 AND tc.relname = $2`
+	schema, table := splitTableName(table)
+	var indexName string
+	err := d.db.QueryRow(query, schema, table).Scan(&indexName)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return indexName, err
+}
+
+func (d *PostgresDatabase) getTableComment(table string) (*string, error) {
+	const query = `SELECT obj_description(c.oid, 'pg_class')
+FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2`
+	schema, table := splitTableName(table)
+	var comment *string
+	if err := d.db.QueryRow(query, schema, table).Scan(&comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// Returns "" unless the table is partitioned
+func (d *PostgresDatabase) getPartitionDef(table string) (string, error) {
+	const query = `SELECT coalesce(pg_get_partkeydef(c.oid), '')
+FROM pg_class c
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2`
+	schema, table := splitTableName(table)
+	var partitionDef string
+	if err := d.db.QueryRow(query, schema, table).Scan(&partitionDef); err != nil {
+		return "", err
+	}
+	return partitionDef, nil
+}
+
+// Parent tables of `INHERITS`, excluding partitioned tables
+func (d *PostgresDatabase) getInheritedTables(table string) ([]string, error) {
+	const query = `SELECT pn.nspname, p.relname
+FROM pg_inherits i
+	JOIN pg_class c ON c.oid = i.inhrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+	JOIN pg_class p ON p.oid = i.inhparent
+	JOIN pg_namespace pn ON pn.oid = p.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2 AND NOT c.relispartition
+ORDER BY i.inhseqno`
+	schema, table := splitTableName(table)
+	rows, err := d.db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	parents := []string{}
+	for rows.Next() {
+		var parentSchema, parentName string
+		if err := rows.Scan(&parentSchema, &parentName); err != nil {
+			return nil, err
+		}
+		parents = append(parents, parentSchema+"."+parentName)
+	}
+	return parents, rows.Err()
+}
+
 // refs: https://gist.github.com/PickledDragon/dd41f4e72b428175354d
+// Checks which don't refer to exactly one column. Others are dumped as column-level ones.
+func (d *PostgresDatabase) getTableCheckDefs(table string) ([]string, error) {
+	const query = `SELECT pc.conname, pg_get_constraintdef(pc.oid, true)
+FROM pg_constraint pc
+	JOIN pg_class c ON c.oid = pc.conrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE pc.contype = 'c' AND pc.conislocal AND COALESCE(array_length(pc.conkey, 1), 0) <> 1 AND n.nspname = $1 AND c.relname = $2
+ORDER BY pc.conname`
+	schema, table := splitTableName(table)
+	rows, err := d.db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	defs := make([]string, 0)
+	for rows.Next() {
+		var constraintName, constraintDef string
+		if err = rows.Scan(&constraintName, &constraintDef); err != nil {
+			return nil, err
+		}
+		defs = append(defs, fmt.Sprintf("CONSTRAINT \"%s\" %s", constraintName, constraintDef))
+	}
+	return defs, rows.Err()
+}
+
+// EXCLUDE constraints and unique constraints other than column-level UNIQUE, whose indexes are not dumped by getIndexDefs
+func (d *PostgresDatabase) getConstraintDefs(table string) ([]string, error) {
+	const query = `SELECT pc.conname, pg_get_constraintdef(pc.oid, true)
+FROM pg_constraint pc
+	JOIN pg_class c ON c.oid = pc.conrelid
+	JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE ` + tableConstraintCondition + ` AND n.nspname = $1 AND c.relname = $2
+ORDER BY pc.conname`
+	schema, table := splitTableName(table)
+	rows, err := d.db.Query(query, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	defs := make([]string, 0)
+	for rows.Next() {
+		var constraintName, constraintDef string
+		if err = rows.Scan(&constraintName, &constraintDef); err != nil {
+			return nil, err
+		}
+		defs = append(defs, fmt.Sprintf("CONSTRAINT \"%s\" %s", constraintName, constraintDef))
+	}
+	return defs, rows.Err()
+}
+
 func (d *PostgresDatabase) getForeginDefs(table string) ([]string, error) {
 	const query = `SELECT
 	tc.table_schema, tc.constraint_name, tc.table_name, kcu.column_name,
@@ -324,7 +794,8 @@ func (d *PostgresDatabase) getForeginDefs(table string) ([]string, error) {
 	ccu.table_name AS foreign_table_name,
 	ccu.column_name AS foreign_column_name,
 	rc.update_rule AS foreign_update_rule,
-	rc.delete_rule AS foreign_delete_rule
+	rc.delete_rule AS foreign_delete_rule,
+	tc.is_deferrable, tc.initially_deferred
 FROM
 	information_schema.table_constraints AS tc
 	JOIN information_schema.key_column_usage AS kcu
@@ -343,8 +814,8 @@ WHERE constraint_type = 'FOREIGN KEY' AND tc.table_schema=$1 AND tc.table_name=$
 
 	defs := make([]string, 0)
 	for rows.Next() {
-		var tableSchema, constraintName, tableName, columnName, foreignTableSchema, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule string
-		err = rows.Scan(&tableSchema, &constraintName, &tableName, &columnName, &foreignTableSchema, &foreignTableName, &foreignColumnName, &foreignUpdateRule, &foreignDeleteRule)
+		var tableSchema, constraintName, tableName, columnName, foreignTableSchema, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule, isDeferrable, initiallyDeferred string
+		err = rows.Scan(&tableSchema, &constraintName, &tableName, &columnName, &foreignTableSchema, &foreignTableName, &foreignColumnName, &foreignUpdateRule, &foreignDeleteRule, &isDeferrable, &initiallyDeferred)
 		if err != nil {
 			return nil, err
 		}
@@ -352,6 +823,12 @@ WHERE constraint_type = 'FOREIGN KEY' AND tc.table_schema=$1 AND tc.table_name=$
 			"ALTER TABLE ONLY %s.%s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s.%s(%s) ON UPDATE %s ON DELETE %s",
 			tableSchema, tableName, constraintName, columnName, foreignTableSchema, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule,
 		)
+		if isDeferrable == "YES" {
+			def += " DEFERRABLE"
+		}
+		if initiallyDeferred == "YES" {
+			def += " INITIALLY DEFERRED"
+		}
 		defs = append(defs, def)
 	}
 	return defs, nil
@@ -2,6 +2,7 @@ package sqlite3
 
 import (
 	"database/sql"
+	"strings"
 
 	"github.com/k0kubun/sqldef/adapter"
 	_ "github.com/mattn/go-sqlite3"
@@ -17,6 +18,8 @@ func NewDatabase(config adapter.Config) (adapter.Database, error) {
 	if err != nil {
 		return nil, err
 	}
+	// PRAGMA foreign_keys is a setting of a connection, so DDLs should run on the same connection
+	db.SetMaxOpenConns(1)
 
 	return &Sqlite3Database{
 		db:     db,
@@ -24,6 +27,13 @@ func NewDatabase(config adapter.Config) (adapter.Database, error) {
 	}, nil
 }
 
+// Whether the connection enforces foreign keys, which is off unless it's enabled like `_foreign_keys=1` of the DSN
+func (d *Sqlite3Database) ForeignKeysEnabled() (bool, error) {
+	var enabled bool
+	err := d.db.QueryRow("PRAGMA foreign_keys").Scan(&enabled)
+	return enabled, err
+}
+
 func (d *Sqlite3Database) TableNames() ([]string, error) {
 	rows, err := d.db.Query(
 		`select tbl_name from sqlite_master where type = 'table' and tbl_name not like 'sqlite_%'`,
@@ -45,10 +55,38 @@ func (d *Sqlite3Database) TableNames() ([]string, error) {
 }
 
 func (d *Sqlite3Database) DumpTableDDL(table string) (string, error) {
-	const query = `select sql from sqlite_master where tbl_name = ?`
+	const query = `select sql from sqlite_master where type = 'table' and tbl_name = ?`
 	var sql string
-	err := d.db.QueryRow(query, table).Scan(&sql)
-	return sql, err
+	if err := d.db.QueryRow(query, table).Scan(&sql); err != nil {
+		return "", err
+	}
+
+	indexDefs, err := d.indexDefs(table)
+	if err != nil {
+		return "", err
+	}
+	ddls := append([]string{sql}, indexDefs...)
+	return strings.Join(ddls, ";\n"), nil
+}
+
+// Indexes created by CREATE INDEX. Ones for PRIMARY KEY and UNIQUE constraints don't have sql.
+func (d *Sqlite3Database) indexDefs(table string) ([]string, error) {
+	const query = `select sql from sqlite_master where type = 'index' and tbl_name = ? and sql is not null`
+	rows, err := d.db.Query(query, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indexDefs := []string{}
+	for rows.Next() {
+		var sql string
+		if err := rows.Scan(&sql); err != nil {
+			return nil, err
+		}
+		indexDefs = append(indexDefs, sql)
+	}
+	return indexDefs, rows.Err()
 }
 
 func (d *Sqlite3Database) Views() ([]string, error) {
@@ -71,6 +109,26 @@ func (d *Sqlite3Database) Views() ([]string, error) {
 	return ddls, nil
 }
 
+func (d *Sqlite3Database) Triggers() ([]string, error) {
+	var ddls []string
+	const query = "select sql from sqlite_master where type = 'trigger' order by name;"
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sql string
+		if err = rows.Scan(&sql); err != nil {
+			return nil, err
+		}
+		ddls = append(ddls, sql)
+	}
+
+	return ddls, rows.Err()
+}
+
 func (d *Sqlite3Database) DB() *sql.DB {
 	return d.db
 }
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/k0kubun/sqldef"
@@ -20,17 +21,29 @@ var version string
 // TODO: Support `sqldef schema.sql -opt val...`
 func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 	var opts struct {
-		User     string `short:"U" long:"user" description:"MSSQL user name" value-name:"user_name" default:"sa"`
-		Password string `short:"P" long:"password" description:"MSSQL user password, overridden by $MSSQL_PWD" value-name:"password"`
-		Host     string `short:"h" long:"host" description:"Host to connect to the MSSQL server" value-name:"host_name" default:"127.0.0.1"`
-		Port     uint   `short:"p" long:"port" description:"Port used for the connection" value-name:"port_num" default:"1433"`
-		Prompt   bool   `long:"password-prompt" description:"Force MSSQL user password prompt"`
-		File     string `long:"file" description:"Read schema SQL from the file, rather than stdin" value-name:"sql_file" default:"-"`
-		DryRun   bool   `long:"dry-run" description:"Don't run DDLs but just show them"`
-		Export   bool   `long:"export" description:"Just dump the current schema to stdout"`
-		SkipDrop bool   `long:"skip-drop" description:"Skip destructive changes such as DROP"`
-		Help     bool   `long:"help" description:"Show this help"`
-		Version  bool   `long:"version" description:"Show this version"`
+		User             string        `short:"U" long:"user" description:"MSSQL user name" value-name:"user_name" default:"sa"`
+		Password         string        `short:"P" long:"password" description:"MSSQL user password, overridden by $MSSQL_PWD" value-name:"password"`
+		Host             string        `short:"h" long:"host" description:"Host to connect to the MSSQL server" value-name:"host_name" default:"127.0.0.1"`
+		Port             uint          `short:"p" long:"port" description:"Port used for the connection" value-name:"port_num" default:"1433"`
+		Prompt           bool          `long:"password-prompt" description:"Force MSSQL user password prompt"`
+		File             string        `long:"file" description:"Read schema SQL from the file, the *.sql files in the directory, or stdin when it is -" value-name:"sql_file" default:"-"`
+		DryRun           bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Export           bool          `long:"export" description:"Just dump the current schema to stdout"`
+		DumpModel        string        `long:"dump-model" description:"Just dump the model of the current schema parsed by sqldef to stdout" value-name:"format" choice:"json"`
+		Output           string        `long:"output" description:"Output format of --dry-run and --export: sql, or json with the object each statement changes" value-name:"format" choice:"sql" choice:"json" default:"sql"`
+		PrintResult      bool          `long:"print-result" description:"Don't run DDLs but show the schema after running them"`
+		SkipDrop         bool          `long:"skip-drop" description:"Skip destructive changes such as DROP"`
+		AllowUnsafe      bool          `long:"allow-unsafe" description:"Don't skip changes which may lose data, such as dropping a column"`
+		EnableDropTable  bool          `long:"enable-drop-table" description:"Drop tables which are not in the schema file, instead of just reporting them"`
+		UseIfExists      bool          `long:"use-if-exists" description:"Use IF EXISTS of DROP and IF NOT EXISTS of CREATE INDEX where the database supports them"`
+		TargetTables     []string      `long:"target-tables" description:"Only manage tables whose names fully match the regexp, which can be given multiple times" value-name:"regexp"`
+		SkipTables       []string      `long:"skip-tables" description:"Don't manage tables whose names fully match the regexp, which can be given multiple times" value-name:"regexp"`
+		QuoteIdentifiers string        `long:"quote-identifiers" description:"Quote identifiers in generated DDLs: always, or only when necessary like reserved words" value-name:"policy" choice:"always" choice:"necessary" default:"always"`
+		OnlineIndex      bool          `long:"online-index" description:"Create indexes with ONLINE = ON, which needs the Enterprise edition"`
+		Timeout          time.Duration `long:"timeout" description:"Cancel applying DDLs when it takes longer than the duration, e.g. 30s" value-name:"duration"`
+		Help             bool          `long:"help" description:"Show this help"`
+		Features         bool          `long:"features" description:"Show features which can be diffed for this database"`
+		Version          bool          `long:"version" description:"Show this version"`
 	}
 
 	parser := flags.NewParser(&opts, flags.None)
@@ -50,6 +63,13 @@ func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 		os.Exit(0)
 	}
 
+	if opts.Features {
+		for _, feature := range schema.SupportedFeatures(schema.GeneratorModeMssql) {
+			fmt.Println(feature)
+		}
+		os.Exit(0)
+	}
+
 	if len(args) == 0 {
 		fmt.Print("No database is specified!\n\n")
 		parser.WriteHelp(os.Stdout)
@@ -62,10 +82,21 @@ func parseOptions(args []string) (adapter.Config, *sqldef.Options) {
 	database := args[0]
 
 	options := sqldef.Options{
-		SqlFile:  opts.File,
-		DryRun:   opts.DryRun,
-		Export:   opts.Export,
-		SkipDrop: opts.SkipDrop,
+		SqlFile:          opts.File,
+		DryRun:           opts.DryRun,
+		Export:           opts.Export,
+		DumpModel:        opts.DumpModel,
+		Output:           opts.Output,
+		PrintResult:      opts.PrintResult,
+		SkipDrop:         opts.SkipDrop,
+		AllowUnsafe:      opts.AllowUnsafe,
+		EnableDropTable:  opts.EnableDropTable,
+		UseIfExists:      opts.UseIfExists,
+		TargetTables:     opts.TargetTables,
+		SkipTables:       opts.SkipTables,
+		QuoteIdentifiers: opts.QuoteIdentifiers,
+		OnlineIndex:      opts.OnlineIndex,
+		Timeout:          opts.Timeout,
 	}
 
 	password, ok := os.LookupEnv("MSSQL_PWD")
@@ -87,6 +87,19 @@ func TestMssqldefCreateTable(t *testing.T) {
 	assertApplyOutput(t, createTable1, nothingModified)
 }
 
+func TestMssqldefCreateTableInSchema(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE myapp.users (
+		  id bigint NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"IF SCHEMA_ID('myapp') IS NULL EXEC('CREATE SCHEMA [myapp]');\n"+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMssqldefCreateTableWithDefault(t *testing.T) {
 	resetTestDatabase()
 
@@ -105,6 +118,21 @@ func TestMssqldefCreateTableWithDefault(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefNegativeDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  position int DEFAULT -20,
+		  score decimal(5, 2) DEFAULT -2,
+		  ratio float DEFAULT -0.125
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMssqldefCreateTableWithIDENTITY(t *testing.T) {
 	resetTestDatabase()
 
@@ -121,6 +149,26 @@ func TestMssqldefCreateTableWithIDENTITY(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefChangeIDENTITYSeed(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY IDENTITY(1,1)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY IDENTITY(1000,10)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, "-- Warning: column 'id' of table 'users' changes IDENTITY(1,1) to IDENTITY(1000,10), which needs rebuilding the table --\n"+nothingModified)
+}
+
 func TestMssqldefCreateTableWithCLUSTERED(t *testing.T) {
 	resetTestDatabase()
 
@@ -195,6 +243,66 @@ func TestMssqldefAddColumn(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefComment(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT NOT NULL PRIMARY KEY,
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Add comments
+	comments := "COMMENT ON TABLE users IS 'users';\nCOMMENT ON COLUMN users.name IS 'user name';\n"
+	assertApplyOutput(t, createTable+comments, applyPrefix+
+		"EXEC sp_addextendedproperty 'MS_Description', N'users', 'SCHEMA', 'dbo', 'TABLE', 'users';\n"+
+		"EXEC sp_addextendedproperty 'MS_Description', N'user name', 'SCHEMA', 'dbo', 'TABLE', 'users', 'COLUMN', 'name';\n")
+	assertApplyOutput(t, createTable+comments, nothingModified)
+
+	// Change comments
+	comments = "COMMENT ON TABLE users IS 'all users';\nCOMMENT ON COLUMN users.name IS 'user''s name';\n"
+	assertApplyOutput(t, createTable+comments, applyPrefix+
+		"EXEC sp_updateextendedproperty 'MS_Description', N'all users', 'SCHEMA', 'dbo', 'TABLE', 'users';\n"+
+		"EXEC sp_updateextendedproperty 'MS_Description', N'user''s name', 'SCHEMA', 'dbo', 'TABLE', 'users', 'COLUMN', 'name';\n")
+	assertApplyOutput(t, createTable+comments, nothingModified)
+
+	// Remove comments
+	assertApplyOutput(t, createTable, applyPrefix+
+		"EXEC sp_dropextendedproperty 'MS_Description', 'SCHEMA', 'dbo', 'TABLE', 'users';\n"+
+		"EXEC sp_dropextendedproperty 'MS_Description', 'SCHEMA', 'dbo', 'TABLE', 'users', 'COLUMN', 'name';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMssqldefAddComputedColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE products (
+		  id BIGINT NOT NULL PRIMARY KEY,
+		  price int,
+		  tax int
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE products (
+		  id BIGINT NOT NULL PRIMARY KEY,
+		  price int,
+		  tax int,
+		  total AS (price + tax) PERSISTED
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE [dbo].[products] ADD [total] AS (price + tax) PERSISTED;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMssqldefAddColumnWithIDENTITY(t *testing.T) {
 	resetTestDatabase()
 
@@ -364,6 +472,30 @@ func TestMssqldefCreateTableAddPrimaryKeyConstraint(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefAlterTableAddCompositePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	// SQL Server requires the columns of a primary key to be NOT NULL beforehand
+	createTable := stripHeredoc(`
+		CREATE TABLE friends (
+		  user_id bigint NOT NULL,
+		  friend_id bigint NOT NULL
+		);
+		`,
+	)
+	addPrimaryKey := "ALTER TABLE friends ADD PRIMARY KEY (friend_id, user_id);\n"
+	assertApplyOutput(t, createTable+addPrimaryKey, applyPrefix+createTable+addPrimaryKey)
+	assertApplyOutput(t, createTable+addPrimaryKey, nothingModified)
+
+	// The primary key keeps the declared order
+	out := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--export")
+	for _, definition := range []string{"user_id bigint NOT NULL", "friend_id bigint NOT NULL", "PRIMARY KEY CLUSTERED ([friend_id], [user_id])"} {
+		if !strings.Contains(out, definition) {
+			t.Errorf("expected '%s' in the exported schema:\n%s", definition, out)
+		}
+	}
+}
+
 func TestMssqldefCreateTableDropPrimaryKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -524,6 +656,37 @@ func TestMssqldefCreateTableDropIndex(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefCreateTableIndexWithInclude(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  email varchar(20),
+		  INDEX [ix_users_name] NONCLUSTERED ([name]) INCLUDE ([email])
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20),
+		  email varchar(20),
+		  INDEX [ix_users_name] NONCLUSTERED ([name]) INCLUDE ([email], [id])
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"DROP INDEX [ix_users_name] ON [dbo].[users];\n"+
+		"CREATE NONCLUSTERED INDEX [ix_users_name] ON [dbo].[users] ([name]) INCLUDE ([email], [id]);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMssqldefCreateTableChangeIndexOption(t *testing.T) {
 	resetTestDatabase()
 
@@ -556,6 +719,66 @@ func TestMssqldefCreateTableChangeIndexOption(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMssqldefCreateTableUniqueConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email varchar(255),
+		  CONSTRAINT uq_users_email UNIQUE (email)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email varchar(255),
+		  CONSTRAINT uq_users_email UNIQUE (id, email)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE [dbo].[users] DROP CONSTRAINT [uq_users_email];\n"+
+		"ALTER TABLE [dbo].[users] ADD CONSTRAINT [uq_users_email] unique NONCLUSTERED ([id], [email]);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMssqldefPromoteUniqueIndexToPrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(100),
+		  INDEX ix_users_id UNIQUE CLUSTERED (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(100),
+		  CONSTRAINT PK_users PRIMARY KEY CLUSTERED (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"DROP INDEX [ix_users_id] ON [dbo].[users];\n"+
+		"ALTER TABLE [dbo].[users] ADD CONSTRAINT [PK_users] primary key CLUSTERED ([id]);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+}
+
 func TestMssqldefCreateTableForeignKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -578,7 +801,7 @@ func TestMssqldefCreateTableForeignKey(t *testing.T) {
 		);
 		`,
 	)
-	assertApplyOutput(t, createUsers+createPosts, applyPrefix+"ALTER TABLE [dbo].[posts] ADD CONSTRAINT [posts_ibfk_1] FOREIGN KEY ([user_id]) REFERENCES [users] ([id]);\n")
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+"ALTER TABLE [dbo].[posts] ADD CONSTRAINT [posts_ibfk_1] FOREIGN KEY ([user_id]) REFERENCES [dbo].[users] ([id]);\n")
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 
 	createPosts = stripHeredoc(`
@@ -591,7 +814,7 @@ func TestMssqldefCreateTableForeignKey(t *testing.T) {
 	)
 	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
 		"ALTER TABLE [dbo].[posts] DROP CONSTRAINT [posts_ibfk_1];\n"+
-		"ALTER TABLE [dbo].[posts] ADD CONSTRAINT [posts_ibfk_1] FOREIGN KEY ([user_id]) REFERENCES [users] ([id]) ON DELETE SET NULL ON UPDATE CASCADE;\n",
+		"ALTER TABLE [dbo].[posts] ADD CONSTRAINT [posts_ibfk_1] FOREIGN KEY ([user_id]) REFERENCES [dbo].[users] ([id]) ON DELETE SET NULL ON UPDATE CASCADE;\n",
 	)
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 
@@ -627,8 +850,8 @@ func TestMssqldefCreateTableWithCheck(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE [dbo].[a] DROP CONSTRAINT a_a_id_check;\n"+
-		"ALTER TABLE [dbo].[a] ADD CONSTRAINT a_a_id_check CHECK (a_id > (1));\n")
+		"ALTER TABLE [dbo].[a] DROP CONSTRAINT [a_a_id_check];\n"+
+		"ALTER TABLE [dbo].[a] ADD CONSTRAINT [a_a_id_check] CHECK (a_id > (1));\n")
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
@@ -639,13 +862,14 @@ func TestMssqldefCreateTableWithCheck(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE [dbo].[a] DROP CONSTRAINT a_a_id_check;\n")
+		"ALTER TABLE [dbo].[a] DROP CONSTRAINT [a_a_id_check];\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
 func TestMssqldefCreateTableWithCheckWithoutName(t *testing.T) {
 	resetTestDatabase()
 
+	// A check without a name is named by sqldef, not randomly by MSSQL
 	createTable := stripHeredoc(`
 		CREATE TABLE a (
 		  a_id INTEGER PRIMARY KEY CHECK ([a_id]>(0)),
@@ -653,7 +877,13 @@ func TestMssqldefCreateTableWithCheckWithoutName(t *testing.T) {
 		);
 		`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		CREATE TABLE a (
+		  a_id INTEGER PRIMARY KEY CONSTRAINT [a_a_id_check] CHECK ([a_id]>(0)),
+		  my_text TEXT NOT NULL
+		);
+		`,
+	))
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
@@ -663,20 +893,53 @@ func TestMssqldefCreateTableWithCheckWithoutName(t *testing.T) {
 		);
 		`,
 	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE [dbo].[a] DROP CONSTRAINT [a_a_id_check];\n"+
+		"ALTER TABLE [dbo].[a] ADD CONSTRAINT [a_a_id_check] CHECK (a_id > (1));\n")
+	assertApplyOutput(t, createTable, nothingModified)
 
-	// extract name of check constraint from sql server
-	out, err := execute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-h", "-1", "-Q", stripHeredoc(`
-		SELECT name FROM sys.check_constraints cc WHERE cc.parent_object_id = OBJECT_ID('dbo.a', 'U');
+	createTable = stripHeredoc(`
+		CREATE TABLE a (
+		  a_id INTEGER PRIMARY KEY CHECK ([a_id]>(1)),
+		  my_text TEXT NOT NULL,
+		  amount INTEGER CHECK ([amount]>=(0))
+		);
 		`,
-	))
-	if err != nil {
-		t.Error("failed to extract check constraint name")
-	}
-	checkConstraintName := strings.Replace((strings.Split(out, "\n")[0]), " ", "", -1)
-	dropConstraint := fmt.Sprintf("ALTER TABLE [dbo].[a] DROP CONSTRAINT %s;\n", checkConstraintName)
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE [dbo].[a] ADD [amount] integer CONSTRAINT [a_amount_check] CHECK (amount >= (0));\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMssqldefCreateTableWithTableCheck(t *testing.T) {
+	resetTestDatabase()
 
+	createTable := stripHeredoc(`
+		CREATE TABLE orders (
+		  id INTEGER NOT NULL,
+		  starts_at INTEGER,
+		  ends_at INTEGER,
+		  CONSTRAINT [orders_period] CHECK ([starts_at]<[ends_at])
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id INTEGER NOT NULL,
+		  starts_at INTEGER,
+		  ends_at INTEGER,
+		  CONSTRAINT [orders_period] CHECK ([starts_at]<=[ends_at]),
+		  CHECK (([starts_at]+(10))>[ends_at])
+		);
+		`,
+	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		dropConstraint+"ALTER TABLE [dbo].[a] ADD CONSTRAINT a_a_id_check CHECK (a_id > (1));\n")
+		"ALTER TABLE [dbo].[orders] DROP CONSTRAINT [orders_period];\n"+
+		"ALTER TABLE [dbo].[orders] ADD CONSTRAINT [orders_period] CHECK (starts_at <= ends_at);\n"+
+		"ALTER TABLE [dbo].[orders] ADD CHECK (starts_at + 10 > ends_at);\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -698,6 +961,36 @@ func TestMssqldefDryRun(t *testing.T) {
 	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
 }
 
+func TestMssqldefOnlineIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name varchar(20),
+		  email varchar(20)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name varchar(20),
+		  email varchar(20),
+		  INDEX [ix_users_email] NONCLUSTERED ([email])
+		);
+		CREATE INDEX ix_users_name ON users (name);
+		`,
+	))
+	dryRun := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--dry-run", "--online-index", "--file", "schema.sql")
+	assertEquals(t, dryRun, "-- dry run --\n"+
+		"CREATE NONCLUSTERED INDEX [ix_users_email] ON [dbo].[users] ([email]) WITH (online = ON);\n"+
+		"CREATE INDEX ix_users_name ON users (name) WITH (ONLINE = ON);\n",
+	)
+}
+
 func TestMssqldefSkipDrop(t *testing.T) {
 	resetTestDatabase()
 	mustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
@@ -709,11 +1002,74 @@ func TestMssqldefSkipDrop(t *testing.T) {
 
 	writeFile("schema.sql", "")
 
-	skipDrop := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--skip-drop", "--file", "schema.sql")
-	apply := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql")
+	skipDrop := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--skip-drop", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	apply := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	assertEquals(t, skipDrop, strings.Replace(apply, "DROP", "-- Skipped: DROP", 1))
 }
 
+func TestMssqldefUseIfExistsDropConstraint(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    age integer,
+		    CONSTRAINT age_check CHECK (age > 0)
+		);
+		CREATE TABLE posts (
+		    id bigint NOT NULL PRIMARY KEY,
+		    user_id bigint,
+		    CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);`,
+	))
+
+	schema := stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    age integer
+		);
+		CREATE TABLE posts (
+		    id bigint NOT NULL PRIMARY KEY,
+		    user_id bigint
+		);
+		`,
+	)
+	writeFile("schema.sql", schema)
+	out := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--use-if-exists", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"ALTER TABLE [dbo].[users] DROP CONSTRAINT IF EXISTS [age_check];\n"+
+		"ALTER TABLE [dbo].[posts] DROP CONSTRAINT IF EXISTS [posts_ibfk_1];\n",
+	)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestMssqldefUseIfExistsIndex(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name varchar(40)
+		);
+		CREATE INDEX index_id ON users (id);`,
+	))
+
+	// MSSQL doesn't support IF NOT EXISTS of CREATE INDEX
+	schema := stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name varchar(40)
+		);
+		CREATE INDEX index_name ON users (name);
+		`,
+	)
+	writeFile("schema.sql", schema)
+	out := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--use-if-exists", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"CREATE INDEX index_name ON users (name);\n"+
+		"DROP INDEX IF EXISTS [index_id] ON [dbo].[users];\n",
+	)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
 func TestMssqldefExport(t *testing.T) {
 	resetTestDatabase()
 	out := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--export")
@@ -736,6 +1092,27 @@ func TestMssqldefExport(t *testing.T) {
 	))
 }
 
+func TestMssqldefExportRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
+		CREATE TABLE dbo.users (
+		    id int NOT NULL IDENTITY(1,1),
+		    name varchar(20) CONSTRAINT df_name DEFAULT 'none',
+		    age int CONSTRAINT users_age_check CHECK (age > 0),
+		    CONSTRAINT pk_users PRIMARY KEY CLUSTERED (id)
+		);
+		CREATE TABLE dbo.posts (
+		    id int NOT NULL,
+		    user_id int NOT NULL,
+		    CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+		);
+		CREATE NONCLUSTERED INDEX ix_posts_user_id ON dbo.posts (user_id);
+		`,
+	))
+	assertExportRoundTrip(t)
+}
+
 func TestMssqldefHelp(t *testing.T) {
 	_, err := execute("mssqldef", "--help")
 	if err != nil {
@@ -758,16 +1135,23 @@ func TestMain(m *testing.M) {
 func assertApply(t *testing.T, schema string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql")
+	assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 }
 
 func assertApplyOutput(t *testing.T, schema string, expected string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	actual := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql")
+	actual := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	assertEquals(t, actual, expected)
 }
 
+// Applying the output of `--export` should be always "Nothing is modified".
+func assertExportRoundTrip(t *testing.T) {
+	t.Helper()
+	out := assertedExecute(t, "mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
 func mustExecute(command string, args ...string) {
 	out, err := execute(command, args...)
 	if err != nil {
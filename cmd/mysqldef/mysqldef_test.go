@@ -152,6 +152,31 @@ func TestMysqldefCreateTableChangePrimaryKey(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefAlterTableAddCompositePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE friends (
+		  user_id bigint,
+		  friend_id bigint
+		) DEFAULT CHARSET=latin1;
+		`,
+	)
+	addPrimaryKey := "ALTER TABLE friends ADD PRIMARY KEY (friend_id, user_id);\n"
+	assertApplyOutput(t, createTable+addPrimaryKey, applyPrefix+createTable+addPrimaryKey)
+	assertApplyOutput(t, createTable+addPrimaryKey, nothingModified)
+
+	// The columns become NOT NULL, and the primary key keeps the declared order
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertEquals(t, out,
+		"CREATE TABLE `friends` (\n"+
+			"  `user_id` bigint NOT NULL,\n"+
+			"  `friend_id` bigint NOT NULL,\n"+
+			"  PRIMARY KEY (`friend_id`,`user_id`)\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1;\n",
+	)
+}
+
 func TestMysqldefCreateTableAddAutoIncrementPrimaryKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -436,6 +461,45 @@ func TestMysqldefChangeColumnLength(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefTableOptions(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) ENGINE=MyISAM DEFAULT CHARSET=latin1;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin AUTO_INCREMENT=100;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ENGINE = InnoDB;\n"+
+		"ALTER TABLE `users` CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_bin;\n"+
+		"ALTER TABLE `users` AUTO_INCREMENT = 100;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// AUTO_INCREMENT is not decreased after rows are inserted
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "INSERT INTO users () VALUES (), ();")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Options which are not given are not changed
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefChangeColumnBinary(t *testing.T) {
 	resetTestDatabase()
 
@@ -484,6 +548,146 @@ func TestMysqldefChangeColumnCollate(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefImplicitColumnCharset(t *testing.T) {
+	resetTestDatabase()
+
+	// An explicit charset same as the table's default is not shown by MySQL
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(20) CHARACTER SET latin1,
+		  bio varchar(20) CHARACTER SET utf8mb4
+		) DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// A column omitting its charset is changed to the table's default
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(20),
+		  bio varchar(20)
+		) DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` CHANGE COLUMN `bio` `bio` varchar(20);\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefTableDefaultCharset(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) NOT NULL
+		) DEFAULT CHARSET=latin1;`,
+	))
+	assertExportRoundTrip(t)
+
+	// Omitted table options match the current default
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) CHARACTER SET latin1 NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) NOT NULL
+		) DEFAULT CHARACTER SET latin1;
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefBitDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  active bit(1) NOT NULL DEFAULT 1,
+		  deleted bit(1) NOT NULL DEFAULT 0,
+		  flags bit(8) DEFAULT b'101'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	// MySQL shows them like b'1', b'0' and b'101'
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  active bit(1) NOT NULL DEFAULT b'1',
+		  deleted bit(1) NOT NULL DEFAULT '0',
+		  flags bit(8) DEFAULT 5
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  active bit(1) NOT NULL DEFAULT b'0',
+		  deleted bit(1) NOT NULL DEFAULT 0,
+		  flags bit(8) DEFAULT b'110'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ALTER COLUMN `active` SET DEFAULT b'0';\n"+
+		"ALTER TABLE `users` ALTER COLUMN `flags` SET DEFAULT b'110';\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefJsonDefaultExpression(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tags json DEFAULT (JSON_ARRAY()),
+		  attributes json DEFAULT (JSON_OBJECT('name', 'none'))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tags json,
+		  attributes json DEFAULT (JSON_OBJECT('name', 'unknown'))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ALTER COLUMN `tags` DROP DEFAULT;\n"+
+		"ALTER TABLE `users` CHANGE COLUMN `attributes` `attributes` json DEFAULT (json_object('name', 'unknown'));\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	assertApplyFailure(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  tags json DEFAULT '[]'
+		);
+		`,
+	), "column 'tags' of table 'users' has a default value, but MySQL doesn't allow a default value for JSON columns\n")
+}
+
 func TestMysqldefSwapColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -557,6 +761,121 @@ func TestMysqldefAddIndexWithKeyLength(t *testing.T) {
 	assertApplyOutput(t, createTable+alterTable, nothingModified)
 }
 
+func TestMysqldefFunctionalIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  email varchar(255) NOT NULL,
+		  UNIQUE KEY index_lower_email ((lower(email)))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  email varchar(255) NOT NULL,
+		  UNIQUE KEY index_lower_email ((upper(email)))
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` DROP INDEX `index_lower_email`;\n"+
+		"ALTER TABLE `users` ADD unique key `index_lower_email` ((upper(email)));\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefAddCheckToColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age int
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Only the check is added without CHANGE COLUMN
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age int CONSTRAINT users_age_check CHECK (age > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` ADD CONSTRAINT `users_age_check` CHECK (age > 0);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age bigint CONSTRAINT users_age_check CHECK (age > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `age` `age` bigint;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age bigint
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` DROP CHECK `users_age_check`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefPrimaryKeyWithIndexOptions(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20) DEFAULT NULL,
+		  PRIMARY KEY USING BTREE (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20) DEFAULT NULL,
+		  CONSTRAINT pk_users PRIMARY KEY (id) USING BTREE
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(20) DEFAULT NULL,
+		  PRIMARY KEY (id) USING HASH
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` DROP PRIMARY KEY;\n"+
+		"ALTER TABLE `users` ADD primary key (`id`) using HASH;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefFulltextIndex(t *testing.T) {
 	resetTestDatabase()
 
@@ -589,6 +908,19 @@ func TestMysqldefFulltextIndex(t *testing.T) {
 	)
 	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `posts` ADD fulltext key `title_fulltext_index` (`title`) WITH parser ngram;\n")
 	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE posts (
+		  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  title varchar(40) DEFAULT NULL,
+		  FULLTEXT KEY title_fulltext_index (title) WITH PARSER ngram COMMENT 'post''s title'
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `posts` DROP INDEX `title_fulltext_index`;\n"+
+		"ALTER TABLE `posts` ADD fulltext key `title_fulltext_index` (`title`) WITH parser ngram comment 'post''s title';\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
 }
 
 func TestMysqldefCreateIndex(t *testing.T) {
@@ -646,6 +978,21 @@ func TestMysqldefCreateTableKey(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefCreateTableUniqueConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id BIGINT PRIMARY KEY,
+		  name varchar(40),
+		  CONSTRAINT uniq_name UNIQUE (name)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefCreateTableWithUniqueColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -784,13 +1131,199 @@ func TestMysqldefCreateTableForeignKey(t *testing.T) {
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 }
 
-func TestMysqldefCreateTableSyntaxError(t *testing.T) {
+func TestMysqldefKeepIndexNeededByForeignKey(t *testing.T) {
 	resetTestDatabase()
-	assertApplyFailure(t, "CREATE TABLE users (id bigint,);", `found syntax error when parsing DDL "CREATE TABLE users (id bigint,)": syntax error at position 32`+"\n")
-}
 
-// Both `AUTO_INCREMENT NOT NULL` and `NOT NULL AUTO_INCREMENT` should work
-func TestMysqldefAutoIncrementNotNull(t *testing.T) {
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint PRIMARY KEY,
+		  user_id bigint,
+		  KEY index_posts_on_user_id (user_id),
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	// The index can't be dropped while the foreign key uses it
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint PRIMARY KEY,
+		  user_id bigint,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	// Another index for the foreign key makes the index droppable
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint PRIMARY KEY,
+		  user_id bigint,
+		  KEY index_posts_on_user_id_and_id (user_id, id),
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		"ALTER TABLE `posts` ADD key `index_posts_on_user_id_and_id` (`user_id`, `id`);\n"+
+		"ALTER TABLE `posts` DROP INDEX `index_posts_on_user_id`;\n",
+	)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestMysqldefForeignKeyColumnPairing(t *testing.T) {
+	resetTestDatabase()
+
+	createParents := "CREATE TABLE parents (x int, y int, KEY idx_xy (x, y), KEY idx_yx (y, x));\n"
+	createChildren := stripHeredoc(`
+		CREATE TABLE children (
+		  a int,
+		  b int,
+		  CONSTRAINT fk FOREIGN KEY (a, b) REFERENCES parents (x, y)
+		);
+		`,
+	)
+	assertApplyOutput(t, createParents+createChildren, applyPrefix+createParents+createChildren)
+	assertApplyOutput(t, createParents+createChildren, nothingModified)
+
+	// Listing the same pairs in another order is not a change
+	createChildren = stripHeredoc(`
+		CREATE TABLE children (
+		  a int,
+		  b int,
+		  CONSTRAINT fk FOREIGN KEY (b, a) REFERENCES parents (y, x)
+		);
+		`,
+	)
+	assertApplyOutput(t, createParents+createChildren, nothingModified)
+
+	// Swapping the pairing recreates the foreign key
+	createChildren = stripHeredoc(`
+		CREATE TABLE children (
+		  a int,
+		  b int,
+		  CONSTRAINT fk FOREIGN KEY (a, b) REFERENCES parents (y, x)
+		);
+		`,
+	)
+	assertApplyOutput(t, createParents+createChildren, applyPrefix+
+		"ALTER TABLE `children` DROP FOREIGN KEY `fk`;\n"+
+		"ALTER TABLE `children` ADD CONSTRAINT `fk` FOREIGN KEY (`a`,`b`) REFERENCES `parents` (`y`,`x`);\n")
+	assertApplyOutput(t, createParents+createChildren, nothingModified)
+}
+
+func TestMysqldefChangeForeignKeyReference(t *testing.T) {
+	resetTestDatabase()
+
+	createParents := stripHeredoc(`
+		CREATE TABLE users (id bigint NOT NULL, PRIMARY KEY (id));
+		CREATE TABLE admins (id bigint NOT NULL, PRIMARY KEY (id));
+		`,
+	)
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createParents+createPosts, applyPrefix+createParents+createPosts)
+	assertApplyOutput(t, createParents+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES admins (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createParents+createPosts, applyPrefix+
+		"ALTER TABLE `posts` DROP FOREIGN KEY `posts_user_fk`;\n"+
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_user_fk` FOREIGN KEY (`user_id`) REFERENCES `admins` (`id`);\n")
+	assertApplyOutput(t, createParents+createPosts, nothingModified)
+}
+
+func TestMysqldefCircularForeignKeys(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, best_post_id bigint, CONSTRAINT users_best_post_fk FOREIGN KEY (best_post_id) REFERENCES posts (id));\n"
+	createPosts := "CREATE TABLE posts (id bigint NOT NULL PRIMARY KEY, user_id bigint, CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id));\n"
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		"SET FOREIGN_KEY_CHECKS = 0;\n"+
+		createUsers+
+		createPosts+
+		"SET FOREIGN_KEY_CHECKS = 1;\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestMysqldefForeignKeyReferenceOptions(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id bigint NOT NULL, PRIMARY KEY (id));\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	// RESTRICT, NO ACTION and omitting them are the same in MySQL
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT ON UPDATE NO ACTION
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE ON UPDATE SET NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		"ALTER TABLE `posts` DROP FOREIGN KEY `posts_user_fk`;\n"+
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE ON UPDATE SET NULL;\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE NO ACTION ON UPDATE RESTRICT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		"ALTER TABLE `posts` DROP FOREIGN KEY `posts_user_fk`;\n"+
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_user_fk` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE NO ACTION ON UPDATE RESTRICT;\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestMysqldefCreateTableSyntaxError(t *testing.T) {
+	resetTestDatabase()
+	assertApplyFailure(t, "CREATE TABLE users (id bigint,);", `found syntax error when parsing DDL "CREATE TABLE users (id bigint,)": syntax error at position 32`+"\n")
+}
+
+func TestMysqldefCreateTableNullablePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+	assertApplyFailure(t, "CREATE TABLE users (id bigint NULL PRIMARY KEY);",
+		"primary key column 'id' of table 'users' is declared as NULL, but primary key columns must be NOT NULL\n")
+	assertApplyFailure(t, "CREATE TABLE users (id bigint NULL, name varchar(20), PRIMARY KEY (id, name));",
+		"primary key column 'id' of table 'users' is declared as NULL, but primary key columns must be NOT NULL\n")
+}
+
+// Both `AUTO_INCREMENT NOT NULL` and `NOT NULL AUTO_INCREMENT` should work
+func TestMysqldefAutoIncrementNotNull(t *testing.T) {
 	resetTestDatabase()
 	createTable1 := stripHeredoc(`
 		CREATE TABLE users1 (
@@ -846,6 +1379,74 @@ func TestMysqldefKeywordIndexColumns(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefChangeColumnRename(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL DEFAULT 'none' COMMENT 'user name'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  username varchar(40) NOT NULL DEFAULT 'none' COMMENT 'user name' -- @renamed from=name
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `name` `username` varchar(40) NOT NULL DEFAULT 'none' COMMENT 'user name';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefChangeColumnComment(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL COMMENT 'user name'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL COMMENT 'user''s display name'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) NOT NULL COMMENT 'user''s display name';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) NOT NULL;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// MySQL doesn't show an empty comment, which is the same as no comment
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL COMMENT ''
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefMysqlComment(t *testing.T) {
 	resetTestDatabase()
 
@@ -1067,6 +1668,33 @@ func TestMysqldefEnumValues(t *testing.T) {
 	)
 	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` ADD COLUMN `authorities` enum('normal', 'admin') NOT NULL DEFAULT 'normal' AFTER `id`;\n")
 	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint(20) NOT NULL,
+		  authorities enum('normal', 'admin', 'owner') NOT NULL DEFAULT 'normal',
+		  flags set('read', 'write')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`authorities` `authorities`"+` enum('normal', 'admin', 'owner') NOT NULL DEFAULT 'normal';
+		ALTER TABLE `+"`users`"+` ADD COLUMN `+"`flags`"+` set('read', 'write') AFTER `+"`authorities`"+`;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint(20) NOT NULL,
+		  authorities enum('normal', 'admin', 'owner') NOT NULL DEFAULT 'normal',
+		  flags set('write', 'read', 'execute')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `flags` `flags` set('write', 'read', 'execute');\n")
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
 }
 
 func TestMysqldefView(t *testing.T) {
@@ -1107,6 +1735,36 @@ func TestMysqldefView(t *testing.T) {
 	assertApplyOutput(t, "", applyPrefix+"DROP TABLE `posts`;\nDROP TABLE `users`;\nDROP VIEW `foo`;\n")
 }
 
+func TestMysqldefTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age int
+		);
+		`,
+	)
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_age BEFORE INSERT ON users FOR EACH ROW BEGIN
+		  IF NEW.age < 0 THEN
+		    SET NEW.age = 0;
+		  END IF;
+		END;
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTrigger = "CREATE TRIGGER users_age BEFORE UPDATE ON users FOR EACH ROW SET NEW.age = GREATEST(NEW.age, 0);\n"
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+"DROP TRIGGER `users_age`;\n"+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"DROP TRIGGER `users_age`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefDefaultValue(t *testing.T) {
 	resetTestDatabase()
 
@@ -1149,11 +1807,135 @@ func TestMysqldefDefaultValue(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `tools` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL;\n"+
+		"ALTER TABLE `tools` ALTER COLUMN `created_at` DROP DEFAULT;\n"+
 		"ALTER TABLE `tools` CHANGE COLUMN `updated_at` `updated_at` datetime NOT NULL;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefChangeDefaultOnly(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL DEFAULT 'none',
+		  age int DEFAULT 0
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL DEFAULT 'anonymous',
+		  age int
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ALTER COLUMN `name` SET DEFAULT 'anonymous';\n"+
+		"ALTER TABLE `users` ALTER COLUMN `age` DROP DEFAULT;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// A type change still needs CHANGE COLUMN
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40) NOT NULL DEFAULT 'anonymous',
+		  age bigint DEFAULT 20
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` CHANGE COLUMN `age` `age` bigint DEFAULT 20;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefDefaultKeywordCase(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE tools (
+		  name varchar(255) DEFAULT null,
+		  created_at datetime NOT NULL DEFAULT current_timestamp,
+		  updated_at datetime NOT NULL DEFAULT current_timestamp ON UPDATE current_timestamp
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE tools (
+		  name varchar(255) DEFAULT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  updated_at datetime NOT NULL DEFAULT Current_Timestamp ON UPDATE CURRENT_TIMESTAMP
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefOnUpdateWithPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP(0),
+		  updated_at datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  updated_at datetime(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` CHANGE COLUMN `created_at` `created_at` datetime NOT NULL DEFAULT current_timestamp;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestMysqldefTextDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  profile text DEFAULT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	assertApplyFailure(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  profile text DEFAULT ''
+		);
+		`,
+	), "column 'profile' of table 'users' has a default value, but MySQL doesn't allow a default value for TEXT columns\n")
+
+	assertApplyFailure(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  profile text DEFAULT NULL,
+		  avatar blob DEFAULT 'none'
+		);
+		`,
+	), "column 'avatar' of table 'users' has a default value, but MySQL doesn't allow a default value for BLOB columns\n")
+}
+
 func TestMysqldefNegativeDefault(t *testing.T) {
 	resetTestDatabase()
 
@@ -1173,7 +1955,20 @@ func TestMysqldefNegativeDefault(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		"ALTER TABLE `items` CHANGE COLUMN `position` `position` float DEFAULT 100;\n")
+		"ALTER TABLE `items` ALTER COLUMN `position` SET DEFAULT 100;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  position float DEFAULT 100,
+		  score decimal(5, 2) DEFAULT -2,
+		  ratio double DEFAULT -0.125
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `items` ADD COLUMN `score` decimal(5, 2) DEFAULT -2 AFTER `position`;\n"+
+		"ALTER TABLE `items` ADD COLUMN `ratio` double DEFAULT -0.125 AFTER `score`;\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
@@ -1190,6 +1985,31 @@ func TestMysqldefDecimalDefault(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefChangeDecimalScale(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  price decimal(10, 2),
+		  amount decimal(5)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  price decimal(10, 4),
+		  amount decimal(5)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `items` CHANGE COLUMN `price` `price` decimal(10, 4);\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefIndexWithDot(t *testing.T) {
 	resetTestDatabase()
 
@@ -1238,6 +2058,51 @@ func TestMysqldefChangeIndexCombination(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestMysqldefGeneratedColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE products (
+		  id bigint NOT NULL,
+		  price int,
+		  total int
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE products (
+		  id bigint NOT NULL,
+		  price int,
+		  total int AS (price * 2)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`products`"+` DROP COLUMN `+"`total`"+`;
+		ALTER TABLE `+"`products`"+` ADD COLUMN `+"`total`"+` int GENERATED ALWAYS AS (price * 2) VIRTUAL AFTER `+"`price`"+`;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE products (
+		  id bigint NOT NULL,
+		  price int,
+		  total int GENERATED ALWAYS AS (price * 3) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`products`"+` DROP COLUMN `+"`total`"+`;
+		ALTER TABLE `+"`products`"+` ADD COLUMN `+"`total`"+` int GENERATED ALWAYS AS (price * 3) STORED AFTER `+"`price`"+`;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 //
 // ----------------------- following tests are for CLI -----------------------
 //
@@ -1256,6 +2121,45 @@ func TestMysqldefDryRun(t *testing.T) {
 	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
 }
 
+func TestMysqldefMergeAlterTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name varchar(40),
+		  age int,
+		  email varchar(255) NOT NULL,
+		  created_at datetime
+		);
+		`,
+	))
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--merge-alter-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"ALTER TABLE `users` ADD COLUMN `age` int AFTER `name`, ADD COLUMN `email` varchar(255) NOT NULL AFTER `age`, ADD COLUMN `created_at` datetime AFTER `email`;\n",
+	)
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--merge-alter-table", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+
+	// DROP clauses aren't combined with the others, which would be skipped with them
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", "ALTER TABLE users ADD KEY index_name (name);")
+	writeFile("schema.sql", "CREATE TABLE users (id bigint NOT NULL, name varchar(40), age int, email varchar(255) NOT NULL, created_at datetime, KEY index_age (age));\n")
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--merge-alter-table", "--skip-drop", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"ALTER TABLE `users` ADD key `index_age` (`age`);\n"+
+		"-- Skipped: ALTER TABLE `users` DROP INDEX `index_name`;\n",
+	)
+}
+
 func TestMysqldefExport(t *testing.T) {
 	resetTestDatabase()
 	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
@@ -1276,6 +2180,116 @@ func TestMysqldefExport(t *testing.T) {
 	)
 }
 
+func TestMysqldefExportAutoIncrement(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) DEFAULT CHARSET=latin1 AUTO_INCREMENT=1000;`,
+	))
+
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertEquals(t, out,
+		"CREATE TABLE `users` (\n"+
+			"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  PRIMARY KEY (`id`)\n"+
+			") ENGINE=InnoDB DEFAULT CHARSET=latin1;\n",
+	)
+
+	out = assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export", "--include-auto-increment")
+	assertEquals(t, out,
+		"CREATE TABLE `users` (\n"+
+			"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  PRIMARY KEY (`id`)\n"+
+			") ENGINE=InnoDB AUTO_INCREMENT=1000 DEFAULT CHARSET=latin1;\n",
+	)
+
+	// Lowering AUTO_INCREMENT is ignored since it has grown with inserted rows
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) DEFAULT CHARSET=latin1 AUTO_INCREMENT=10;
+		`,
+	), nothingModified)
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY
+		) DEFAULT CHARSET=latin1 AUTO_INCREMENT=2000;
+		`,
+	), applyPrefix+"ALTER TABLE `users` AUTO_INCREMENT = 2000;\n")
+}
+
+func TestMysqldefExportRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(40) NOT NULL DEFAULT '',
+		  age int DEFAULT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  PRIMARY KEY (id),
+		  UNIQUE KEY index_name (name)
+		);
+		CREATE TABLE posts (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  user_id bigint NOT NULL,
+		  PRIMARY KEY (id),
+		  KEY index_user_id (user_id),
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+		);
+		CREATE VIEW adult_users AS SELECT id, name FROM users WHERE age >= 20;`,
+	))
+	assertExportRoundTrip(t)
+}
+
+func TestMysqldefExportRoundTripManyColumns(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(40) NOT NULL DEFAULT '',
+		  email varchar(255) NOT NULL,
+		  age int DEFAULT NULL,
+		  height double DEFAULT NULL,
+		  weight double DEFAULT NULL,
+		  bio text,
+		  active tinyint(1) NOT NULL DEFAULT 1,
+		  role enum('normal', 'admin') NOT NULL DEFAULT 'normal',
+		  last_login_at datetime DEFAULT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		  PRIMARY KEY (id)
+		);`,
+	))
+	assertExportRoundTrip(t)
+
+	// Dropping a column and adding another one doesn't move the columns in between
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL AUTO_INCREMENT,
+		  name varchar(40) NOT NULL DEFAULT '',
+		  age int DEFAULT NULL,
+		  height double DEFAULT NULL,
+		  weight double DEFAULT NULL,
+		  bio text,
+		  active tinyint(1) NOT NULL DEFAULT 1,
+		  role enum('normal', 'admin') NOT NULL DEFAULT 'normal',
+		  last_login_at datetime DEFAULT NULL,
+		  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		  updated_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		  deleted_at datetime DEFAULT NULL,
+		  PRIMARY KEY (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"ALTER TABLE `users` ADD COLUMN `deleted_at` datetime DEFAULT null AFTER `updated_at`;\n"+
+		"ALTER TABLE `users` DROP COLUMN `email`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefSkipDrop(t *testing.T) {
 	resetTestDatabase()
 	mustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
@@ -1287,8 +2301,8 @@ func TestMysqldefSkipDrop(t *testing.T) {
 
 	writeFile("schema.sql", "")
 
-	skipDrop := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--skip-drop", "--file", "schema.sql")
-	apply := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	skipDrop := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--skip-drop", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	apply := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	assertEquals(t, skipDrop, strings.Replace(apply, "DROP", "-- Skipped: DROP", 1))
 }
 
@@ -1314,20 +2328,27 @@ func TestMain(m *testing.M) {
 func assertApply(t *testing.T, schema string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 }
 
 func assertApplyOutput(t *testing.T, schema string, expected string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	actual := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	assertEquals(t, actual, expected)
 }
 
+// Applying the output of `--export` should be always "Nothing is modified".
+func assertExportRoundTrip(t *testing.T) {
+	t.Helper()
+	out := assertedExecute(t, "mysqldef", "-uroot", "mysqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
 func assertApplyFailure(t *testing.T, schema string, expected string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	actual, err := execute("mysqldef", "-uroot", "mysqldef_test", "--file", "schema.sql")
+	actual, err := execute("mysqldef", "-uroot", "mysqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	if err == nil {
 		t.Errorf("expected 'mysqldef -uroot mysqldef_test --file schema.sql' to fail but succeeded with: %s", actual)
 	}
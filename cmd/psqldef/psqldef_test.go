@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -44,6 +45,22 @@ func TestPsqldefCreateTable(t *testing.T) {
 	assertApplyOutput(t, createTable1, nothingModified)
 }
 
+func TestPsqldefCreateTableInSchema(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE myapp.users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE myapp.posts (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`CREATE SCHEMA IF NOT EXISTS "myapp";`+"\n"+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqldefCreateTableWithDefault(t *testing.T) {
 	resetTestDatabase()
 
@@ -94,6 +111,210 @@ func TestPsqldefCreateTableChangeDefault(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefNegativeDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  position integer DEFAULT -20,
+		  score numeric(5, 2) DEFAULT -2,
+		  ratio double precision DEFAULT -0.125
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  position integer DEFAULT -1,
+		  score numeric(5, 2) DEFAULT -2.5,
+		  ratio double precision DEFAULT -0.125
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."items" ALTER COLUMN "position" SET DEFAULT -1;
+		ALTER TABLE "public"."items" ALTER COLUMN "score" SET DEFAULT -2.5;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefCastedDefault(t *testing.T) {
+	resetTestDatabase()
+
+	// PostgreSQL shows these defaults with casts like '{}'::jsonb
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  attributes jsonb DEFAULT '{}',
+		  tags text[] DEFAULT '{}',
+		  code uuid DEFAULT '00000000-0000-0000-0000-000000000000'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  attributes jsonb DEFAULT '[]'::jsonb,
+		  tags text[] DEFAULT '{}'::text[],
+		  code uuid DEFAULT '00000000-0000-0000-0000-000000000000'::uuid
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."items" ALTER COLUMN "attributes" SET DEFAULT '[]';`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefByteaDefault(t *testing.T) {
+	resetTestDatabase()
+
+	// PostgreSQL shows these defaults in the lower-case hex format like '\xabcd'::bytea
+	createTable := stripHeredoc(`
+		CREATE TABLE files (
+		  header bytea DEFAULT '\x1234',
+		  magic bytea DEFAULT '\xABCD',
+		  label bytea DEFAULT 'abc'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE files (
+		  header bytea DEFAULT '\x5678'::bytea,
+		  magic bytea DEFAULT '\xabcd'::bytea,
+		  label bytea DEFAULT '\x616263'::bytea
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."files" ALTER COLUMN "header" SET DEFAULT '\x5678';`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefFunctionCallDefault(t *testing.T) {
+	resetTestDatabase()
+
+	// PostgreSQL shows the arguments with casts like concat('a'::text, 'b'::text)
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name text DEFAULT concat('a', 'b'),
+		  code text DEFAULT lpad('1', 4, '0')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name text DEFAULT concat('a', 'c'),
+		  code text DEFAULT lpad('1', 4, '0')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" SET DEFAULT (concat('a', 'c'));`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefParenthesizedDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer DEFAULT (1),
+		  name text DEFAULT ('none')
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefBackslashInCheck(t *testing.T) {
+	resetTestDatabase()
+
+	// A backslash isn't an escape character in a standard string
+	createDomain := "CREATE DOMAIN code AS text CHECK (VALUE ~ '^\\d+$');\n"
+	assertApplyOutput(t, createDomain, applyPrefix+createDomain)
+	assertApplyOutput(t, createDomain, nothingModified)
+
+	createDomain = "CREATE DOMAIN code AS text CHECK (VALUE ~ '^\\d{4}$');\n"
+	assertApplyOutput(t, createDomain, applyPrefix+stripHeredoc(`
+		ALTER DOMAIN "public"."code" DROP CONSTRAINT "code_check";
+		ALTER DOMAIN "public"."code" ADD CHECK (VALUE ~ '^\d{4}$');
+		`,
+	))
+	assertApplyOutput(t, createDomain, nothingModified)
+}
+
+func TestPsqldefChangeNumericScale(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  price numeric(10, 2),
+		  amount numeric(5),
+		  ratio numeric
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE items (
+		  price numeric(10, 4),
+		  amount numeric(5),
+		  ratio numeric
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."items" ALTER COLUMN "price" TYPE numeric(10, 4);`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefDefaultKeywordCase(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name text default null,
+		  active bool default true,
+		  deleted bool default false,
+		  created_at timestamp default current_timestamp,
+		  created_on date default current_date
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name text DEFAULT NULL,
+		  active bool DEFAULT TRUE,
+		  deleted bool DEFAULT False,
+		  created_at timestamp DEFAULT CURRENT_TIMESTAMP,
+		  created_on date DEFAULT CURRENT_DATE
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqldefCreateTableAlterColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -121,6 +342,62 @@ func TestPsqldefCreateTableAlterColumn(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefAlterColumnTypeWithCast(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age varchar(10)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE integer USING "age"::integer;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefRenameColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		CREATE INDEX index_name ON users (name);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  username text -- @renamed_from name
+		);
+		CREATE INDEX index_name ON users (username);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" RENAME COLUMN "name" TO "username";
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqldefCreateTableNotNull(t *testing.T) {
 	resetTestDatabase()
 
@@ -247,6 +524,36 @@ func TestPsqldefCreateTableConstraintPrimaryKey(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefCreateTablePrimaryKeyWithStorageParameters(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  PRIMARY KEY (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  PRIMARY KEY (id) WITH (fillfactor = 70)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" DROP CONSTRAINT "users_pkey";
+		ALTER TABLE "public"."users" ADD primary key ("id") WITH (fillfactor = 70);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqldefCreateTableForeignKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -270,7 +577,7 @@ func TestPsqldefCreateTableForeignKey(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
-		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "users" ("id");`+"\n",
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id");`+"\n",
 	)
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 
@@ -284,7 +591,7 @@ func TestPsqldefCreateTableForeignKey(t *testing.T) {
 	)
 	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
 		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_ibfk_1";`+"\n"+
-		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;`+"\n",
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_ibfk_1" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON DELETE SET NULL ON UPDATE CASCADE;`+"\n",
 	)
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 
@@ -299,69 +606,206 @@ func TestPsqldefCreateTableForeignKey(t *testing.T) {
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 }
 
-func TestPsqldefAddForeignKey(t *testing.T) {
+func TestPsqldefChangeForeignKeyReference(t *testing.T) {
 	resetTestDatabase()
 
-	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createParents := stripHeredoc(`
+		CREATE TABLE users (id bigint PRIMARY KEY);
+		CREATE TABLE admins (id bigint PRIMARY KEY);
+		`,
+	)
 	createPosts := stripHeredoc(`
 		CREATE TABLE posts (
-		  content text,
 		  user_id bigint,
-		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id)
 		);
 		`,
 	)
-	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
-	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+	assertApplyOutput(t, createParents+createPosts, applyPrefix+createParents+createPosts)
+	assertApplyOutput(t, createParents+createPosts, nothingModified)
 
+	// A schema-qualified reference is the same table
 	createPosts = stripHeredoc(`
 		CREATE TABLE posts (
-		  content text,
-		  user_id bigint
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES public.users (id)
 		);
 		`,
 	)
-	addForeignKey := "ALTER TABLE ONLY public.posts ADD CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE;\n"
-	assertApplyOutput(t, createUsers+createPosts+addForeignKey, nothingModified)
-
-}
-
-func TestPsqldefCreateTableWithReferences(t *testing.T) {
-	resetTestDatabase()
+	assertApplyOutput(t, createParents+createPosts, nothingModified)
 
-	createTableA := stripHeredoc(`
-		CREATE TABLE a (
-		  a_id INTEGER PRIMARY KEY,
-		  my_text TEXT UNIQUE NOT NULL
-		);
-		`,
-	)
-	createTableB := stripHeredoc(`
-		CREATE TABLE b (
-		  b_id INTEGER PRIMARY KEY,
-		  a_id INTEGER REFERENCES a,
-		  a_my_text TEXT NOT NULL REFERENCES a (my_text)
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES admins (id)
 		);
 		`,
 	)
-	assertApplyOutput(t, createTableA+createTableB, applyPrefix+createTableA+createTableB)
-	assertApplyOutput(t, createTableA+createTableB, nothingModified)
+	assertApplyOutput(t, createParents+createPosts, applyPrefix+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."admins" ("id");`+"\n")
+	assertApplyOutput(t, createParents+createPosts, nothingModified)
 
-	createTableB = stripHeredoc(`
-		CREATE TABLE b (
-		  b_id INTEGER PRIMARY KEY,
-		  a_id INTEGER,
-		  a_my_text TEXT NOT NULL
+	// The reference moves to a table of another schema
+	createOtherUsers := "CREATE TABLE other.users (id bigint PRIMARY KEY);\n"
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES other.users (id)
 		);
 		`,
 	)
-	assertApplyOutput(t, createTableA+createTableB, applyPrefix+
-		`ALTER TABLE "public"."b" DROP CONSTRAINT "b_a_id_fkey";`+"\n"+
-		`ALTER TABLE "public"."b" DROP CONSTRAINT "b_a_my_text_fkey";`+"\n")
-	assertApplyOutput(t, createTableA+createTableB, nothingModified)
+	assertApplyOutput(t, createParents+createOtherUsers+createPosts, applyPrefix+
+		`CREATE SCHEMA IF NOT EXISTS "other";`+"\n"+
+		createOtherUsers+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "other"."users" ("id");`+"\n")
+	assertApplyOutput(t, createParents+createOtherUsers+createPosts, nothingModified)
 }
 
-func TestPsqldefCreateTableWithCheck(t *testing.T) {
+func TestPsqldefForeignKeyReferenceOptions(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id bigint PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint DEFAULT 0,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET DEFAULT ON UPDATE CASCADE
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	// Unlike MySQL, RESTRICT is not NO ACTION in PostgreSQL
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint DEFAULT 0,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON DELETE RESTRICT;`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint DEFAULT 0,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE NO ACTION
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") ON DELETE NO ACTION;`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestPsqldefDeferrableForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id bigint PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) DEFERRABLE INITIALLY DEFERRED
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+	assertExportRoundTrip(t)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) DEFERRABLE
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id") DEFERRABLE;`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  user_id bigint,
+		  CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id) NOT DEFERRABLE
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk";`+"\n"+
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "public"."users" ("id");`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestPsqldefAddForeignKey(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  content text,
+		  user_id bigint,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE
+		);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createPosts = stripHeredoc(`
+		CREATE TABLE posts (
+		  content text,
+		  user_id bigint
+		);
+		`,
+	)
+	addForeignKey := "ALTER TABLE ONLY public.posts ADD CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE;\n"
+	assertApplyOutput(t, createUsers+createPosts+addForeignKey, nothingModified)
+
+}
+
+func TestPsqldefCreateTableWithReferences(t *testing.T) {
+	resetTestDatabase()
+
+	createTableA := stripHeredoc(`
+		CREATE TABLE a (
+		  a_id INTEGER PRIMARY KEY,
+		  my_text TEXT UNIQUE NOT NULL
+		);
+		`,
+	)
+	createTableB := stripHeredoc(`
+		CREATE TABLE b (
+		  b_id INTEGER PRIMARY KEY,
+		  a_id INTEGER REFERENCES a,
+		  a_my_text TEXT NOT NULL REFERENCES a (my_text)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTableA+createTableB, applyPrefix+createTableA+createTableB)
+	assertApplyOutput(t, createTableA+createTableB, nothingModified)
+
+	createTableB = stripHeredoc(`
+		CREATE TABLE b (
+		  b_id INTEGER PRIMARY KEY,
+		  a_id INTEGER,
+		  a_my_text TEXT NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTableA+createTableB, applyPrefix+
+		`ALTER TABLE "public"."b" DROP CONSTRAINT "b_a_id_fkey";`+"\n"+
+		`ALTER TABLE "public"."b" DROP CONSTRAINT "b_a_my_text_fkey";`+"\n")
+	assertApplyOutput(t, createTableA+createTableB, nothingModified)
+}
+
+func TestPsqldefCreateTableWithCheck(t *testing.T) {
 	resetTestDatabase()
 
 	createTable := stripHeredoc(`
@@ -382,8 +826,8 @@ func TestPsqldefCreateTableWithCheck(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		`ALTER TABLE "public"."a" DROP CONSTRAINT a_a_id_check;`+"\n"+
-		`ALTER TABLE "public"."a" ADD CONSTRAINT a_a_id_check CHECK (a_id > 1);`+"\n")
+		`ALTER TABLE "public"."a" DROP CONSTRAINT "a_a_id_check";`+"\n"+
+		`ALTER TABLE "public"."a" ADD CONSTRAINT "a_a_id_check" CHECK (a_id > 1);`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
@@ -394,208 +838,1124 @@ func TestPsqldefCreateTableWithCheck(t *testing.T) {
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		`ALTER TABLE "public"."a" DROP CONSTRAINT a_a_id_check;`+"\n")
+		`ALTER TABLE "public"."a" DROP CONSTRAINT "a_a_id_check";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE a (
+		  a_id INTEGER PRIMARY KEY CHECK (a_id > 2) NO INHERIT,
+		  my_text TEXT UNIQUE NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."a" ADD CONSTRAINT "a_a_id_check" CHECK (a_id > 2) NO INHERIT;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE a (
+		  a_id INTEGER PRIMARY KEY CHECK (a_id > 3) NO INHERIT,
+		  my_text TEXT UNIQUE NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."a" DROP CONSTRAINT "a_a_id_check";`+"\n"+
+		`ALTER TABLE "public"."a" ADD CONSTRAINT "a_a_id_check" CHECK (a_id > 3) NO INHERIT;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefChangeCheckWithNonDefaultName(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE TABLE users (id integer PRIMARY KEY, age integer CONSTRAINT positive_age CHECK (age > 0));")
+	assertApplyOutput(t, "CREATE TABLE users (id integer PRIMARY KEY, age integer CHECK (age > 0));\n", nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable := "CREATE TABLE users (id integer PRIMARY KEY, age integer CHECK (age >= 0));\n"
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."users" DROP CONSTRAINT "positive_age";`+"\n"+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "users_age_check" CHECK (age >= 0);`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefCheckNoInheritRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  age integer CHECK (age > 0) NO INHERIT,
+		  score integer,
+		  CONSTRAINT users_check CHECK (age < score) NO INHERIT
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	// pg_dump style, with redundant parentheses
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  age integer,
+		  score integer,
+		  CONSTRAINT users_age_check CHECK ((age > 0)) NO INHERIT,
+		  CONSTRAINT users_check CHECK ((age < score)) NO INHERIT
+		);
+		`,
+	), nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  age integer CHECK (age > 0),
+		  score integer,
+		  CONSTRAINT users_check CHECK (age < score)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."users" DROP CONSTRAINT "users_age_check";`+"\n"+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "users_age_check" CHECK (age > 0);`+"\n"+
+		`ALTER TABLE "public"."users" DROP CONSTRAINT "users_check";`+"\n"+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "users_check" CHECK (age < score);`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefCreateTableWithTableCheck(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  starts_at integer,
+		  ends_at integer,
+		  CONSTRAINT orders_period CHECK (starts_at < ends_at)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  starts_at integer,
+		  ends_at integer,
+		  CONSTRAINT orders_period CHECK (starts_at <= ends_at),
+		  CHECK (starts_at + 10 > ends_at)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" DROP CONSTRAINT "orders_period";`+"\n"+
+		`ALTER TABLE "public"."orders" ADD CONSTRAINT "orders_period" CHECK (starts_at <= ends_at);`+"\n"+
+		`ALTER TABLE "public"."orders" ADD CONSTRAINT "orders_check" CHECK (starts_at + 10 > ends_at);`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  starts_at integer,
+		  ends_at integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" DROP CONSTRAINT "orders_check";`+"\n"+
+		`ALTER TABLE "public"."orders" DROP CONSTRAINT "orders_period";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefExclusionConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE reservations (
+		  id integer NOT NULL,
+		  during tsrange,
+		  EXCLUDE USING gist (during WITH &&)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE reservations (
+		  id integer NOT NULL,
+		  during tsrange,
+		  CONSTRAINT reservations_during_excl EXCLUDE USING gist (during WITH &&) WHERE (id > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."reservations" DROP CONSTRAINT "reservations_during_excl";`+"\n"+
+		`ALTER TABLE "public"."reservations" ADD CONSTRAINT "reservations_during_excl" EXCLUDE USING gist ("during" WITH &&) WHERE (id > 0);`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE reservations (
+		  id integer NOT NULL,
+		  during tsrange
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."reservations" DROP CONSTRAINT "reservations_during_excl";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefRenameCheckConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  starts_at integer,
+		  ends_at integer,
+		  CHECK (starts_at < ends_at)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  starts_at integer,
+		  ends_at integer,
+		  CONSTRAINT orders_period CHECK (starts_at < ends_at)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" RENAME CONSTRAINT "orders_check" TO "orders_period";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefRenameColumnCheckConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  amount integer CHECK (amount > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL,
+		  amount integer CONSTRAINT orders_amount_positive CHECK (amount > 0)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" RENAME CONSTRAINT "orders_amount_check" TO "orders_amount_positive";`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqlddefCreatePolicy(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100));\n"
+
+	assertApplyOutput(t, createUsers, applyPrefix+createUsers)
+	assertApplyOutput(t, createUsers, nothingModified)
+
+	createPolicy := stripHeredoc(`
+		CREATE POLICY p_users ON users AS PERMISSIVE FOR ALL TO PUBLIC USING (id = (current_user)::integer) WITH CHECK ((current_user)::integer = 1);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+
+		"CREATE POLICY p_users ON users AS PERMISSIVE FOR ALL TO PUBLIC USING (id = (current_user)::integer) WITH CHECK ((current_user)::integer = 1);\n",
+	)
+	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+
+	createPolicy = stripHeredoc(`
+		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (id = (current_user)::integer);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+stripHeredoc(`
+		DROP POLICY "p_users" ON "public"."users";
+		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (id = (current_user)::integer);
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+
+	createPolicy = stripHeredoc(`
+		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+stripHeredoc(`
+		DROP POLICY "p_users" ON "public"."users";
+		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+
+	assertApplyOutput(t, createUsers, applyPrefix+`DROP POLICY "p_users" ON "public"."users";`+"\n")
+	assertApplyOutput(t, createUsers, nothingModified)
+}
+
+func TestPsqldefPartitionBy(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE measurements (
+		  id bigint NOT NULL,
+		  created_at timestamp NOT NULL
+		) PARTITION BY RANGE (created_at);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c",
+		"CREATE TABLE measurements_2020 PARTITION OF measurements FOR VALUES FROM ('2020-01-01') TO ('2021-01-01')")
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE measurements (
+		  id bigint NOT NULL,
+		  created_at timestamp NOT NULL,
+		  value integer
+		) PARTITION BY RANGE (created_at);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."measurements" ADD COLUMN "value" integer;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// Postgres can't change the partition key in place
+	changedTable := strings.Replace(createTable, "RANGE (created_at)", "LIST (id)", 1)
+	assertApplyFailure(t, changedTable, "changing the partitioning of table 'public.measurements' is not supported "+
+		"(current: 'partition by range (created_at)', desired: 'partition by list (id)'): '"+strings.TrimSuffix(changedTable, ";\n")+"'\n")
+}
+
+func TestPsqldefCreateView(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100));\n"
+	createPosts := "CREATE TABLE posts (id BIGINT PRIMARY KEY, name character varying(100), user_id BIGINT, is_deleted boolean);\n"
+
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+
+	createView := stripHeredoc(`
+		CREATE VIEW view_user_posts AS SELECT p.id FROM (posts as p JOIN users as u ON ((p.user_id = u.id)));
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts+createView, applyPrefix+
+		"CREATE VIEW view_user_posts AS SELECT p.id FROM (posts as p JOIN users as u ON ((p.user_id = u.id)));\n",
+	)
+	assertApplyOutput(t, createUsers+createPosts+createView, nothingModified)
+
+	createView = stripHeredoc(`
+		CREATE VIEW view_user_posts AS SELECT p.id from (posts p INNER JOIN users u ON ((p.user_id = u.id))) WHERE (p.is_deleted = FALSE);
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts+createView, applyPrefix+stripHeredoc(`
+		CREATE OR REPLACE VIEW "public"."view_user_posts" AS select p.id from (posts as p join users as u on ((p.user_id = u.id))) where (p.is_deleted = false);
+		`,
+	))
+	assertApplyOutput(t, createUsers+createPosts+createView, nothingModified)
+
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+`DROP VIEW "public"."view_user_posts";`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestPsqldefMaterializedView(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100), is_deleted boolean);\n"
+	assertApplyOutput(t, createUsers, applyPrefix+createUsers)
+
+	createView := "CREATE MATERIALIZED VIEW user_names AS SELECT users.id, users.name FROM users;\n"
+	createIndex := "CREATE UNIQUE INDEX user_names_id ON user_names (id);\n"
+	assertApplyOutput(t, createUsers+createView+createIndex, applyPrefix+createView+createIndex)
+	assertApplyOutput(t, createUsers+createView+createIndex, nothingModified)
+	assertExportRoundTrip(t)
+
+	createView = "CREATE MATERIALIZED VIEW user_names AS SELECT users.id, users.name FROM users WHERE (users.is_deleted = false);\n"
+	assertApplyOutput(t, createUsers+createView+createIndex, applyPrefix+
+		`DROP MATERIALIZED VIEW "public"."user_names";`+"\n"+
+		createView+createIndex,
+	)
+	assertApplyOutput(t, createUsers+createView+createIndex, nothingModified)
+
+	assertApplyOutput(t, createUsers+createView, applyPrefix+`DROP INDEX "user_names_id";`+"\n")
+	assertApplyOutput(t, createUsers+createView, nothingModified)
+
+	assertApplyOutput(t, createUsers, applyPrefix+`DROP MATERIALIZED VIEW "public"."user_names";`+"\n")
+	assertApplyOutput(t, createUsers, nothingModified)
+}
+
+func TestPsqldefChangeViewToMaterializedView(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100));\n"
+	createView := "CREATE VIEW user_names AS SELECT users.id, users.name FROM users;\n"
+	assertApplyOutput(t, createUsers+createView, applyPrefix+createUsers+createView)
+	assertApplyOutput(t, createUsers+createView, nothingModified)
+
+	// CREATE OR REPLACE can't change the kind of a view
+	createMaterializedView := "CREATE MATERIALIZED VIEW user_names AS SELECT users.id, users.name FROM users;\n"
+	createIndex := "CREATE UNIQUE INDEX user_names_id ON user_names (id);\n"
+	assertApplyOutput(t, createUsers+createMaterializedView+createIndex, applyPrefix+
+		`DROP VIEW "public"."user_names";`+"\n"+
+		createMaterializedView+createIndex,
+	)
+	assertApplyOutput(t, createUsers+createMaterializedView+createIndex, nothingModified)
+
+	// The index is dropped with the materialized view
+	assertApplyOutput(t, createUsers+createView, applyPrefix+
+		`DROP MATERIALIZED VIEW "public"."user_names";`+"\n"+
+		createView,
+	)
+	assertApplyOutput(t, createUsers+createView, nothingModified)
+}
+
+func TestPsqldefCreateInDependencyOrder(t *testing.T) {
+	resetTestDatabase()
+
+	createPostIds := "CREATE VIEW post_ids AS SELECT user_posts.id FROM user_posts;\n"
+	createUserPosts := "CREATE VIEW user_posts AS SELECT p.id FROM (posts as p JOIN users as u ON ((p.user_id = u.id)));\n"
+	createPosts := "CREATE TABLE posts (id BIGINT PRIMARY KEY, user_id BIGINT REFERENCES users (id));\n"
+	createIndex := "CREATE INDEX index_posts_on_user_id ON posts (user_id);\n"
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY);\n"
+
+	schema := createPostIds + createUserPosts + createPosts + createIndex + createUsers
+	assertApplyOutput(t, schema, applyPrefix+createUsers+createPosts+createIndex+createUserPosts+createPostIds)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestPsqldefCreateFunctionsInDependencyOrder(t *testing.T) {
+	resetTestDatabase()
+
+	createView := "CREATE VIEW happy_users AS SELECT users.id FROM users WHERE is_happy(users.mood);\n"
+	createFunction := "CREATE FUNCTION is_happy(m mood) RETURNS boolean LANGUAGE sql IMMUTABLE AS $$ SELECT m = 'happy' $$;\n"
+	createTable := "CREATE TABLE users (id bigint NOT NULL, mood mood);\n"
+	createType := "CREATE TYPE mood AS ENUM ('sad', 'happy');\n"
+
+	schema := createView + createFunction + createTable + createType
+	assertApplyOutput(t, schema, applyPrefix+createType+createFunction+createTable+createView)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestPsqldefCircularForeignKeys(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, best_post_id BIGINT, CONSTRAINT users_best_post_fk FOREIGN KEY (best_post_id) REFERENCES posts (id));\n"
+	createPosts := "CREATE TABLE posts (id BIGINT PRIMARY KEY, user_id BIGINT, CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id));\n"
+	writeFile("schema.sql", createUsers+createPosts)
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, out, "-- Warning: table 'public.users' references a table created after it by circular foreign keys; add the foreign key by ALTER TABLE after the tables instead --\n"+
+		"-- dry run --\n"+createUsers+createPosts)
+
+	// An existing table breaks the cycle
+	assertApply(t, "CREATE TABLE users (id BIGINT PRIMARY KEY, best_post_id BIGINT);\n")
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createPosts+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "users_best_post_fk" FOREIGN KEY ("best_post_id") REFERENCES "public"."posts" ("id");`+"\n")
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestPsqldefAlterTableAddCompositePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE friends (
+		  user_id bigint,
+		  friend_id bigint
+		);
+		`,
+	)
+	addPrimaryKey := "ALTER TABLE friends ADD PRIMARY KEY (friend_id, user_id);\n"
+	assertApplyOutput(t, createTable+addPrimaryKey, applyPrefix+createTable+addPrimaryKey)
+	assertApplyOutput(t, createTable+addPrimaryKey, nothingModified)
+
+	// The columns become NOT NULL, and the primary key keeps the declared order
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export")
+	// workaround: local has `public.` but travis doesn't.
+	assertEquals(t, strings.Replace(out, "public.friends", "friends", 2), stripHeredoc(`
+		CREATE TABLE friends (
+		    "user_id" bigint NOT NULL,
+		    "friend_id" bigint NOT NULL,
+		    PRIMARY KEY ("friend_id", "user_id")
+		);
+		`,
+	))
+}
+
+func TestPsqldefDropPrimaryKey(t *testing.T) {
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);`,
+	)
+	assertApply(t, createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" DROP CONSTRAINT "users_pkey";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefAddColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  age integer
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "age" integer;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  age integer
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" DROP COLUMN "name";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefAddArrayColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer,
+		  name integer[]
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "name" integer[];`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefAddColumnWithCollate(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer,
+		  name text COLLATE "C" NOT NULL
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "name" text COLLATE "C" NOT NULL;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer,
+		  name text COLLATE "POSIX" NOT NULL
+		);`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE text COLLATE "POSIX";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefChangeColumnToGenerated(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE products (
+		  id integer PRIMARY KEY,
+		  price integer,
+		  total integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE products (
+		  id integer PRIMARY KEY,
+		  price integer,
+		  total integer GENERATED ALWAYS AS (price * 2) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."products" DROP COLUMN "total";
+		ALTER TABLE "public"."products" ADD COLUMN "total" integer GENERATED ALWAYS AS (price * 2) STORED;
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefDropGenerationExpression(t *testing.T) {
+	resetTestDatabase()
+	if version, _ := strconv.Atoi(strings.TrimSpace(assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SHOW server_version_num;"))); version < 130000 {
+		t.Skip("DROP EXPRESSION is supported by PostgreSQL 13+")
+	}
+
+	createTable := stripHeredoc(`
+		CREATE TABLE products (
+		  id integer PRIMARY KEY,
+		  price integer,
+		  total integer GENERATED ALWAYS AS (price * 2) STORED
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "INSERT INTO products (id, price) VALUES (1, 100);")
+
+	// The column keeps its values as a regular column
+	createTable = stripHeredoc(`
+		CREATE TABLE products (
+		  id integer PRIMARY KEY,
+		  price integer,
+		  total integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."products" ALTER COLUMN "total" DROP EXPRESSION;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+	assertEquals(t, assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SELECT total FROM products;"), "200\n")
+}
+
+func TestPsqldefCommentOnColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		COMMENT ON COLUMN users.name IS 'user name';
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		COMMENT ON COLUMN users.name IS 'user''s display name';
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"COMMENT ON COLUMN users.name IS 'user''s display name';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`COMMENT ON COLUMN "public"."users"."name" IS NULL;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// An empty comment drops the comment as well
+	assertApplyOutput(t, createTable+"COMMENT ON COLUMN users.name IS 'user name';\n", applyPrefix+"COMMENT ON COLUMN users.name IS 'user name';\n")
+	assertApplyOutput(t, createTable+"COMMENT ON COLUMN users.name IS '';\n", applyPrefix+"COMMENT ON COLUMN users.name IS '';\n")
+	assertApplyOutput(t, createTable+"COMMENT ON COLUMN users.name IS '';\n", nothingModified)
+}
+
+func TestPsqldefCommentOnTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		COMMENT ON TABLE users IS 'users';
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		COMMENT ON TABLE users IS 'registered users';
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"COMMENT ON TABLE users IS 'registered users';\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`COMMENT ON TABLE "public"."users" IS NULL;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// An empty comment drops the comment as well
+	assertApplyOutput(t, createTable+"COMMENT ON TABLE users IS 'users';\n", applyPrefix+"COMMENT ON TABLE users IS 'users';\n")
+	assertApplyOutput(t, createTable+"COMMENT ON TABLE users IS '';\n", applyPrefix+"COMMENT ON TABLE users IS '';\n")
+	assertApplyOutput(t, createTable+"COMMENT ON TABLE users IS '';\n", nothingModified)
+}
+
+func TestPsqldefCreateIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  age integer
+		);
+		`,
+	)
+	createIndex1 := `CREATE INDEX "index_name" on users (name);` + "\n"
+	createIndex2 := `CREATE UNIQUE INDEX "index_age" on users (age);` + "\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+
+	createIndex1 = `CREATE INDEX "index_name" on users (name, id);` + "\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+
+	createIndex1 = `CREATE UNIQUE INDEX "index_name" on users (name) WHERE age > 20;` + "\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+`DROP INDEX "index_age";`+"\n"+`DROP INDEX "index_name";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefCreatePartialIndexWithCast(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  name text,
+		  state varchar(20)
+		);
+		`,
+	)
+	createIndex1 := `CREATE INDEX "index_name" on users (name) WHERE name = 'active';` + "\n"
+	createIndex2 := `CREATE INDEX "index_state" on users (id) WHERE state = 'active' AND id > 10;` + "\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+
+	createIndex1 = `CREATE INDEX "index_name" on users (name) WHERE name = 'inactive';` + "\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+}
+
+func TestPsqldefFunctionalIndex(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, email varchar(255));\n"
+	createIndex := "CREATE UNIQUE INDEX index_lower_email ON users (lower(email));\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+	assertExportRoundTrip(t)
+
+	createIndex = "CREATE UNIQUE INDEX index_lower_email ON users ((upper(email)));\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+`DROP INDEX "index_lower_email";`+"\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefIndexColumnCollate(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, name text);\n"
+	createIndex1 := "CREATE INDEX index_name ON users (name COLLATE \"C\", id);\n"
+	createIndex2 := "CREATE INDEX index_lower_name ON users (lower(name) COLLATE \"C\");\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+	assertExportRoundTrip(t)
+
+	createIndex1 = "CREATE INDEX index_name ON users (name, id);\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+}
+
+func TestPsqldefCreatePartialIndexWithLike(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, name text, deleted boolean);\n"
+	createIndex1 := "CREATE INDEX index_name ON users (name) WHERE name LIKE 'a%' AND NOT deleted;\n"
+	createIndex2 := "CREATE INDEX index_id ON users (id) WHERE name NOT LIKE 'b%' OR deleted = false;\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+	assertExportRoundTrip(t)
+
+	// Whitespaces, cases and parentheses don't matter
+	createIndex2 = "CREATE INDEX index_id ON users (id) WHERE ((name NOT LIKE 'b%')   OR (deleted = FALSE));\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+}
+
+func TestPsqldefCreateIndexWithInclude(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, name text, email text UNIQUE);\n"
+	createIndex := "CREATE INDEX index_name ON users (name) INCLUDE (email);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+	assertExportRoundTrip(t)
+
+	createIndex = "CREATE INDEX index_name ON users (name) INCLUDE (email, id);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX \"index_name\";\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	// The covering index is not the one of the UNIQUE column
+	createUniqueIndex := "CREATE UNIQUE INDEX index_email ON users (email) INCLUDE (name);\n"
+	assertApplyOutput(t, createTable+createIndex+createUniqueIndex, applyPrefix+createUniqueIndex)
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+"DROP INDEX \"index_email\";\n")
+}
+
+func TestPsqldefUniqueConstraint(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email text,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
 
 	createTable = stripHeredoc(`
-		CREATE TABLE a (
-		  a_id INTEGER PRIMARY KEY CHECK (a_id > 2) NO INHERIT,
-		  my_text TEXT UNIQUE NOT NULL
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email text,
+		  name text,
+		  CONSTRAINT uq_email UNIQUE (email),
+		  CONSTRAINT uq_name_email UNIQUE (name, email)
 		);
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+
-		`ALTER TABLE "public"."a" ADD CONSTRAINT a_a_id_check CHECK (a_id > 2) NO INHERIT;`+"\n")
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "uq_email" UNIQUE ("email");`+"\n"+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "uq_name_email" UNIQUE ("name", "email");`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
 
+	// A unique index of the same name is not the constraint
 	createTable = stripHeredoc(`
-		CREATE TABLE a (
-		  a_id INTEGER PRIMARY KEY CHECK (a_id > 3) NO INHERIT,
-		  my_text TEXT UNIQUE NOT NULL
+		CREATE TABLE users (
+		  id bigint NOT NULL,
+		  email text,
+		  name text,
+		  CONSTRAINT uq_name_email UNIQUE (name, email)
 		);
 		`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+
-		`ALTER TABLE "public"."a" DROP CONSTRAINT a_a_id_check;`+"\n"+
-		`ALTER TABLE "public"."a" ADD CONSTRAINT a_a_id_check CHECK (a_id > 3) NO INHERIT;`+"\n")
+	createIndex := "CREATE UNIQUE INDEX uq_email ON users (email);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+
+		`ALTER TABLE "public"."users" DROP CONSTRAINT "uq_email";`+"\n"+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+`DROP INDEX "uq_email";`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
-func TestPsqlddefCreatePolicy(t *testing.T) {
+func TestPsqldefTableAttributes(t *testing.T) {
 	resetTestDatabase()
 
-	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100));\n"
+	// A tablespace needs an empty directory on the server
+	mustExecute("psql", "-Upostgres", "-c", "COPY (SELECT 1) TO PROGRAM 'mkdir -p /tmp/psqldef_tablespace'")
+	mustExecute("psql", "-Upostgres", "-c", "DROP TABLESPACE IF EXISTS psqldef_tablespace")
+	mustExecute("psql", "-Upostgres", "-c", "CREATE TABLESPACE psqldef_tablespace LOCATION '/tmp/psqldef_tablespace'")
 
-	assertApplyOutput(t, createUsers, applyPrefix+createUsers)
-	assertApplyOutput(t, createUsers, nothingModified)
+	createTable := "CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text) WITH (fillfactor = 90);\n"
+	createIndex := "CREATE INDEX index_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
 
-	createPolicy := stripHeredoc(`
-		CREATE POLICY p_users ON users AS PERMISSIVE FOR ALL TO PUBLIC USING (id = (current_user)::integer) WITH CHECK ((current_user)::integer = 1);
-		`,
-	)
-	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+
-		"CREATE POLICY p_users ON users AS PERMISSIVE FOR ALL TO PUBLIC USING (id = (current_user)::integer) WITH CHECK ((current_user)::integer = 1);\n",
+	// Attributes are changed in a fixed order regardless of the order in the schema
+	clusterOn := "ALTER TABLE users CLUSTER ON index_name;\n"
+	changedTable := "CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text) WITH (fillfactor = 70, autovacuum_enabled = off) TABLESPACE psqldef_tablespace;\n"
+	assertApplyOutput(t, changedTable+createIndex+clusterOn, applyPrefix+
+		`ALTER TABLE "public"."users" SET (autovacuum_enabled = false, fillfactor = 70);`+"\n"+
+		`ALTER TABLE "public"."users" SET TABLESPACE "psqldef_tablespace";`+"\n"+
+		`ALTER TABLE "public"."users" CLUSTER ON "index_name";`+"\n",
 	)
-	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+	assertApplyOutput(t, changedTable+createIndex+clusterOn, nothingModified)
+	assertExportRoundTrip(t)
 
-	createPolicy = stripHeredoc(`
-		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (id = (current_user)::integer);
-		`,
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+
+		`ALTER TABLE "public"."users" SET (fillfactor = 90);`+"\n"+
+		`ALTER TABLE "public"."users" RESET (autovacuum_enabled);`+"\n"+
+		`ALTER TABLE "public"."users" SET TABLESPACE "pg_default";`+"\n"+
+		`ALTER TABLE "public"."users" SET WITHOUT CLUSTER;`+"\n",
 	)
-	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+stripHeredoc(`
-		DROP POLICY "p_users" ON "public"."users";
-		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (id = (current_user)::integer);
-		`,
-	))
-	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
 
-	createPolicy = stripHeredoc(`
-		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
-		`,
-	)
-	assertApplyOutput(t, createUsers+createPolicy, applyPrefix+stripHeredoc(`
-		DROP POLICY "p_users" ON "public"."users";
-		CREATE POLICY p_users ON users AS RESTRICTIVE FOR ALL TO postgres USING (true);
+func TestPsqldefCreateIndexWithAccessMethod(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, name text, data jsonb);\n"
+	createIndex1 := "CREATE INDEX index_data ON users USING gin (data);\n"
+	createIndex2 := "CREATE INDEX index_name ON users (name);\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+	assertExportRoundTrip(t)
+
+	createIndex2 = "CREATE INDEX index_name ON users USING hash (name);\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+"DROP INDEX \"index_name\";\n"+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
+
+	createIndex2 = "CREATE INDEX index_name ON users USING btree (name);\n"
+	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+"DROP INDEX \"index_name\";\n"+createIndex2)
+	assertApplyOutput(t, createTable+createIndex1, applyPrefix+"DROP INDEX \"index_name\";\n")
+}
+
+func TestPsqldefEnumType(t *testing.T) {
+	resetTestDatabase()
+
+	createType := "CREATE TYPE mood AS ENUM ('sad', 'happy');\n"
+	createTable := "CREATE TABLE users (id bigint NOT NULL, current_mood mood);\n"
+	assertApplyOutput(t, createType+createTable, applyPrefix+createType+createTable)
+	assertApplyOutput(t, createType+createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createType = "CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy', 'great');\n"
+	assertApplyOutput(t, createType+createTable, applyPrefix+stripHeredoc(`
+		ALTER TYPE "public"."mood" ADD VALUE 'ok' BEFORE 'happy';
+		ALTER TYPE "public"."mood" ADD VALUE 'great';
 		`,
 	))
-	assertApplyOutput(t, createUsers+createPolicy, nothingModified)
+	assertApplyOutput(t, createType+createTable, nothingModified)
 
-	assertApplyOutput(t, createUsers, applyPrefix+`DROP POLICY "p_users" ON "public"."users";`+"\n")
-	assertApplyOutput(t, createUsers, nothingModified)
+	assertApplyFailure(t, "CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');\n"+createTable,
+		"enum value 'great' of type 'public.mood' can't be removed: 'CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy')'\n")
 }
 
-func TestPsqldefCreateView(t *testing.T) {
+func TestPsqldefDomain(t *testing.T) {
 	resetTestDatabase()
 
-	createUsers := "CREATE TABLE users (id BIGINT PRIMARY KEY, name character varying(100));\n"
-	createPosts := "CREATE TABLE posts (id BIGINT PRIMARY KEY, name character varying(100), user_id BIGINT, is_deleted boolean);\n"
+	createDomain := "CREATE DOMAIN email AS text CHECK (VALUE ~ '@');\n"
+	createTable := "CREATE TABLE users (id bigint NOT NULL, mail email);\n"
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+createDomain+createTable)
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
+	assertExportRoundTrip(t)
 
-	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
-	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+	createDomain = "CREATE DOMAIN email AS text DEFAULT 'nobody@example.com' NOT NULL CHECK (VALUE ~ '@.');\n"
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+stripHeredoc(`
+		ALTER DOMAIN "public"."email" SET NOT NULL;
+		ALTER DOMAIN "public"."email" SET DEFAULT 'nobody@example.com';
+		ALTER DOMAIN "public"."email" DROP CONSTRAINT "email_check";
+		ALTER DOMAIN "public"."email" ADD CHECK (VALUE ~ '@.');
+		`,
+	))
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
 
-	createView := stripHeredoc(`
-		CREATE VIEW view_user_posts AS SELECT p.id FROM (posts as p JOIN users as u ON ((p.user_id = u.id)));
+	// Checks are examined by their names
+	createDomain = "CREATE DOMAIN email AS text DEFAULT 'nobody@example.com' NOT NULL CHECK (VALUE ~ '@.') CHECK (length(VALUE) < 256) CONSTRAINT email_lower CHECK (VALUE = lower(VALUE));\n"
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+stripHeredoc(`
+		ALTER DOMAIN "public"."email" ADD CHECK (length(VALUE) < 256);
+		ALTER DOMAIN "public"."email" ADD CONSTRAINT "email_lower" CHECK (VALUE = lower(VALUE));
 		`,
-	)
-	assertApplyOutput(t, createUsers+createPosts+createView, applyPrefix+
-		"CREATE VIEW view_user_posts AS SELECT p.id FROM (posts as p JOIN users as u ON ((p.user_id = u.id)));\n",
-	)
-	assertApplyOutput(t, createUsers+createPosts+createView, nothingModified)
+	))
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
 
-	createView = stripHeredoc(`
-		CREATE VIEW view_user_posts AS SELECT p.id from (posts p INNER JOIN users u ON ((p.user_id = u.id))) WHERE (p.is_deleted = FALSE);
+	createDomain = "CREATE DOMAIN email AS text DEFAULT 'nobody@example.com' NOT NULL CHECK (VALUE ~ '@.') CHECK (length(VALUE) < 128);\n"
+	assertApplyOutput(t, createDomain+createTable, applyPrefix+stripHeredoc(`
+		ALTER DOMAIN "public"."email" DROP CONSTRAINT "email_check1";
+		ALTER DOMAIN "public"."email" DROP CONSTRAINT "email_lower";
+		ALTER DOMAIN "public"."email" ADD CHECK (length(VALUE) < 128);
 		`,
-	)
-	assertApplyOutput(t, createUsers+createPosts+createView, applyPrefix+stripHeredoc(`
-		CREATE OR REPLACE VIEW "public"."view_user_posts" AS select p.id from (posts as p join users as u on ((p.user_id = u.id))) where (p.is_deleted = false);
+	))
+	assertApplyOutput(t, createDomain+createTable, nothingModified)
+
+	createTable = "CREATE TABLE users (id bigint NOT NULL, mail text);\n"
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "mail" TYPE text;
+		DROP DOMAIN "public"."email";
 		`,
 	))
-	assertApplyOutput(t, createUsers+createPosts+createView, nothingModified)
+	assertApplyOutput(t, createTable, nothingModified)
+}
 
-	assertApplyOutput(t, createUsers+createPosts, applyPrefix+`DROP VIEW "public"."view_user_posts";`+"\n")
-	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+func TestPsqldefSkipDropDomain(t *testing.T) {
+	resetTestDatabase()
+
+	createDomain := "CREATE DOMAIN email AS text CHECK (VALUE ~ '@');\n"
+	assertApplyOutput(t, createDomain, applyPrefix+createDomain)
+
+	// Like tables, domains are dropped only with --enable-drop-table
+	writeFile("schema.sql", "")
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, "-- Skipped drop of domain public.email\n"+nothingModified)
+
+	assertApplyOutput(t, "", applyPrefix+`DROP DOMAIN "public"."email";`+"\n")
 }
 
-func TestPsqldefDropPrimaryKey(t *testing.T) {
-	createTable := stripHeredoc(`
-		CREATE TABLE users (
-		  id bigint NOT NULL PRIMARY KEY,
-		  name text
-		);`,
-	)
-	assertApply(t, createTable)
+func TestPsqldefFunction(t *testing.T) {
+	resetTestDatabase()
 
-	createTable = stripHeredoc(`
-		CREATE TABLE users (
-		  id bigint NOT NULL,
-		  name text
-		);`,
-	)
-	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" DROP CONSTRAINT "users_pkey";`+"\n")
-	assertApplyOutput(t, createTable, nothingModified)
+	createFunction := "CREATE FUNCTION add(a integer, b integer DEFAULT 1) RETURNS integer LANGUAGE sql IMMUTABLE AS $$ SELECT a + b; $$;\n"
+	assertApplyOutput(t, createFunction, applyPrefix+createFunction)
+	assertApplyOutput(t, createFunction, nothingModified)
+	assertExportRoundTrip(t)
+
+	createFunction = "CREATE FUNCTION add(a integer, b integer DEFAULT 1) RETURNS integer LANGUAGE sql IMMUTABLE AS $$ SELECT a + b + 0; $$;\n"
+	assertApplyOutput(t, createFunction, applyPrefix+
+		"CREATE OR REPLACE FUNCTION add(a integer, b integer DEFAULT 1) RETURNS integer LANGUAGE sql IMMUTABLE AS $$ SELECT a + b + 0; $$;\n")
+	assertApplyOutput(t, createFunction, nothingModified)
+
+	// Overloaded by argument types
+	overloadFunction := "CREATE FUNCTION add(a text) RETURNS text LANGUAGE plpgsql AS $$ BEGIN RETURN a || '1'; END; $$;\n"
+	assertApplyOutput(t, createFunction+overloadFunction, applyPrefix+overloadFunction)
+	assertApplyOutput(t, createFunction+overloadFunction, nothingModified)
+
+	// The return type can't be replaced
+	overloadFunction = "CREATE FUNCTION add(a text) RETURNS bigint LANGUAGE plpgsql AS $$ BEGIN RETURN length(a); END; $$;\n"
+	assertApplyOutput(t, createFunction+overloadFunction, applyPrefix+"DROP FUNCTION \"public\".\"add\"(text);\n"+overloadFunction)
+	assertApplyOutput(t, createFunction+overloadFunction, nothingModified)
+
+	assertApplyOutput(t, createFunction, applyPrefix+"DROP FUNCTION \"public\".\"add\"(text);\n")
+	assertApplyOutput(t, createFunction, nothingModified)
 }
 
-func TestPsqldefAddColumn(t *testing.T) {
+func TestPsqldefTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, updated_at timestamp);\n"
+	createFunction := "CREATE FUNCTION touch() RETURNS trigger LANGUAGE plpgsql AS $$ BEGIN NEW.updated_at := now(); RETURN NEW; END; $$;\n"
+	createTrigger := "CREATE TRIGGER users_touch BEFORE UPDATE ON users FOR EACH ROW EXECUTE PROCEDURE touch();\n"
+	assertApplyOutput(t, createTable+createFunction+createTrigger, applyPrefix+createTable+createFunction+createTrigger)
+	assertApplyOutput(t, createTable+createFunction+createTrigger, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTrigger = "CREATE TRIGGER users_touch BEFORE INSERT OR UPDATE ON users FOR EACH ROW EXECUTE FUNCTION touch();\n"
+	assertApplyOutput(t, createTable+createFunction+createTrigger, applyPrefix+
+		`DROP TRIGGER "users_touch" ON "public"."users";`+"\n"+createTrigger)
+	assertApplyOutput(t, createTable+createFunction+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable+createFunction, applyPrefix+`DROP TRIGGER "users_touch" ON "public"."users";`+"\n")
+	assertApplyOutput(t, createTable+createFunction, nothingModified)
+}
+
+func TestPsqldefInheritedTable(t *testing.T) {
 	resetTestDatabase()
 
 	createTable := stripHeredoc(`
-		CREATE TABLE users (
-		  id bigint NOT NULL,
-		  name text
+		CREATE TABLE cities (
+		  name text,
+		  population integer CHECK (population >= 0)
 		);
+		CREATE TABLE capitals (
+		  state text
+		) INHERITS (cities);
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+createTable)
 	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
 
+	// A column added to the parent is not dropped from the child
 	createTable = stripHeredoc(`
-		CREATE TABLE users (
-		  id bigint NOT NULL,
+		CREATE TABLE cities (
 		  name text,
-		  age integer
-		);`,
+		  population integer CHECK (population >= 0),
+		  area integer
+		);
+		CREATE TABLE capitals (
+		  state text
+		) INHERITS (cities);
+		`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "age" integer;`+"\n")
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."cities" ADD COLUMN "area" integer;`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
 
 	createTable = stripHeredoc(`
-		CREATE TABLE users (
-		  id bigint NOT NULL,
-		  age integer
-		);`,
+		CREATE TABLE cities (
+		  name text,
+		  population integer CHECK (population >= 0)
+		);
+		CREATE TABLE capitals (
+		  state text
+		) INHERITS (cities);
+		`,
 	)
-	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" DROP COLUMN "name";`+"\n")
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."cities" DROP COLUMN "area";`+"\n")
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
-func TestPsqldefAddArrayColumn(t *testing.T) {
+func TestPsqldefCreateIndexWithDuplicatedName(t *testing.T) {
 	resetTestDatabase()
 
 	createTable := stripHeredoc(`
 		CREATE TABLE users (
-		  id integer
+		  id bigint NOT NULL,
+		  name text
+		);
+		CREATE INDEX index_name ON users (name);
+		CREATE TABLE groups (
+		  id bigint NOT NULL,
+		  name text
 		);
 		`,
 	)
 	assertApplyOutput(t, createTable, applyPrefix+createTable)
 	assertApplyOutput(t, createTable, nothingModified)
 
-	createTable = stripHeredoc(`
-		CREATE TABLE users (
-		  id integer,
-		  name integer[]
-		);`,
-	)
-	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "name" integer[];`+"\n")
-	assertApplyOutput(t, createTable, nothingModified)
-}
-
-func TestPsqldefCreateIndex(t *testing.T) {
-	resetTestDatabase()
+	assertApplyFailure(t, createTable+"CREATE INDEX index_name ON groups (name);\n",
+		"index 'index_name' is created against both table 'public.users' and table 'public.groups', but index names must be unique in a schema\n")
 
-	createTable := stripHeredoc(`
-		CREATE TABLE users (
+	createTable = stripHeredoc(`
+		CREATE TABLE groups (
 		  id bigint NOT NULL,
-		  name text,
-		  age integer
+		  name text
 		);
+		CREATE INDEX index_name ON groups (name);
 		`,
 	)
-	createIndex1 := `CREATE INDEX "index_name" on users (name);` + "\n"
-	createIndex2 := `CREATE UNIQUE INDEX "index_age" on users (age);` + "\n"
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+createTable+createIndex1+createIndex2)
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
-
-	createIndex1 = `CREATE INDEX "index_name" on users (name, id);` + "\n"
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
-
-	createIndex1 = `CREATE UNIQUE INDEX "index_name" on users (name) WHERE age > 20;` + "\n"
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, applyPrefix+`DROP INDEX "index_name";`+"\n"+createIndex1)
-	assertApplyOutput(t, createTable+createIndex1+createIndex2, nothingModified)
-
-	assertApplyOutput(t, createTable, applyPrefix+`DROP INDEX "index_age";`+"\n"+`DROP INDEX "index_name";`+"\n")
-	assertApplyOutput(t, createTable, nothingModified)
+	assertApplyFailure(t, createTable,
+		"index 'index_name' of table 'public.groups' is already used by existing table 'public.users', but index names must be unique in a schema\n")
 }
 
 func TestPsqldefCreateIndexWithKey(t *testing.T) {
@@ -632,7 +1992,6 @@ func TestPsqldefDataTypes(t *testing.T) {
 
 	// TODO:
 	//   - int8
-	//   - serial8
 	//   - box
 	//   - bytea
 	//   - cidr
@@ -655,9 +2014,6 @@ func TestPsqldefDataTypes(t *testing.T) {
 	//   - float4
 	//   - smallint
 	//   - int2
-	//   - smallserial
-	//   - serial2
-	//   - serial4
 	//   - timetz
 	//   - timestamptz
 	//   - tsquer
@@ -708,42 +2064,272 @@ func TestPsqldefDataTypes(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified) // Label for column type may change. Type will be examined.
 }
 
-//
-// ----------------------- following tests are for CLI -----------------------
-//
-
-func TestPsqldefDryRun(t *testing.T) {
+//
+// ----------------------- following tests are for CLI -----------------------
+//
+
+func TestPsqldefDryRun(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", stripHeredoc(`
+	    CREATE TABLE users (
+	        id bigint NOT NULL PRIMARY KEY,
+	        age int
+	    );`,
+	))
+
+	dryRun := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql")
+	apply := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
+}
+
+func TestPsqldefSkipDrop(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    age int,
+		    c_char_1 char,
+		    c_char_10 char(10),
+		    c_varchar_10 varchar(10),
+		    c_varchar_unlimited varchar
+		);`,
+	))
+
+	writeFile("schema.sql", "")
+
+	skipDrop := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--skip-drop", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	apply := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, skipDrop, strings.Replace(apply, "DROP", "-- Skipped: DROP", 1))
+}
+
+func TestPsqldefUseIfExistsDropConstraint(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    age integer,
+		    CONSTRAINT age_check CHECK (age > 0)
+		);
+		CREATE TABLE posts (
+		    id bigint NOT NULL PRIMARY KEY,
+		    user_id bigint,
+		    CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id)
+		);`,
+	))
+
+	schema := stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    age integer
+		);
+		CREATE TABLE posts (
+		    id bigint NOT NULL PRIMARY KEY,
+		    user_id bigint
+		);
+		`,
+	)
+	writeFile("schema.sql", schema)
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--use-if-exists", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		`ALTER TABLE "public"."users" DROP CONSTRAINT IF EXISTS "age_check";`+"\n"+
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT IF EXISTS "posts_user_id_fkey";`+"\n",
+	)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestPsqldefUseIfExistsIndex(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name text
+		);
+		CREATE INDEX index_id ON users (id);`,
+	))
+
+	schema := stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name text
+		);
+		CREATE INDEX index_name ON users (name);
+		`,
+	)
+	writeFile("schema.sql", schema)
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--use-if-exists", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"CREATE INDEX IF NOT EXISTS index_name ON users (name);\n"+
+		`DROP INDEX IF EXISTS "index_id";`+"\n",
+	)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestPsqldefAllowUnsafe(t *testing.T) {
 	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name text,
+		    age bigint
+		);`,
+	))
+
 	writeFile("schema.sql", stripHeredoc(`
-	    CREATE TABLE users (
-	        id bigint NOT NULL PRIMARY KEY,
-	        age int
-	    );`,
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40),
+		  age integer
+		);
+		`,
 	))
 
-	dryRun := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql")
-	apply := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
-	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+stripHeredoc(`
+		-- Skipped: ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE varchar(40);
+		-- Skipped: ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE integer;
+		`,
+	))
+
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--allow-unsafe", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE varchar(40);
+		ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE integer;
+		`,
+	))
 }
 
-func TestPsqldefSkipDrop(t *testing.T) {
+func TestPsqldefWarnColumnOrder(t *testing.T) {
 	resetTestDatabase()
 	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
 		CREATE TABLE users (
 		    id bigint NOT NULL PRIMARY KEY,
-		    age int,
-		    c_char_1 char,
-		    c_char_10 char(10),
-		    c_varchar_10 varchar(10),
-		    c_varchar_unlimited varchar
+		    name text,
+		    age integer
 		);`,
 	))
 
-	writeFile("schema.sql", "")
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  email text,
+		  name text,
+		  age integer
+		);
+		`,
+	))
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--warn-column-order", "--file", "schema.sql")
+	assertEquals(t, out, stripHeredoc(`
+		-- Warning: column 'email' is added to the end of table 'public.users' since PostgreSQL can't add a column in the middle --
+		`,
+	)+applyPrefix+`ALTER TABLE "public"."users" ADD COLUMN "email" text;`+"\n")
 
-	skipDrop := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--skip-drop", "--file", "schema.sql")
-	apply := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
-	assertEquals(t, skipDrop, strings.Replace(apply, "DROP", "-- Skipped: DROP", 1))
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  age integer,
+		  name text,
+		  email text
+		);
+		`,
+	))
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--warn-column-order", "--file", "schema.sql")
+	assertEquals(t, out, stripHeredoc(`
+		-- Warning: columns of table 'public.users' are kept in the order (id, name, age, email) since PostgreSQL can't reorder columns --
+		`,
+	)+nothingModified)
+
+	// No warning without --warn-column-order
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestPsqldefIndexConcurrently(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE TABLE users (id bigint PRIMARY KEY, name text, data jsonb);")
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE INDEX index_id ON users (id);")
+
+	createTable := "CREATE TABLE users (id bigint PRIMARY KEY, name text, data jsonb);\n"
+	createIndex := "CREATE INDEX index_name ON users (name);\n"
+	writeFile("schema.sql", createTable+createIndex)
+
+	// CONCURRENTLY can't run in a transaction, so it fails unless it's run alone
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--index-concurrently", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"CREATE INDEX CONCURRENTLY index_name ON users (name);\n"+
+		`DROP INDEX CONCURRENTLY "index_id";`+"\n")
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+
+	createIndex = "CREATE UNIQUE INDEX index_name ON users (name, id);\n"
+	writeFile("schema.sql", createTable+createIndex)
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--index-concurrently", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		`DROP INDEX CONCURRENTLY "index_name";`+"\n"+
+		"CREATE UNIQUE INDEX CONCURRENTLY index_name ON users (name, id);\n")
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestPsqldefNotNullViaCheck(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE TABLE users (id bigint PRIMARY KEY, name text);")
+
+	createTable := "CREATE TABLE users (id bigint PRIMARY KEY, name text NOT NULL);\n"
+	writeFile("schema.sql", createTable)
+
+	// The check would be left behind if only DROP CONSTRAINT were skipped
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--not-null-via-check", "--skip-drop", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+stripHeredoc(`
+		-- Skipped: ALTER TABLE "public"."users" ADD CONSTRAINT "users_name_not_null_check" CHECK ("name" IS NOT NULL) NOT VALID;
+		-- Skipped: ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null_check";
+		-- Skipped: ALTER TABLE "public"."users" ALTER COLUMN "name" SET NOT NULL;
+		-- Skipped: ALTER TABLE "public"."users" DROP CONSTRAINT "users_name_not_null_check";
+		`,
+	))
+
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--not-null-via-check", "--use-if-exists", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ADD CONSTRAINT "users_name_not_null_check" CHECK ("name" IS NOT NULL) NOT VALID;
+		ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null_check";
+		ALTER TABLE "public"."users" ALTER COLUMN "name" SET NOT NULL;
+		ALTER TABLE "public"."users" DROP CONSTRAINT IF EXISTS "users_name_not_null_check";
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+	assertEquals(t, assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SELECT count(*) FROM pg_constraint WHERE conname = 'users_name_not_null_check';"), "0\n")
+
+	// Dropping NOT NULL doesn't need the check
+	createTable = "CREATE TABLE users (id bigint PRIMARY KEY, name text);\n"
+	writeFile("schema.sql", createTable)
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--not-null-via-check", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+`ALTER TABLE "public"."users" ALTER COLUMN "name" DROP NOT NULL;`+"\n")
+}
+
+func TestPsqldefQuoteIdentifiers(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE TABLE users (id bigint PRIMARY KEY);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint PRIMARY KEY,
+		  name text,
+		  "user" text,
+		  "nickName" text
+		);
+		`,
+	))
+
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, out, "-- dry run --\n"+
+		`ALTER TABLE "public"."users" ADD COLUMN "name" text;`+"\n"+
+		`ALTER TABLE "public"."users" ADD COLUMN "user" text;`+"\n"+
+		`ALTER TABLE "public"."users" ADD COLUMN "nickName" text;`+"\n")
+
+	// Upper case letters are quoted since Postgres folds unquoted identifiers to lower case
+	out = assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--quote-identifiers", "necessary", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		`ALTER TABLE public.users ADD COLUMN name text;`+"\n"+
+		`ALTER TABLE public.users ADD COLUMN "user" text;`+"\n"+
+		`ALTER TABLE public.users ADD COLUMN "nickName" text;`+"\n")
+	assertExportRoundTrip(t)
 }
 
 func TestPsqldefExport(t *testing.T) {
@@ -803,6 +2389,204 @@ func TestPsqldefExportCompositePrimaryKey(t *testing.T) {
 	))
 }
 
+func TestPsqldefExportRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE TABLE users (
+		    id bigint NOT NULL PRIMARY KEY,
+		    name text UNIQUE,
+		    age integer DEFAULT 20 CHECK (age > 0),
+		    created_at timestamp with time zone NOT NULL DEFAULT now()
+		);
+		CREATE TABLE posts (
+		    id integer NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+		    user_id bigint NOT NULL,
+		    CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+		);
+		CREATE INDEX index_posts_user_id ON posts (user_id);
+		CREATE VIEW adult_users AS SELECT id, name FROM users WHERE age >= 20;`,
+	))
+	assertExportRoundTrip(t)
+}
+
+func TestPsqldefSerialPrimaryKeyRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id serial PRIMARY KEY,
+		  name text
+		);
+		CREATE TABLE posts (
+		  id serial8 PRIMARY KEY,
+		  user_id integer
+		);
+		CREATE TABLE tags (
+		  id smallserial PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertExportRoundTrip(t)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefSerialExpandedForm(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id serial PRIMARY KEY, counter bigserial);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	// Postgres stores serial as an integer column with a sequence default
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id integer DEFAULT nextval('users_id_seq'::regclass) NOT NULL PRIMARY KEY,
+		  counter bigint DEFAULT nextval('users_counter_seq'::regclass) NOT NULL
+		);
+		`,
+	), nothingModified)
+
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", stripHeredoc(`
+		CREATE SEQUENCE users_id_seq;
+		CREATE TABLE users (id integer DEFAULT nextval('users_id_seq'::regclass) NOT NULL PRIMARY KEY);
+		ALTER SEQUENCE users_id_seq OWNED BY users.id;
+		`,
+	))
+	assertApplyOutput(t, "CREATE TABLE users (id serial PRIMARY KEY);\n", nothingModified)
+}
+
+func TestPsqldefNextvalDefault(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "CREATE SEQUENCE seq_a; CREATE SEQUENCE seq_b;")
+
+	createTable := "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, counter integer);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	// A nextval default of another sequence is kept as it is, unlike serial
+	createTable = "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, counter integer DEFAULT nextval('seq_a'::regclass));\n"
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" ALTER COLUMN "counter" SET DEFAULT (nextval('seq_a'::regclass));`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  counter integer DEFAULT nextval('seq_b'::regclass),
+		  total bigint DEFAULT nextval('seq_a'::regclass)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "counter" SET DEFAULT (nextval('seq_b'::regclass));
+		ALTER TABLE "public"."users" ADD COLUMN "total" bigint DEFAULT (nextval('seq_a'::regclass));
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// serial creates its own sequence
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id serial PRIMARY KEY,
+		  counter integer DEFAULT nextval('seq_b'::regclass),
+		  total bigint DEFAULT nextval('seq_a'::regclass)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		CREATE SEQUENCE IF NOT EXISTS "public"."users_id_seq" OWNED BY "public"."users"."id";
+		ALTER TABLE "public"."users" ALTER COLUMN "id" SET DEFAULT nextval('"public"."users_id_seq"'::regclass);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefDropSerialColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  counter serial
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+`ALTER TABLE "public"."users" DROP COLUMN "counter";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// The sequence owned by the serial column is dropped together
+	sequences := assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SELECT count(*) FROM pg_class WHERE relkind = 'S' AND relname = 'users_counter_seq';")
+	assertEquals(t, sequences, "0\n")
+}
+
+func TestPsqldefTimestamptzWithPrecision(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint PRIMARY KEY,
+		  created_at timestamptz(3) DEFAULT now(),
+		  updated_at timestamptz(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE events (
+		  id bigint PRIMARY KEY,
+		  created_at timestamptz(6) DEFAULT now(),
+		  updated_at timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."events" ALTER COLUMN "created_at" TYPE timestamp(6) WITH TIME ZONE;
+		ALTER TABLE "public"."events" ALTER COLUMN "updated_at" TYPE timestamp(3);
+		`,
+	))
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefTimeout(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", `CREATE FUNCTION slow_positive(integer) RETURNS boolean AS 'SELECT pg_sleep(5) IS NOT NULL AND $1 > 0' LANGUAGE sql;`)
+
+	createTable := "CREATE TABLE users (id integer PRIMARY KEY);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "INSERT INTO users (id) VALUES (1);")
+
+	// Validating the new CHECK constraint against the existing row outlives the timeout
+	createTable = "CREATE TABLE users (id integer PRIMARY KEY CHECK (slow_positive(id)));\n"
+	writeFile("schema.sql", createTable)
+	out, err := execute("psqldef", "-Upostgres", "psqldef_test", "--timeout", "1s", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected psqldef to time out but succeeded with: %s", out)
+	}
+	ddl := `ALTER TABLE "public"."users" ADD CONSTRAINT users_id_check CHECK (slow_positive(id))`
+	if !strings.HasPrefix(out, applyPrefix+ddl+";\n") {
+		t.Errorf("expected the interrupted DDL to be applied first, but got: %s", out)
+	}
+	if !strings.Contains(out, "timeout exceeded while executing '"+ddl+"'") {
+		t.Errorf("expected the interrupted DDL to be reported, but got: %s", out)
+	}
+
+	// The transaction is rolled back, so the constraint is added again
+	assertApplyOutput(t, "CREATE TABLE users (id integer PRIMARY KEY CHECK (id > 0));\n", applyPrefix+
+		`ALTER TABLE "public"."users" ADD CONSTRAINT users_id_check CHECK (id > 0);`+"\n")
+}
+
 func TestPsqldefCreateTableWithIdentityColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -865,6 +2649,76 @@ func TestPsqldefRemovingIdentityColumn(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefSerialToIdentity(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id serial PRIMARY KEY, name text);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "INSERT INTO users (name) VALUES ('alice'), ('bob');")
+	// The sequence is found by the column owning it, not by its name
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "ALTER SEQUENCE users_id_seq RENAME TO user_ids;")
+
+	createTable = "CREATE TABLE users (id integer GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, name text);\n"
+	dropDefault := `ALTER TABLE "public"."users" ALTER COLUMN "id" DROP DEFAULT;` + "\n"
+	addIdentity := `DO $$ DECLARE seq text := pg_get_serial_sequence('"public"."users"', 'id'); next_value bigint; BEGIN ` +
+		`EXECUTE format('SELECT CASE WHEN is_called THEN last_value + 1 ELSE last_value END FROM %s', COALESCE(seq, '"public"."user_ids"')) INTO next_value; ` +
+		`ALTER TABLE "public"."users" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY; ` +
+		`EXECUTE format('ALTER TABLE "public"."users" ALTER COLUMN "id" RESTART WITH %s', next_value); ` +
+		`IF seq IS NOT NULL THEN EXECUTE format('DROP SEQUENCE %s', seq); END IF; END $$;` + "\n"
+
+	// Adding identity fails without dropping the default, so they're skipped together
+	writeFile("schema.sql", createTable)
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--skip-drop", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+"-- Skipped: "+dropDefault+"-- Skipped: "+addIdentity)
+
+	// The identity continues from the sequence of the serial column, which is dropped
+	assertApplyOutput(t, createTable, applyPrefix+dropDefault+addIdentity)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertEquals(t, assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SELECT to_regclass('user_ids') IS NULL;"), "t\n")
+
+	mustExecute("psql", "-Upostgres", "psqldef_test", "-c", "INSERT INTO users (name) VALUES ('carol');")
+	assertEquals(t, assertedExecute(t, "psql", "-Upostgres", "psqldef_test", "-tAc", "SELECT id FROM users WHERE name = 'carol';"), "3\n")
+}
+
+func TestPsqldefTogglingIdentityColumnNullability(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE color (
+		  color_id INT,
+		  color_name VARCHAR NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	// Identity requires NOT NULL
+	createTable = stripHeredoc(`
+		CREATE TABLE color (
+		  color_id INT GENERATED ALWAYS AS IDENTITY,
+		  color_name VARCHAR NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."color" ALTER COLUMN "color_id" SET NOT NULL;`+"\n"+
+		`ALTER TABLE "public"."color" ALTER COLUMN "color_id" ADD GENERATED ALWAYS AS IDENTITY;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// NOT NULL can't be dropped until identity is dropped
+	createTable = stripHeredoc(`
+		CREATE TABLE color (
+		  color_id INT,
+		  color_name VARCHAR NOT NULL
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."color" ALTER COLUMN "color_id" DROP IDENTITY IF EXISTS;`+"\n"+
+		`ALTER TABLE "public"."color" ALTER COLUMN "color_id" DROP NOT NULL;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqldefChangingIdentityColumn(t *testing.T) {
 	resetTestDatabase()
 
@@ -988,6 +2842,24 @@ func TestPsqldefAddIdentityColumnWithSequenceOption(t *testing.T) {
 	assertApplyOutput(t, createTableWithoutSequence, nothingModified)
 }
 
+func TestPsqldefFeatures(t *testing.T) {
+	out := assertedExecute(t, "psqldef", "--features")
+	features := map[string]bool{}
+	for _, feature := range strings.Split(strings.TrimSpace(out), "\n") {
+		features[feature] = true
+	}
+	for _, feature := range []string{"Function: CREATE FUNCTION", "Trigger: CREATE TRIGGER", "Inheritance: INHERITS", "Column: rename by @renamed"} {
+		if !features[feature] {
+			t.Errorf("expected --features to include %q, but got: %v", feature, features)
+		}
+	}
+	for _, feature := range []string{"Table options: ENGINE"} {
+		if features[feature] {
+			t.Errorf("expected --features not to include %q, but got: %v", feature, features)
+		}
+	}
+}
+
 func TestPsqldefHelp(t *testing.T) {
 	_, err := execute("psqldef", "--help")
 	if err != nil {
@@ -1010,13 +2882,30 @@ func TestMain(m *testing.M) {
 func assertApply(t *testing.T, schema string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 }
 
 func assertApplyOutput(t *testing.T, schema string, expected string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--file", "schema.sql")
+	actual := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, actual, expected)
+}
+
+// Applying the output of `--export` should be always "Nothing is modified".
+func assertExportRoundTrip(t *testing.T) {
+	t.Helper()
+	out := assertedExecute(t, "psqldef", "-Upostgres", "psqldef_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
+func assertApplyFailure(t *testing.T, schema string, expected string) {
+	t.Helper()
+	writeFile("schema.sql", schema)
+	actual, err := execute("psqldef", "-Upostgres", "psqldef_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected 'psqldef -Upostgres psqldef_test --file schema.sql' to fail but succeeded with: %s", actual)
+	}
 	assertEquals(t, actual, expected)
 }
 
@@ -6,12 +6,18 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+
+	"github.com/k0kubun/sqldef/schema"
 )
 
 const (
@@ -58,8 +64,8 @@ func TestSQLite3defCreateTableQuotes(t *testing.T) {
 
 	createTable = stripHeredoc(
 		"CREATE TABLE `test_table` (\n" +
-		"  id integer primary key\n" +
-		");\n",
+			"  id integer primary key\n" +
+			");\n",
 	)
 	assertApplyOutput(t, createTable, nothingModified)
 }
@@ -116,6 +122,160 @@ func TestSQLite3defCreateView(t *testing.T) {
 	//assertApplyOutput(t, "", nothingModified)
 }
 
+func TestSQLite3defCreateTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL,
+		  name text,
+		  updated_at integer
+		);
+		`,
+	)
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_touch AFTER UPDATE OF name ON users
+		BEGIN
+		  UPDATE users SET updated_at = 1 WHERE id = NEW.id;
+		END;
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+	assertExportRoundTrip(t)
+
+	createTrigger = stripHeredoc(`
+		CREATE TRIGGER users_touch AFTER UPDATE OF name ON users
+		BEGIN
+		  UPDATE users SET updated_at = CASE WHEN NEW.name IS NULL THEN 0 ELSE 2 END WHERE id = NEW.id;
+		END;
+		`,
+	)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+"DROP TRIGGER `users_touch`;\n"+createTrigger)
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	assertApplyOutput(t, createTable, applyPrefix+"DROP TRIGGER `users_touch`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defCreateInDependencyOrder(t *testing.T) {
+	resetTestDatabase()
+
+	createAdults := "CREATE VIEW `adults` AS select id from users where age >= 20;\n"
+	createUsers := "CREATE TABLE users (id integer NOT NULL, age integer);\n"
+
+	schema := createAdults + createUsers
+	assertApplyOutput(t, schema, applyPrefix+createUsers+createAdults)
+	assertApplyOutput(t, schema, nothingModified)
+}
+
+func TestSQLite3defCircularForeignKeys(t *testing.T) {
+	resetTestDatabase()
+
+	// SQLite doesn't check a referenced table until rows are modified
+	createUsers := "CREATE TABLE users (id integer PRIMARY KEY, best_post_id integer REFERENCES posts (id));\n"
+	createPosts := "CREATE TABLE posts (id integer PRIMARY KEY, user_id integer REFERENCES users (id));\n"
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestSQLite3defInlineReference(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL,
+		  tenant_id integer NOT NULL,
+		  PRIMARY KEY (id, tenant_id)
+		);
+		CREATE TABLE posts (
+		  id integer PRIMARY KEY,
+		  user_id integer REFERENCES users (id)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	assertApplyFailure(t, stripHeredoc(`
+		CREATE TABLE posts (
+		  id integer PRIMARY KEY,
+		  user_id integer REFERENCES users (id, tenant_id)
+		);
+		`,
+	), "column 'user_id' of table 'posts' references multiple columns of 'users', but a multi-column foreign key must be a table constraint like FOREIGN KEY (...) REFERENCES users (...)\n")
+}
+
+func TestSQLite3defUseIfExists(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id integer PRIMARY KEY, name text);\n"
+	createPosts := "CREATE TABLE posts (id integer PRIMARY KEY);\n"
+	createView := "CREATE VIEW user_names AS SELECT name FROM users;\n"
+	assertApplyOutput(t, createTable+createPosts+createView, applyPrefix+createTable+createPosts+createView)
+
+	writeFile("schema.sql", createTable+"CREATE INDEX index_name ON users (name);\n")
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--use-if-exists", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"CREATE INDEX IF NOT EXISTS index_name ON users (name);\n"+
+		"DROP TABLE IF EXISTS `posts`;\n"+
+		"DROP VIEW IF EXISTS `user_names`;\n",
+	)
+	assertApplyOutput(t, createTable+"CREATE INDEX index_name ON users (name);\n", nothingModified)
+}
+
+func TestSQLite3defTargetTables(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer PRIMARY KEY, name text);\n"
+	createPosts := "CREATE TABLE posts (id integer PRIMARY KEY);\n"
+	createLogs := "CREATE TABLE app_logs (id integer PRIMARY KEY);\n"
+	assertApplyOutput(t, createUsers+createPosts+createLogs, applyPrefix+createUsers+createPosts+createLogs)
+
+	// posts is not altered since it's out of the targets, while app_logs is dropped
+	writeFile("schema.sql", "CREATE TABLE users (id integer PRIMARY KEY);\nCREATE TABLE posts (id integer PRIMARY KEY, title text);\n")
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--target-tables", "users", "--target-tables", "app_.*", "--enable-drop-table", "--allow-unsafe", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"ALTER TABLE `users` DROP COLUMN `name`;\n"+
+		"DROP TABLE `app_logs`;\n",
+	)
+
+	// A pattern matches a whole name
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--target-tables", "post", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestSQLite3defSkipTables(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer PRIMARY KEY);\n"
+	createMigrations := "CREATE TABLE schema_migrations (version text PRIMARY KEY);\n"
+	createMetadata := "CREATE TABLE ar_internal_metadata (name text PRIMARY KEY);\n"
+	assertApplyOutput(t, createUsers+createMigrations+createMetadata, applyPrefix+createUsers+createMigrations+createMetadata)
+
+	// Skipped tables are neither dropped nor altered even if they're in the schema
+	writeFile("schema.sql", createUsers+"CREATE TABLE ar_internal_metadata (name text PRIMARY KEY, value text);\n")
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--skip-tables", "schema_migrations", "--skip-tables", "ar_internal_.*", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+
+	// Skipping wins over targeting
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--target-tables", ".*", "--skip-tables", "schema_migrations", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE `ar_internal_metadata` ADD COLUMN `value` text;\n")
+
+	// Triggers of skipped tables are neither created nor dropped
+	mustExecute("sqlite3", "sqlite3def_test", "CREATE TRIGGER schema_migrations_insert AFTER INSERT ON schema_migrations BEGIN DELETE FROM schema_migrations WHERE version = ''; END;")
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER ar_internal_metadata_insert AFTER INSERT ON ar_internal_metadata
+		BEGIN
+		  DELETE FROM ar_internal_metadata WHERE name = '';
+		END;
+		`,
+	)
+	writeFile("schema.sql", createUsers+"CREATE TABLE ar_internal_metadata (name text PRIMARY KEY, value text);\n"+createTrigger)
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--skip-tables", "schema_migrations", "--skip-tables", "ar_internal_.*", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+}
+
 func TestSQLite3defColumnLiteral(t *testing.T) {
 	resetTestDatabase()
 
@@ -131,6 +291,229 @@ func TestSQLite3defColumnLiteral(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestSQLite3defNegativeDefault(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE items (
+		  position integer DEFAULT -20,
+		  ratio real DEFAULT -0.125
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defDefaultKeywordCase(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  name text default null,
+		  created_at timestamp default current_timestamp
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name text DEFAULT NULL,
+		  created_at timestamp DEFAULT CURRENT_TIMESTAMP
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defGeneratedColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE products (
+		  price integer,
+		  total integer GENERATED ALWAYS AS (price * 2) STORED,
+		  half integer AS (price / 2)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defRenameColumn(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  name text
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  username text -- @renamed_from name
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+"ALTER TABLE `users` RENAME COLUMN `name` TO `username`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defRebuildTable(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  name text,
+		  age integer
+		);
+		`,
+	)
+	createIndex := "CREATE INDEX index_age ON users(age);\n"
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+createTable+createIndex)
+	mustExecute("sqlite3", "sqlite3def_test", "INSERT INTO users (id, name, age) VALUES (1, 'alice', 20);")
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  name varchar(20) NOT NULL DEFAULT '',
+		  age integer
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable+createIndex, applyPrefix+
+		"CREATE TABLE `_sqldef_new_users` (\n"+
+		"  id integer PRIMARY KEY,\n"+
+		"  name varchar(20) NOT NULL DEFAULT '',\n"+
+		"  age integer\n"+
+		");\n"+
+		"INSERT INTO `_sqldef_new_users` (`id`, `name`, `age`) SELECT `id`, `name`, `age` FROM `users`;\n"+
+		"DROP TABLE `users`;\n"+
+		"ALTER TABLE `_sqldef_new_users` RENAME TO `users`;\n"+
+		createIndex,
+	)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+	assertEquals(t, assertedExecute(t, "sqlite3", "sqlite3def_test", "SELECT id, name, age FROM users;"), "1|alice|20\n")
+
+	// A rebuild dropping a column is skipped as a whole without --allow-unsafe
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  name varchar(40) NOT NULL DEFAULT ''
+		);
+		`,
+	))
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"-- Skipped: CREATE TABLE `_sqldef_new_users` (\n"+
+		"  id integer PRIMARY KEY,\n"+
+		"  name varchar(40) NOT NULL DEFAULT ''\n"+
+		");\n"+
+		"-- Skipped: INSERT INTO `_sqldef_new_users` (`id`, `name`) SELECT `id`, `name` FROM `users`;\n"+
+		"-- Skipped: DROP TABLE `users`;\n"+
+		"-- Skipped: ALTER TABLE `_sqldef_new_users` RENAME TO `users`;\n")
+
+	// So is a rebuild with --skip-drop, which would skip DROP TABLE and fail to rename the new table otherwise
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer PRIMARY KEY,
+		  name varchar(40) NOT NULL DEFAULT '',
+		  age integer
+		);
+		`,
+	)+createIndex)
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--skip-drop", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"-- Skipped: CREATE TABLE `_sqldef_new_users` (\n"+
+		"  id integer PRIMARY KEY,\n"+
+		"  name varchar(40) NOT NULL DEFAULT '',\n"+
+		"  age integer\n"+
+		");\n"+
+		"-- Skipped: INSERT INTO `_sqldef_new_users` (`id`, `name`, `age`) SELECT `id`, `name`, `age` FROM `users`;\n"+
+		"-- Skipped: DROP TABLE `users`;\n"+
+		"-- Skipped: ALTER TABLE `_sqldef_new_users` RENAME TO `users`;\n"+
+		"-- Skipped: "+createIndex)
+	assertApplyOutput(t, createTable+createIndex, nothingModified)
+}
+
+func TestSQLite3defRebuildReferencedTable(t *testing.T) {
+	resetTestDatabase()
+
+	// Foreign keys are enforced only when the connection enables them
+	dbName := "sqlite3def_test?_foreign_keys=1"
+	createUsers := "CREATE TABLE users (id integer PRIMARY KEY, name text);\n"
+	createPosts := "CREATE TABLE posts (id integer PRIMARY KEY, user_id integer REFERENCES users (id));\n"
+	writeFile("schema.sql", createUsers+createPosts)
+	assertEquals(t, assertedExecute(t, "sqlite3def", dbName, "--file", "schema.sql"), applyPrefix+createUsers+createPosts)
+	mustExecute("sqlite3", "sqlite3def_test", "INSERT INTO users (id, name) VALUES (1, 'alice'); INSERT INTO posts (id, user_id) VALUES (1, 1);")
+
+	// Foreign keys are disabled once while the tables are rebuilt, so dropping the referenced one doesn't fail
+	createUsers = "CREATE TABLE users (id integer PRIMARY KEY, name text NOT NULL DEFAULT '');\n"
+	createPosts = "CREATE TABLE posts (id integer PRIMARY KEY, user_id integer NOT NULL REFERENCES users (id));\n"
+	writeFile("schema.sql", createUsers+createPosts)
+	assertEquals(t, assertedExecute(t, "sqlite3def", dbName, "--file", "schema.sql"), applyPrefix+
+		"PRAGMA foreign_keys = OFF;\n"+
+		"CREATE TABLE `_sqldef_new_users` (id integer PRIMARY KEY, name text NOT NULL DEFAULT '');\n"+
+		"INSERT INTO `_sqldef_new_users` (`id`, `name`) SELECT `id`, `name` FROM `users`;\n"+
+		"DROP TABLE `users`;\n"+
+		"ALTER TABLE `_sqldef_new_users` RENAME TO `users`;\n"+
+		"CREATE TABLE `_sqldef_new_posts` (id integer PRIMARY KEY, user_id integer NOT NULL REFERENCES users (id));\n"+
+		"INSERT INTO `_sqldef_new_posts` (`id`, `user_id`) SELECT `id`, `user_id` FROM `posts`;\n"+
+		"DROP TABLE `posts`;\n"+
+		"ALTER TABLE `_sqldef_new_posts` RENAME TO `posts`;\n"+
+		"PRAGMA foreign_keys = ON;\n",
+	)
+	assertEquals(t, assertedExecute(t, "sqlite3def", dbName, "--file", "schema.sql"), nothingModified)
+	assertEquals(t, assertedExecute(t, "sqlite3", "sqlite3def_test", "SELECT id, user_id FROM posts;"), "1|1\n")
+
+	// The pragmas aren't printed when the rebuild is skipped
+	writeFile("schema.sql", createUsers+"CREATE TABLE posts (id integer PRIMARY KEY, user_id integer REFERENCES users (id));\n")
+	assertEquals(t, assertedExecute(t, "sqlite3def", dbName, "--skip-drop", "--file", "schema.sql"), applyPrefix+
+		"-- Skipped: CREATE TABLE `_sqldef_new_posts` (id integer PRIMARY KEY, user_id integer REFERENCES users (id));\n"+
+		"-- Skipped: INSERT INTO `_sqldef_new_posts` (`id`, `user_id`) SELECT `id`, `user_id` FROM `posts`;\n"+
+		"-- Skipped: DROP TABLE `posts`;\n"+
+		"-- Skipped: ALTER TABLE `_sqldef_new_posts` RENAME TO `posts`;\n",
+	)
+}
+
+func TestSQLite3defWithoutRowID(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE settings (name text PRIMARY KEY, value text);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecute("sqlite3", "sqlite3def_test", "INSERT INTO settings (name, value) VALUES ('theme', 'dark');")
+
+	// WITHOUT ROWID can't be altered
+	createTable = "CREATE TABLE settings (name text PRIMARY KEY, value text) WITHOUT ROWID;\n"
+	assertApplyOutput(t, createTable, applyPrefix+
+		"CREATE TABLE `_sqldef_new_settings` (name text PRIMARY KEY, value text) WITHOUT ROWID;\n"+
+		"INSERT INTO `_sqldef_new_settings` (`name`, `value`) SELECT `name`, `value` FROM `settings`;\n"+
+		"DROP TABLE `settings`;\n"+
+		"ALTER TABLE `_sqldef_new_settings` RENAME TO `settings`;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportRoundTrip(t)
+	assertEquals(t, assertedExecute(t, "sqlite3", "sqlite3def_test", "SELECT name, value FROM settings;"), "theme|dark\n")
+
+	createTable = "CREATE TABLE settings (name text PRIMARY KEY, value text);\n"
+	assertApplyOutput(t, createTable, applyPrefix+
+		"CREATE TABLE `_sqldef_new_settings` (name text PRIMARY KEY, value text);\n"+
+		"INSERT INTO `_sqldef_new_settings` (`name`, `value`) SELECT `name`, `value` FROM `settings`;\n"+
+		"DROP TABLE `settings`;\n"+
+		"ALTER TABLE `_sqldef_new_settings` RENAME TO `settings`;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestSQLite3defDataTypes(t *testing.T) {
 	resetTestDatabase()
 
@@ -149,6 +532,54 @@ func TestSQLite3defDataTypes(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified) // Label for column type may change. Type will be examined.
 }
 
+func TestSQLite3defTypeAffinity(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id INT NOT NULL,
+		  name varchar(255),
+		  score double,
+		  created_at datetime
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	// Types of the same affinity don't rebuild the table
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id INTEGER NOT NULL,
+		  name text,
+		  score real,
+		  created_at timestamp
+		);
+		`,
+	), nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  id INTEGER NOT NULL,
+		  name text,
+		  score integer,
+		  created_at timestamp
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+
+		"CREATE TABLE `_sqldef_new_users` (\n"+
+		"  id INTEGER NOT NULL,\n"+
+		"  name text,\n"+
+		"  score integer,\n"+
+		"  created_at timestamp\n"+
+		");\n"+
+		"INSERT INTO `_sqldef_new_users` (`id`, `name`, `score`, `created_at`) SELECT `id`, `name`, `score`, `created_at` FROM `users`;\n"+
+		"DROP TABLE `users`;\n"+
+		"ALTER TABLE `_sqldef_new_users` RENAME TO `users`;\n",
+	)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 //
 // ----------------------- following tests are for CLI -----------------------
 //
@@ -167,6 +598,77 @@ func TestSQLite3defDryRun(t *testing.T) {
 	assertEquals(t, dryRun, strings.Replace(apply, "Apply", "dry run", 1))
 }
 
+func TestSQLite3defPrintResult(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY
+		);
+		CREATE TABLE posts (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  age integer
+		);
+		`,
+	)
+	writeFile("schema.sql", createTable)
+
+	// The drop of posts is skipped, so it remains
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--print-result", "--file", "schema.sql")
+	assertEquals(t, out, "-- Skipped drop of table posts\n"+"-- result --\n"+
+		createTable+"\n"+
+		"CREATE TABLE posts (\n"+
+		"    id integer NOT NULL PRIMARY KEY\n"+
+		");\n")
+
+	// Nothing is applied
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, out, "-- Skipped drop of table posts\n"+"-- dry run --\n"+
+		"ALTER TABLE `users` ADD COLUMN `age` integer;\n")
+}
+
+func TestSQLite3defPrintResultWithSkippedDDLs(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  age integer
+		);`,
+	))
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	))
+
+	// The drop of age is skipped without --allow-unsafe, so it remains with the added column
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--print-result", "--file", "schema.sql")
+	assertEquals(t, out, "-- result --\n"+
+		"CREATE TABLE `users` (\n"+
+		"  `id` integer NOT NULL,\n"+
+		"  `name` text,\n"+
+		"  `age` integer,\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		");\n")
+
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--print-result", "--allow-unsafe", "--file", "schema.sql")
+	assertEquals(t, out, "-- result --\n"+stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name text
+		);
+		`,
+	))
+}
+
 func TestSQLite3defSkipDrop(t *testing.T) {
 	resetTestDatabase()
 	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
@@ -178,11 +680,89 @@ func TestSQLite3defSkipDrop(t *testing.T) {
 
 	writeFile("schema.sql", "")
 
-	skipDrop := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--skip-drop", "--file", "schema.sql")
-	apply := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	skipDrop := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--skip-drop", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	apply := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 	assertEquals(t, skipDrop, strings.Replace(apply, "DROP", "-- Skipped: DROP", 1))
 }
 
+func TestSQLite3defAllowUnsafe(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY,
+		    age integer
+		);
+		CREATE TABLE posts (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY
+		);
+		`,
+	))
+
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	assertEquals(t, out, "-- Skipped drop of table posts\n"+applyPrefix+
+		"-- Skipped: ALTER TABLE `users` DROP COLUMN `age`;\n")
+
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--allow-unsafe", "--file", "schema.sql")
+	assertEquals(t, out, "-- Skipped drop of table posts\n"+applyPrefix+
+		"ALTER TABLE `users` DROP COLUMN `age`;\n")
+
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"DROP TABLE `posts`;\n")
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY
+		);
+		`,
+	), nothingModified)
+}
+
+func TestSQLite3defQuoteIdentifiers(t *testing.T) {
+	resetTestDatabase()
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY
+		);`,
+	))
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name text,
+		  "order" integer,
+		  "nick name" text
+		);
+		`,
+	))
+
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, out, "-- dry run --\n"+
+		"ALTER TABLE `users` ADD COLUMN `name` text;\n"+
+		"ALTER TABLE `users` ADD COLUMN `order` integer;\n"+
+		"ALTER TABLE `users` ADD COLUMN `nick name` text;\n")
+
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--quote-identifiers", "necessary", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"ALTER TABLE users ADD COLUMN name text;\n"+
+		"ALTER TABLE users ADD COLUMN `order` integer;\n"+
+		"ALTER TABLE users ADD COLUMN `nick name` text;\n")
+	assertApplyOutput(t, stripHeredoc(`
+		CREATE TABLE users (
+		  id integer NOT NULL PRIMARY KEY,
+		  name text,
+		  "order" integer,
+		  "nick name" text
+		);
+		`,
+	), nothingModified)
+}
+
 func TestSQLite3defExport(t *testing.T) {
 	resetTestDatabase()
 	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--export")
@@ -204,6 +784,190 @@ func TestSQLite3defExport(t *testing.T) {
 	))
 }
 
+func TestSQLite3defExportRoundTrip(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY,
+		    name text NOT NULL DEFAULT '',
+		    age integer CHECK (age > 0)
+		);
+		CREATE TABLE posts (
+		    id integer NOT NULL PRIMARY KEY,
+		    user_id integer NOT NULL REFERENCES users (id)
+		);
+		CREATE VIEW adult_users AS SELECT id, name FROM users WHERE age >= 20;`,
+	))
+	assertExportRoundTrip(t)
+}
+
+func TestSQLite3defDumpModel(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY,
+		    name varchar(40) NOT NULL DEFAULT ''
+		);
+		CREATE UNIQUE INDEX index_name ON users (name);`,
+	))
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--dump-model", "json")
+
+	var model struct {
+		Tables []struct {
+			Name    string
+			Columns []struct {
+				Name    string
+				Type    string
+				NotNull bool `json:"not_null"`
+				Default string
+			}
+			Indexes []struct {
+				Name    string
+				Columns []string
+				Primary bool
+				Unique  bool
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(out), &model); err != nil {
+		t.Fatalf("failed to parse the dumped model: %s\n%s", err, out)
+	}
+	if len(model.Tables) != 1 {
+		t.Fatalf("expected one table, but got: %s", out)
+	}
+	table := model.Tables[0]
+	assertEquals(t, fmt.Sprintf("%+v", table.Columns), "[{Name:id Type:integer NotNull:true Default:} {Name:name Type:varchar(40) NotNull:true Default:''}]")
+	assertEquals(t, fmt.Sprintf("%+v", table.Indexes), "[{Name:PRIMARY Columns:[id] Primary:true Unique:true} {Name:index_name Columns:[name] Primary:false Unique:true}]")
+}
+
+func TestSQLite3defStdin(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);\n"
+	out := assertedExecuteWithStdin(t, createTable, "sqlite3def", "sqlite3def_test", "--dry-run", "--file", "-")
+	assertEquals(t, out, "-- dry run --\n"+createTable)
+
+	// stdin is read by default
+	out = assertedExecuteWithStdin(t, createTable, "sqlite3def", "sqlite3def_test")
+	assertEquals(t, out, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestSQLite3defDirectory(t *testing.T) {
+	resetTestDatabase()
+
+	dir, err := ioutil.TempDir("", "sqlite3def_schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Files are read in the order of their names, and the last statement of a file may lack `;`
+	writeFile(filepath.Join(dir, "02_posts.sql"), "CREATE TABLE posts (id integer NOT NULL PRIMARY KEY, title text)")
+	writeFile(filepath.Join(dir, "01_users.sql"), "CREATE TABLE users (id integer NOT NULL PRIMARY KEY);\n-- users")
+	writeFile(filepath.Join(dir, "README.md"), "not SQL")
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", dir)
+	assertEquals(t, out, applyPrefix+
+		"CREATE TABLE users (id integer NOT NULL PRIMARY KEY);\n"+
+		"CREATE TABLE posts (id integer NOT NULL PRIMARY KEY, title text);\n",
+	)
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", dir)
+	assertEquals(t, out, nothingModified)
+
+	emptyDir := filepath.Join(dir, "empty")
+	if err := os.Mkdir(emptyDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	out, err = execute("sqlite3def", "sqlite3def_test", "--file", emptyDir)
+	if err == nil {
+		t.Errorf("expected an empty directory to fail but succeeded with: %s", out)
+	}
+	if !strings.Contains(out, "no *.sql file is found in the directory") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestSQLite3defOutputJSON(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text, age integer);
+		CREATE INDEX index_name ON users (name);
+		`,
+	))
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--dry-run", "--output", "json", "--file", "schema.sql")
+	var changes []schema.Change
+	if err := json.Unmarshal([]byte(out), &changes); err != nil {
+		t.Fatalf("failed to parse the output: %s\n%s", err, out)
+	}
+	assertEquals(t, fmt.Sprintf("%+v", changes), "[{ObjectType:column ObjectName:users.age Operation:create Statement:ALTER TABLE `users` ADD COLUMN `age` integer} {ObjectType:index ObjectName:users.index_name Operation:create Statement:CREATE INDEX index_name ON users (name)}]")
+
+	out = assertedExecute(t, "sqlite3def", "sqlite3def_test", "--export", "--output", "json")
+	if err := json.Unmarshal([]byte(out), &changes); err != nil {
+		t.Fatalf("failed to parse the output: %s\n%s", err, out)
+	}
+	assertEquals(t, fmt.Sprintf("%+v", changes), "[{ObjectType:table ObjectName:users Operation:create Statement:CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text)}]")
+}
+
+func TestSQLite3defExportJSONTrigger(t *testing.T) {
+	resetTestDatabase()
+
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_insert AFTER INSERT ON users BEGIN
+		  UPDATE users SET name = 'a' WHERE id = NEW.id;
+
+		  UPDATE users SET name = 'b' WHERE id = 0;
+		END`,
+	)
+	mustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);\n"+createTrigger+";")
+
+	// The trigger body has `;\n\n`, which must not split the statement
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--export", "--output", "json")
+	var changes []schema.Change
+	if err := json.Unmarshal([]byte(out), &changes); err != nil {
+		t.Fatalf("failed to parse the output: %s\n%s", err, out)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, but got: %+v", changes)
+	}
+	assertEquals(t, fmt.Sprintf("%+v", changes[1]), fmt.Sprintf("%+v", schema.Change{ObjectType: schema.ObjectTrigger, ObjectName: "users_insert", Operation: schema.OperationCreate, Statement: createTrigger}))
+}
+
+func TestSQLite3defOutputJSONWithPrintResult(t *testing.T) {
+	resetTestDatabase()
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY);\n")
+	out, err := execute("sqlite3def", "sqlite3def_test", "--dry-run", "--output", "json", "--print-result", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected --output json with --print-result to fail, but got: %s", out)
+	}
+	if !strings.Contains(out, "--output=json can't be used with --print-result") {
+		t.Errorf("unexpected output: %s", out)
+	}
+}
+
+func TestSQLite3defFeatures(t *testing.T) {
+	out := assertedExecute(t, "sqlite3def", "--features")
+	features := map[string]bool{}
+	for _, feature := range strings.Split(strings.TrimSpace(out), "\n") {
+		features[feature] = true
+	}
+	for _, feature := range []string{"Trigger: CREATE TRIGGER", "View: CREATE VIEW", "Column: rename by @renamed"} {
+		if !features[feature] {
+			t.Errorf("expected --features to include %q, but got: %v", feature, features)
+		}
+	}
+	for _, feature := range []string{"Domain: CREATE DOMAIN", "Function: CREATE FUNCTION"} {
+		if features[feature] {
+			t.Errorf("expected --features not to include %q, but got: %v", feature, features)
+		}
+	}
+}
+
 func TestSQLite3defHelp(t *testing.T) {
 	_, err := execute("sqlite3def", "--help")
 	if err != nil {
@@ -226,16 +990,33 @@ func TestMain(m *testing.M) {
 func assertApply(t *testing.T, schema string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	assertedExecute(t, "sqlite3def", "sqlite3def_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
 }
 
 func assertApplyOutput(t *testing.T, schema string, expected string) {
 	t.Helper()
 	writeFile("schema.sql", schema)
-	actual := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	actual := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, actual, expected)
+}
+
+func assertApplyFailure(t *testing.T, schema string, expected string) {
+	t.Helper()
+	writeFile("schema.sql", schema)
+	actual, err := execute("sqlite3def", "sqlite3def_test", "--allow-unsafe", "--enable-drop-table", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected 'sqlite3def sqlite3def_test --file schema.sql' to fail but succeeded with: %s", actual)
+	}
 	assertEquals(t, actual, expected)
 }
 
+// Applying the output of `--export` should be always "Nothing is modified".
+func assertExportRoundTrip(t *testing.T) {
+	t.Helper()
+	out := assertedExecute(t, "sqlite3def", "sqlite3def_test", "--export")
+	assertApplyOutput(t, out, nothingModified)
+}
+
 func mustExecute(command string, args ...string) {
 	out, err := execute(command, args...)
 	if err != nil {
@@ -260,6 +1041,17 @@ func assertEquals(t *testing.T, actual string, expected string) {
 	}
 }
 
+func assertedExecuteWithStdin(t *testing.T, stdin string, command string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command(command, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("failed to execute '%s %s' (error: '%s'): `%s`", command, strings.Join(args, " "), err, out)
+	}
+	return string(out)
+}
+
 func execute(command string, args ...string) (string, error) {
 	cmd := exec.Command(command, args...)
 	out, err := cmd.CombinedOutput()
@@ -1,5 +1,10 @@
 package schema
 
+import (
+	"fmt"
+	"strings"
+)
+
 type DDL interface {
 	Statement() string
 }
@@ -39,13 +44,42 @@ type AddPolicy struct {
 	policy    Policy
 }
 
+type CommentOnColumn struct {
+	statement  string
+	tableName  string
+	columnName string
+	comment    *Value
+}
+
+type CommentOnTable struct {
+	statement string
+	tableName string
+	comment   *Value
+}
+
+type ClusterOn struct {
+	statement string
+	tableName string
+	indexName string
+}
+
 type Table struct {
-	name        string
-	columns     []Column
-	indexes     []Index
-	foreignKeys []ForeignKey
-	policies    []Policy
-	// XXX: have options and alter on its change?
+	name              string
+	columns           []Column
+	indexes           []Index
+	foreignKeys       []ForeignKey
+	checks            []CheckDefinition // table-level checks. Column-level ones are in `Column.check`.
+	exclusions        []Exclusion       // for Postgres `EXCLUDE`
+	policies          []Policy
+	comment           *Value            // for Postgres `COMMENT ON TABLE`
+	partitionDef      string            // for Postgres `PARTITION BY`
+	inherits          []string          // for Postgres `INHERITS`
+	schema            string            // only for MSSQL, whose table names are not schema-qualified
+	options           map[string]string // MySQL table options like ENGINE, keyed by upper-case names
+	withoutRowID      bool              // for SQLite `WITHOUT ROWID`
+	storageParameters map[string]string // for Postgres `WITH (...)`, keyed by lower-case names
+	tablespace        string            // for Postgres `TABLESPACE`. Empty for the default one.
+	clusterIndex      string            // for Postgres `ALTER TABLE ... CLUSTER ON`
 }
 
 type Column struct {
@@ -66,28 +100,37 @@ type Column struct {
 	timezone       bool // for Postgres `with time zone`
 	keyOption      ColumnKeyOption
 	onUpdate       *Value
+	comment        *Value
 	enumValues     []string
 	references     string
 	identity       string
 	sequence       *Sequence
+	generatedExpr  string // `GENERATED ALWAYS AS (expr)`, or `AS (expr)` for MSSQL
+	generatedKind  string // "STORED" or "VIRTUAL"
+	renamedFrom    string // set by `-- @renamed from=old_name` annotation
 	// TODO: keyopt
 	// XXX: zerofill?
 }
 
 type Index struct {
-	name      string
-	indexType string // Parsed only in "create table" but not parsed in "add index". Only used inside `generateDDLsForCreateTable`.
-	columns   []IndexColumn
-	primary   bool
-	unique    bool
-	where     string // for Postgres `Partial Indexes`
-	clustered bool   // for MSSQL
-	options   []IndexOption
+	name           string
+	indexType      string // Parsed only in "create table" but not parsed in "add index". Only used inside `generateDDLsForCreateTable`.
+	columns        []IndexColumn
+	includeColumns []string // for Postgres and MSSQL `INCLUDE (...)`. Not key columns.
+	using          string   // for Postgres index access methods like `gin`. Empty for the default `btree`.
+	primary        bool
+	unique         bool
+	where          string // for Postgres `Partial Indexes`
+	clustered      bool   // for MSSQL
+	constraint     bool   // for MSSQL `CONSTRAINT name UNIQUE`, which is not a plain unique index
+	options        []IndexOption
 }
 
 type IndexColumn struct {
-	column string
-	length *int
+	column     string
+	length     *int
+	expression string // for a functional index. `column` is empty then.
+	collate    string
 }
 
 type IndexOption struct {
@@ -96,13 +139,15 @@ type IndexOption struct {
 }
 
 type ForeignKey struct {
-	constraintName   string
-	indexName        string
-	indexColumns     []string
-	referenceName    string
-	referenceColumns []string
-	onDelete         string
-	onUpdate         string
+	constraintName    string
+	indexName         string
+	indexColumns      []string
+	referenceName     string
+	referenceColumns  []string
+	onDelete          string
+	onUpdate          string
+	deferrable        bool // Postgres only
+	initiallyDeferred bool // Postgres only
 }
 
 type Policy struct {
@@ -115,10 +160,45 @@ type Policy struct {
 	withCheck     string
 }
 
-type View struct {
+type CreateType struct {
 	statement  string
 	name       string
-	definition string
+	enumValues []string
+}
+
+type CreateDomain struct {
+	statement  string
+	name       string
+	definition Column            // the base type, NOT NULL and the default, which are given like a column's
+	checks     []CheckDefinition // unlike a column, a domain may have several checks
+}
+
+type Function struct {
+	statement  string
+	name       string   // schema-qualified
+	arguments  []string // normalized, like `a integer DEFAULT 1`
+	returns    string   // normalized
+	language   string
+	attributes []string // normalized non-default attributes, like `IMMUTABLE`
+	body       string
+}
+
+type Trigger struct {
+	statement string
+	name      string
+	tableName string
+	timing    string // BEFORE, AFTER or INSTEAD OF
+	events    string // normalized, like `INSERT OR UPDATE`
+	body      string // normalized, like `FOR EACH ROW EXECUTE FUNCTION f()`
+}
+
+type View struct {
+	statement    string
+	name         string
+	definition   string
+	dependencies []string // tables and views referred to by the definition
+	materialized bool
+	indexes      []Index // only for materialized views
 }
 
 type Value struct {
@@ -129,7 +209,6 @@ type Value struct {
 	strVal   string  // ValueTypeStr
 	intVal   int     // ValueTypeInt
 	floatVal float64 // ValueTypeFloat
-	bitVal   bool    // ValueTypeBit
 }
 
 type ValueType int
@@ -174,12 +253,27 @@ type Sequence struct {
 
 type DefaultDefinition struct {
 	value          *Value
+	expression     string // MySQL's expression default like `DEFAULT (JSON_ARRAY())`
+	comparable     string // expression without the casts PostgreSQL adds, only for comparison
 	constraintName string // only for MSSQL
 }
 
 type CheckDefinition struct {
 	definition     string
 	constraintName string
+	noInherit      bool // only for table-level checks. Column-level ones have `Column.checkNoInherit`.
+}
+
+type Exclusion struct {
+	constraintName string
+	indexType      string
+	elements       []ExclusionElement
+	where          string
+}
+
+type ExclusionElement struct {
+	column   string
+	operator string
 }
 
 func (c *CreateTable) Statement() string {
@@ -206,6 +300,64 @@ func (a *AddPolicy) Statement() string {
 	return a.statement
 }
 
+func (c *CommentOnColumn) Statement() string {
+	return c.statement
+}
+
+func (c *CommentOnTable) Statement() string {
+	return c.statement
+}
+
+func (c *ClusterOn) Statement() string {
+	return c.statement
+}
+
+func (c *CreateType) Statement() string {
+	return c.statement
+}
+
+func (c *CreateDomain) Statement() string {
+	return c.statement
+}
+
+func (f *Function) Statement() string {
+	return f.statement
+}
+
+// Functions are identified by their names and argument types since they can be overloaded
+func (f *Function) signature() string {
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(f.argumentTypes(), ", "))
+}
+
+// Types of input arguments like `a integer DEFAULT 1`, ignoring names and defaults
+func (f *Function) argumentTypes() []string {
+	types := []string{}
+	for _, argument := range f.arguments {
+		words := strings.Fields(argument)
+		for i, word := range words {
+			if word == "default" || word == "=" {
+				words = words[:i]
+				break
+			}
+		}
+		if len(words) > 0 && words[0] == "out" {
+			continue
+		}
+		if len(words) > 0 && (words[0] == "in" || words[0] == "inout" || words[0] == "variadic") {
+			words = words[1:]
+		}
+		if len(words) > 1 && !multiWordTypePrefixes[words[0]] {
+			words = words[1:] // argument name
+		}
+		types = append(types, strings.Join(words, " "))
+	}
+	return types
+}
+
+func (t *Trigger) Statement() string {
+	return t.statement
+}
+
 func (v *View) Statement() string {
 	return v.statement
 }
@@ -240,6 +392,158 @@ func (t *Table) PrimaryKey() *Index {
 	}
 }
 
+// Accessors for inspecting the result of `Parse` and `ParseModel`. Slices are copied, so modifying them doesn't change the parsed schema.
+
+func (c *CreateTable) Table() *Table {
+	return &c.table
+}
+
+func (c *CreateIndex) TableName() string {
+	return c.tableName
+}
+
+func (c *CreateIndex) Index() *Index {
+	return &c.index
+}
+
+func (a *AddIndex) TableName() string {
+	return a.tableName
+}
+
+func (a *AddIndex) Index() *Index {
+	return &a.index
+}
+
+func (a *AddForeignKey) TableName() string {
+	return a.tableName
+}
+
+func (a *AddForeignKey) ForeignKey() *ForeignKey {
+	return &a.foreignKey
+}
+
+func (v *View) Name() string {
+	return v.name
+}
+
+func (v *View) Definition() string {
+	return v.definition
+}
+
+func (v *View) Materialized() bool {
+	return v.materialized
+}
+
+func (t *Table) Name() string {
+	return t.name
+}
+
+func (t *Table) Columns() []Column {
+	return append([]Column{}, t.columns...)
+}
+
+// A primary key given by a column like `id integer PRIMARY KEY` is included too
+func (t *Table) Indexes() []Index {
+	indexes := append([]Index{}, t.indexes...)
+	if primaryKey := t.PrimaryKey(); primaryKey != nil && findPrimaryKey(indexes) == nil {
+		indexes = append([]Index{*primaryKey}, indexes...)
+	}
+	return indexes
+}
+
+func (t *Table) ForeignKeys() []ForeignKey {
+	return append([]ForeignKey{}, t.foreignKeys...)
+}
+
+func (c *Column) Name() string {
+	return c.name
+}
+
+func (c *Column) TypeName() string {
+	return c.typeName
+}
+
+// The type with its length and so on as written in DDLs, like `varchar(40)` or `integer[]`
+func (c *Column) DataType() string {
+	return generateDataType(*c)
+}
+
+// `PRIMARY KEY` implies `NOT NULL`
+func (c *Column) NotNull() bool {
+	return (c.notNull != nil && *c.notNull) || c.keyOption == ColumnKeyPrimary
+}
+
+// The default value or expression as written in DDLs, or empty
+func (c *Column) Default() string {
+	if c.defaultDef == nil {
+		return ""
+	}
+	definition, err := generateDefaultDefinition(*c.defaultDef)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(definition, "DEFAULT ")
+}
+
+// The table of an inline `REFERENCES`, or empty
+func (c *Column) References() string {
+	return c.references
+}
+
+func (i *Index) Name() string {
+	return i.name
+}
+
+// An expression of a functional index is given as is
+func (i *Index) Columns() []string {
+	columns := []string{}
+	for _, column := range i.columns {
+		if column.expression != "" {
+			columns = append(columns, column.expression)
+		} else {
+			columns = append(columns, column.column)
+		}
+	}
+	return columns
+}
+
+func (i *Index) Primary() bool {
+	return i.primary
+}
+
+func (i *Index) Unique() bool {
+	return i.unique
+}
+
+// The predicate of a partial index, or empty
+func (i *Index) Where() string {
+	return i.where
+}
+
+func (f *ForeignKey) Name() string {
+	return f.constraintName
+}
+
+func (f *ForeignKey) Columns() []string {
+	return append([]string{}, f.indexColumns...)
+}
+
+func (f *ForeignKey) ReferenceTable() string {
+	return f.referenceName
+}
+
+func (f *ForeignKey) ReferenceColumns() []string {
+	return append([]string{}, f.referenceColumns...)
+}
+
+func (f *ForeignKey) OnDelete() string {
+	return f.onDelete
+}
+
+func (f *ForeignKey) OnUpdate() string {
+	return f.onUpdate
+}
+
 func (keyOption ColumnKeyOption) isUnique() bool {
 	return keyOption == ColumnKeyUnique || keyOption == ColumnKeyUniqueKey
 }
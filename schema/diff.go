@@ -0,0 +1,48 @@
+package schema
+
+type ObjectType string
+
+const (
+	ObjectTable      ObjectType = "table"
+	ObjectColumn     ObjectType = "column"
+	ObjectIndex      ObjectType = "index"
+	ObjectConstraint ObjectType = "constraint" // primary keys, foreign keys, checks and MSSQL defaults
+	ObjectView       ObjectType = "view"
+	ObjectDataType   ObjectType = "type"
+	ObjectDomain     ObjectType = "domain"
+	ObjectFunction   ObjectType = "function"
+	ObjectTrigger    ObjectType = "trigger"
+	ObjectPolicy     ObjectType = "policy"
+	ObjectSchema     ObjectType = "schema"
+)
+
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationDrop   Operation = "drop"
+	OperationAlter  Operation = "alter"
+)
+
+// A statement of `GenerateDiff` with the object it changes.
+// ObjectType and Operation are empty for a statement which doesn't change an object, like `SET FOREIGN_KEY_CHECKS = 0`.
+type Change struct {
+	ObjectType ObjectType `json:"object_type"`
+	ObjectName string     `json:"object_name"` // An object of a table, like a column or an index, is qualified by its table like `users.name`
+	Operation  Operation  `json:"operation"`
+	Statement  string     `json:"statement"`
+}
+
+// Same as `GenerateIdempotentDDLs`, but tells which object each statement changes
+func GenerateDiff(mode GeneratorMode, desiredSQL string, currentSQL string) ([]Change, error) {
+	result, err := GenerateIdempotentDDLsWithResult(mode, desiredSQL, currentSQL, GeneratorOptions{DropTablesEnabled: true})
+	if err != nil {
+		return nil, err
+	}
+	return result.ChangeList(), nil
+}
+
+// Changes of `DDLs` in order
+func (r *Result) ChangeList() []Change {
+	return append([]Change{}, r.Changes...)
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGenerateDiff(t *testing.T) {
+	changes, err := GenerateDiff(GeneratorModeMysql, `
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) NOT NULL,
+		  age int,
+		  group_id bigint,
+		  KEY index_age (age),
+		  CONSTRAINT users_group_id_fk FOREIGN KEY (group_id) REFERENCES groups (id)
+		);
+		CREATE TABLE groups (id bigint NOT NULL PRIMARY KEY);
+	`, `
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(20),
+		  email varchar(40),
+		  group_id bigint,
+		  KEY index_name (name)
+		);
+		CREATE TABLE groups (id bigint NOT NULL PRIMARY KEY);
+		CREATE TABLE posts (id bigint NOT NULL PRIMARY KEY);
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertChanges(t, changes, []string{
+		"column users.name alter: ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) NOT NULL",
+		"column users.age create: ALTER TABLE `users` ADD COLUMN `age` int AFTER `name`",
+		"index users.index_age create: ALTER TABLE `users` ADD key `index_age` (`age`)",
+		"constraint users.users_group_id_fk create: ALTER TABLE `users` ADD CONSTRAINT `users_group_id_fk` FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`)",
+		"index users.index_name drop: ALTER TABLE `users` DROP INDEX `index_name`",
+		"column users.email drop: ALTER TABLE `users` DROP COLUMN `email`",
+		"table posts drop: DROP TABLE `posts`",
+	})
+}
+
+func TestGenerateDiffNonTableObjects(t *testing.T) {
+	changes, err := GenerateDiff(GeneratorModePostgres, `
+		CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text);
+		CREATE INDEX index_name ON users (name);
+		CREATE VIEW names AS SELECT name FROM users;
+	`, `
+		CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text);
+		CREATE INDEX index_name ON users (lower(name));
+		CREATE VIEW names AS SELECT id, name FROM users;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertChanges(t, changes, []string{
+		`index public.users.index_name drop: DROP INDEX "index_name"`,
+		"index public.users.index_name create: CREATE INDEX index_name ON users (name)",
+		`view public.names alter: CREATE OR REPLACE VIEW "public"."names" AS select name from users`,
+	})
+}
+
+func TestGenerateDiffMergedAlterTable(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModeMysql,
+		"CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name varchar(40), age int);",
+		"CREATE TABLE users (id bigint NOT NULL PRIMARY KEY);",
+		GeneratorOptions{MergeAlterTable: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A merged statement changes the table, not one of its columns
+	assertChanges(t, result.ChangeList(), []string{
+		"table users alter: ALTER TABLE `users` ADD COLUMN `name` varchar(40) AFTER `id`, ADD COLUMN `age` int AFTER `name`",
+	})
+}
+
+func TestGenerateDiffStatementWithoutObject(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModeSQLite3,
+		"CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text NOT NULL);",
+		"CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text);",
+		GeneratorOptions{ForeignKeysEnabled: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := result.ChangeList()
+	assertEqual(t, fmt.Sprintf("%+v", changes[0]), "{ObjectType: ObjectName: Operation: Statement:PRAGMA foreign_keys = OFF}")
+	for _, change := range changes[1 : len(changes)-1] {
+		assertEqual(t, fmt.Sprintf("%s %s %s", change.ObjectType, change.ObjectName, change.Operation), "table users alter")
+	}
+	assertEqual(t, fmt.Sprintf("%+v", changes[len(changes)-1]), "{ObjectType: ObjectName: Operation: Statement:PRAGMA foreign_keys = ON}")
+}
+
+func TestChangeListOfSameStatements(t *testing.T) {
+	g := &Generator{changes: map[string][]Change{}, changedTables: map[string]string{}}
+	ddl := "ALTER TABLE `users` DROP CONSTRAINT `users_check`"
+	g.tableChange(ObjectConstraint, "users", "users_check", OperationDrop, ddl)
+	g.tableChange(ObjectConstraint, "users", "users_check", OperationCreate, ddl)
+	// Each statement keeps its own change, and a statement recorded once keeps it however often it appears
+	assertChanges(t, g.changeList([]string{ddl, ddl, ddl, "SELECT 1"}), []string{
+		"constraint users.users_check drop: " + ddl,
+		"constraint users.users_check create: " + ddl,
+		"constraint users.users_check create: " + ddl,
+		"  : SELECT 1",
+	})
+}
+
+func assertChanges(t *testing.T, changes []Change, expected []string) {
+	t.Helper()
+	actual := []string{}
+	for _, change := range changes {
+		actual = append(actual, fmt.Sprintf("%s %s %s: %s", change.ObjectType, change.ObjectName, change.Operation, change.Statement))
+	}
+	assertEqual(t, fmt.Sprintf("%q", actual), fmt.Sprintf("%q", expected))
+}
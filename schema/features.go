@@ -0,0 +1,109 @@
+package schema
+
+import "strings"
+
+// A feature is supported by a mode when the generator can generate DDLs from `current` to `desired` in the mode.
+// A feature may be listed more than once when dialects need different statements for it.
+type feature struct {
+	name    string
+	current string
+	desired string
+}
+
+const featureTable = "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20));\n"
+
+var features = []feature{
+	{"Table: CREATE TABLE", "", featureTable},
+	{"Table: DROP TABLE", featureTable, ""},
+	{"Column: ADD COLUMN", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20), age integer);"},
+	{"Column: DROP COLUMN", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY);"},
+	{"Column: change data type", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(40));"},
+	{"Column: change NOT NULL", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20) NOT NULL);"},
+	{"Column: change DEFAULT", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20) DEFAULT 'none');"},
+	{"Column: rename by @renamed", featureTable, "CREATE TABLE users (\n  id integer NOT NULL PRIMARY KEY,\n  nickname varchar(20) -- @renamed from=name\n);"},
+	{"Column: CHECK", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY CHECK (id > 0), name varchar(20));"},
+	{"Column: COMMENT", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20));\nCOMMENT ON COLUMN users.name IS 'name';"},
+	{"Index: CREATE INDEX", featureTable, featureTable + "CREATE INDEX index_name ON users (name);"},
+	{"Index: DROP INDEX", featureTable + "CREATE INDEX index_name ON users (name);", featureTable},
+	{"Primary Key: change primary key", featureTable, "CREATE TABLE users (id integer NOT NULL, name varchar(20) NOT NULL, PRIMARY KEY (id, name));"},
+	{"Foreign Key: ADD FOREIGN KEY", featureTable + "CREATE TABLE posts (id integer NOT NULL PRIMARY KEY, user_id integer);",
+		featureTable + "CREATE TABLE posts (id integer NOT NULL PRIMARY KEY, user_id integer, CONSTRAINT posts_user FOREIGN KEY (user_id) REFERENCES users (id));"},
+	{"Check: table-level CHECK", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20), CONSTRAINT users_id CHECK (id > 0 AND id < 100));"},
+	{"Exclusion: EXCLUDE", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20), CONSTRAINT users_name EXCLUDE USING btree (name WITH =));"},
+	{"Comment: COMMENT ON TABLE", featureTable, featureTable + "COMMENT ON TABLE users IS 'users';"},
+	{"Policy: CREATE POLICY", featureTable, featureTable + "CREATE POLICY p_users ON users AS PERMISSIVE FOR ALL TO PUBLIC USING (id > 0);"},
+	{"Table options: ENGINE", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY) ENGINE=MyISAM;", "CREATE TABLE users (id integer NOT NULL PRIMARY KEY) ENGINE=InnoDB;"},
+	{"Table options: storage parameters", featureTable, "CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name varchar(20)) WITH (fillfactor = 70);"},
+	{"Table options: CLUSTER ON", featureTable + "CREATE INDEX index_name ON users (name);", featureTable + "CREATE INDEX index_name ON users (name);\nALTER TABLE users CLUSTER ON index_name;"},
+	{"Inheritance: INHERITS", featureTable + "CREATE TABLE admins (id integer NOT NULL);", featureTable + "CREATE TABLE admins (id integer NOT NULL) INHERITS (users);"},
+	{"View: CREATE VIEW", featureTable, featureTable + "CREATE VIEW user_names AS SELECT name FROM users;"},
+	{"View: change view", featureTable + "CREATE VIEW user_names AS SELECT name FROM users;", featureTable + "CREATE VIEW user_names AS SELECT id, name FROM users;"},
+	{"Materialized View: CREATE MATERIALIZED VIEW", featureTable, featureTable + "CREATE MATERIALIZED VIEW user_names AS SELECT name FROM users;"},
+	{"Enum Type: CREATE TYPE ... AS ENUM", "", "CREATE TYPE mood AS ENUM ('sad', 'happy');"},
+	{"Enum Type: ADD VALUE", "CREATE TYPE mood AS ENUM ('sad', 'happy');", "CREATE TYPE mood AS ENUM ('sad', 'ok', 'happy');"},
+	{"Domain: CREATE DOMAIN", "", "CREATE DOMAIN email AS text;"},
+	{"Function: CREATE FUNCTION", "", "CREATE FUNCTION one() RETURNS integer LANGUAGE sql AS $$ SELECT 1 $$;"},
+	{"Trigger: CREATE TRIGGER", featureTable, featureTable + "CREATE TRIGGER users_trigger AFTER INSERT ON users FOR EACH ROW BEGIN DELETE FROM users WHERE id < 0; END;"},
+	{"Trigger: CREATE TRIGGER", featureTable, featureTable + "CREATE TRIGGER users_trigger AFTER INSERT ON users FOR EACH ROW EXECUTE FUNCTION on_insert();"},
+}
+
+// Statements one of the DDLs must contain for a feature, not to take a fallback like DROP and ADD COLUMN for it
+var featureStatements = map[string][]string{
+	"Column: rename by @renamed": {"CHANGE COLUMN", "RENAME COLUMN", "sp_rename"},
+}
+
+// Features which can be diffed in `mode`. They're found by generating DDLs for each feature, not by a static list.
+func SupportedFeatures(mode GeneratorMode) []string {
+	names := []string{}
+	for _, feature := range features {
+		if hasUnsupportedDDL(mode, feature.desired) || hasUnsupportedDDL(mode, feature.current) {
+			continue
+		}
+		ddls, err := GenerateIdempotentDDLs(mode, feature.desired, feature.current)
+		if err == nil && len(ddls) > 0 && hasFeatureStatement(ddls, featureStatements[feature.name]) && (len(names) == 0 || names[len(names)-1] != feature.name) {
+			names = append(names, feature.name)
+		}
+	}
+	return names
+}
+
+func hasFeatureStatement(ddls []string, statements []string) bool {
+	if len(statements) == 0 {
+		return true
+	}
+	for _, ddl := range ddls {
+		for _, statement := range statements {
+			if strings.Contains(ddl, statement) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Types, domains, policies, materialized views, `COMMENT ON` and `CLUSTER ON` are parsed in every mode,
+// but the generated DDLs work only for PostgreSQL, and MSSQL for `COMMENT ON`.
+func hasUnsupportedDDL(mode GeneratorMode, sql string) bool {
+	if mode == GeneratorModePostgres {
+		return false
+	}
+	ddls, err := parseDDLs(mode, sql)
+	if err != nil {
+		return false
+	}
+	for _, ddl := range ddls {
+		switch ddl := ddl.(type) {
+		case *CreateType, *CreateDomain, *AddPolicy, *ClusterOn:
+			return true
+		case *CommentOnTable, *CommentOnColumn:
+			if mode != GeneratorModeMssql {
+				return true
+			}
+		case *View:
+			if ddl.materialized {
+				return true
+			}
+		}
+	}
+	return false
+}
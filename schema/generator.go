@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -24,10 +26,45 @@ var (
 		"int":     "integer",
 		"char":    "character",
 		"varchar": "character varying",
+		"serial2": "smallserial",
+		"serial4": "serial",
+		"serial8": "bigserial",
 	}
 	mysqlDataTypeAliases = map[string]string{
 		"boolean": "tinyint",
 	}
+	// The table name of CREATE TABLE, which may be quoted
+	createTableName  = regexp.MustCompile("(?i)^\\s*CREATE\\s+TABLE\\s+(IF\\s+NOT\\s+EXISTS\\s+)?(\"[^\"]*\"|`[^`]*`|\\[[^\\]]*\\]|[^\\s(]+)")
+	createIndex      = regexp.MustCompile("(?i)^\\s*CREATE\\s+(UNIQUE\\s+)?INDEX\\s")
+	ifNotExists      = regexp.MustCompile("(?i)^\\s*IF\\s+NOT\\s+EXISTS\\s")
+	unnamedIndex     = regexp.MustCompile("(?i)^\\s*ON\\s")
+	checkKeyword     = regexp.MustCompile("(?i)\\bCHECK\\s*\\(")
+	integerTypeRanks = map[string]int{
+		"tinyint":     1,
+		"smallint":    2,
+		"smallserial": 2,
+		"mediumint":   3,
+		"integer":     4,
+		"serial":      4,
+		"bigint":      5,
+		"bigserial":   5,
+	}
+	// The types which serial columns are created with
+	serialBaseTypes = map[string]string{
+		"smallserial": "smallint",
+		"serial":      "integer",
+		"bigserial":   "bigint",
+	}
+	// ALTER TABLE of MySQL whose clause can be combined with others, grouped by the table name and the clause
+	mergeableAlterTable = regexp.MustCompile("(?is)^ALTER TABLE (`[^`]*`|[^\\s`]+) ((?:ADD|DROP|CHANGE|ALTER) COLUMN .*|ADD (?:UNIQUE |FULLTEXT |SPATIAL )?(?:INDEX|KEY) .*|DROP INDEX .*)$")
+	// The default precision of CURRENT_TIMESTAMP, like `CURRENT_TIMESTAMP(0)` or `CURRENT_TIMESTAMP()`
+	defaultTimestampPrecision = regexp.MustCompile(`\(\s*0*\s*\)$`)
+	// A default using a sequence, like `nextval('users_id_seq'::regclass)`
+	nextvalDefault = regexp.MustCompile(`(?i)^nextval\(\s*'([^']+)'(?:\s*::\s*regclass)?\s*\)$`)
+	// A function call like `f(` or `public."F" (` in an expression
+	functionCall = regexp.MustCompile(`((?:\w+|"[^"]+")(?:\.(?:\w+|"[^"]+"))?)\s*\(`)
+	// The default value of a function argument, like ` DEFAULT 1` or ` = 1`
+	functionArgumentDefault = regexp.MustCompile(`(?is)\s*(\bdefault\b|=).*$`)
 )
 
 // This struct holds simulated schema states during GenerateIdempotentDDLs().
@@ -38,10 +75,93 @@ type Generator struct {
 
 	desiredViews []*View
 	currentViews []*View
+
+	currentTypes []*CreateType
+
+	desiredDomains []*CreateDomain
+	currentDomains []*CreateDomain
+
+	desiredFunctions []*Function
+	currentFunctions []*Function
+
+	desiredTriggers []*Trigger
+	currentTriggers []*Trigger
+
+	dropTablesEnabled bool
+	identifierQuoting IdentifierQuoting
+	onlineIndex       bool
+	indexConcurrently bool
+	useIfExists       bool
+	notNullViaCheck   bool
+	skipDrop          bool
+	allowUnsafe       bool
+	targetTables      []*regexp.Regexp
+	skipTables        []*regexp.Regexp
+
+	unsafeDDLs           map[string]bool
+	nonTransactionalDDLs map[string]bool
+	changes              map[string][]Change // in the order they're recorded, since the same statement may change another object
+	changedTables        map[string]string   // table of the object which a DDL changes
+	atomicDDLs           [][]string
+	rebuiltTables        map[string]int // index of `atomicDDLs` rebuilding the table
+	columnOrderWarnings  []string
+	skippedDropTables    []string
+	skippedDropDomains   []string
+	warnings             []string
+}
+
+// Options of `GenerateIdempotentDDLsWithResult`
+type GeneratorOptions struct {
+	DropTablesEnabled  bool              // Drop tables and domains missing in the desired schema. They're reported in `Result.SkippedDropTables` and `Result.SkippedDropDomains` otherwise.
+	IdentifierQuoting  IdentifierQuoting // Which identifiers in generated DDLs should be quoted
+	OnlineIndex        bool              // Create MSSQL indexes with `ONLINE = ON`, which needs the Enterprise edition
+	IndexConcurrently  bool              // Create and drop Postgres indexes with `CONCURRENTLY`, which can't run in a transaction
+	MergeAlterTable    bool              // Combine consecutive MySQL ALTER TABLE of the same table into one statement, which rebuilds the table once
+	UseIfExists        bool              // Use IF EXISTS of DROP TABLE, DROP VIEW, DROP INDEX and DROP CONSTRAINT, and IF NOT EXISTS of CREATE INDEX where the database supports them
+	NotNullViaCheck    bool              // Set NOT NULL of Postgres columns after validating a NOT VALID check, which avoids scanning the table under an exclusive lock
+	SkipDrop           bool              // Skip DDLs containing DROP. They're reported in `Result.SkippedDDLs`.
+	AllowUnsafe        bool              // Don't skip `Result.UnsafeDDLs`. They're reported in `Result.SkippedDDLs` otherwise.
+	ForeignKeysEnabled bool              // Whether SQLite3 enforces foreign keys, which are turned off while tables are rebuilt
+	TargetTables       []string          // Regexps of table names to manage. Other tables are neither altered nor dropped. All tables are managed if empty.
+	SkipTables         []string          // Regexps of table names not to manage, even if they match `TargetTables`
+}
+
+type IdentifierQuoting int
+
+const (
+	QuoteAlways        IdentifierQuoting = iota // Quote every identifier, preserving its case
+	QuoteWhenNecessary                          // Quote reserved words and identifiers with special characters or, in Postgres, upper case letters
+)
+
+// Result of `GenerateIdempotentDDLsWithResult`
+type Result struct {
+	DDLs                 []string
+	UnsafeDDLs           map[string]bool // DDLs which may lose data, like dropping a table or a column
+	SkippedDDLs          map[string]bool // DDLs not to run by `GeneratorOptions.SkipDrop` and `AllowUnsafe`, with the others which can't run without them
+	NonTransactionalDDLs map[string]bool // DDLs which can't run in a transaction, like CREATE INDEX CONCURRENTLY
+	Changes              []Change        // Objects which `DDLs` of the same index change. A DDL changing no object, like SET FOREIGN_KEY_CHECKS, has only `Statement`.
+	ColumnOrderWarnings  []string        // Postgres can't reorder columns, so desired column orders may not be followed
+	SkippedDropTables    []string        // Tables which would be dropped if `GeneratorOptions.DropTablesEnabled` were true
+	SkippedDropDomains   []string        // Domains which would be dropped if `GeneratorOptions.DropTablesEnabled` were true
+	Warnings             []string        // Problems which the DDLs can't solve, like tables referencing each other in Postgres
+	Schema               []string        // DDLs of the schema after running `DDLs` other than `SkippedDDLs`
 }
 
 // Parse argument DDLs and call `generateDDLs()`
 func GenerateIdempotentDDLs(mode GeneratorMode, desiredSQL string, currentSQL string) ([]string, error) {
+	changes, err := GenerateDiff(mode, desiredSQL, currentSQL)
+	if err != nil {
+		return nil, err
+	}
+	ddls := []string{}
+	for _, change := range changes {
+		ddls = append(ddls, change.Statement)
+	}
+	return ddls, nil
+}
+
+// Same as `GenerateIdempotentDDLs`, but also returns information to decide how to apply the DDLs.
+func GenerateIdempotentDDLsWithResult(mode GeneratorMode, desiredSQL string, currentSQL string, options GeneratorOptions) (*Result, error) {
 	// TODO: invalidate duplicated tables, columns
 	desiredDDLs, err := parseDDLs(mode, desiredSQL)
 	if err != nil {
@@ -53,59 +173,492 @@ func GenerateIdempotentDDLs(mode GeneratorMode, desiredSQL string, currentSQL st
 		return nil, err
 	}
 
-	tables, err := convertDDLsToTables(currentDDLs)
+	views := convertDDLsToViews(mode, currentDDLs)
+	types := convertDDLsToTypes(currentDDLs)
+	domains := convertDDLsToDomains(currentDDLs)
+	functions := convertDDLsToFunctions(currentDDLs)
+	triggers := convertDDLsToTriggers(currentDDLs)
+
+	tables, err := convertDDLsToTables(mode, currentDDLs, views)
 	if err != nil {
 		return nil, err
 	}
 
-	views := convertDDLsToViews(currentDDLs)
+	targetTables, err := compileTablePatterns(options.TargetTables)
+	if err != nil {
+		return nil, err
+	}
+	skipTables, err := compileTablePatterns(options.SkipTables)
+	if err != nil {
+		return nil, err
+	}
 
 	generator := Generator{
-		mode:          mode,
-		desiredTables: []*Table{},
-		currentTables: tables,
-		desiredViews:  []*View{},
-		currentViews:  views,
+		mode:                 mode,
+		desiredTables:        []*Table{},
+		currentTables:        tables,
+		desiredViews:         []*View{},
+		currentViews:         views,
+		currentTypes:         types,
+		desiredDomains:       []*CreateDomain{},
+		currentDomains:       domains,
+		desiredFunctions:     []*Function{},
+		currentFunctions:     functions,
+		desiredTriggers:      []*Trigger{},
+		currentTriggers:      triggers,
+		dropTablesEnabled:    options.DropTablesEnabled,
+		identifierQuoting:    options.IdentifierQuoting,
+		onlineIndex:          options.OnlineIndex,
+		indexConcurrently:    options.IndexConcurrently,
+		useIfExists:          options.UseIfExists,
+		notNullViaCheck:      options.NotNullViaCheck,
+		skipDrop:             options.SkipDrop,
+		allowUnsafe:          options.AllowUnsafe,
+		targetTables:         targetTables,
+		skipTables:           skipTables,
+		unsafeDDLs:           map[string]bool{},
+		nonTransactionalDDLs: map[string]bool{},
+		changes:              map[string][]Change{},
+		changedTables:        map[string]string{},
+		rebuiltTables:        map[string]int{},
+	}
+	ddls, err := generator.generateDDLs(desiredDDLs)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := generator.resultSchema(desiredDDLs, currentDDLs, ddls) // before merging statements which change different objects
+	if err != nil {
+		return nil, err
+	}
+	if options.MergeAlterTable && mode == GeneratorModeMysql {
+		ddls = generator.mergeAlterTables(ddls)
+	}
+	skippedDDLs := generator.skippedDDLs(ddls)
+	if mode == GeneratorModeSQLite3 && options.ForeignKeysEnabled {
+		ddls = generator.disableForeignKeysDuringRebuilds(ddls, skippedDDLs)
+	}
+	return &Result{
+		DDLs:                 ddls,
+		UnsafeDDLs:           generator.unsafeDDLs,
+		SkippedDDLs:          skippedDDLs,
+		NonTransactionalDDLs: generator.nonTransactionalDDLs,
+		Changes:              generator.changeList(ddls),
+		ColumnOrderWarnings:  generator.columnOrderWarnings,
+		Warnings:             generator.warnings,
+		SkippedDropTables:    generator.skippedDropTables,
+		SkippedDropDomains:   generator.skippedDropDomains,
+		Schema:               schema,
+	}, nil
+}
+
+// Simulate the schema after running the DDLs which are not skipped. An object whose change is skipped keeps its current definition,
+// and so does a current object which is not dropped, like a table whose drop is skipped or a table which is not managed.
+// A table with both skipped and applied changes is built from its desired and current objects, and formatted by the generator.
+func (g *Generator) resultSchema(desiredDDLs []DDL, currentDDLs []DDL, ddls []string) ([]string, error) {
+	skippedDDLs := g.skippedDDLs(ddls)
+	objects := resultObjects{skipped: map[string]bool{}, applied: map[string]bool{}, desired: map[string]bool{}}
+	skippedTables, appliedTables := map[string]bool{}, map[string]bool{} // tables which have skipped or applied changes of them or their objects
+	for i, change := range g.changeList(ddls) {
+		ddl := ddls[i]
+		if change.ObjectType == "" {
+			continue
+		}
+		key := objectKey(change.ObjectType, change.ObjectName)
+		if skippedDDLs[ddl] {
+			objects.skipped[key] = true
+			skippedTables[g.changedTables[ddl]] = true
+		} else {
+			objects.applied[key] = true
+			appliedTables[g.changedTables[ddl]] = true
+		}
+	}
+
+	desiredTables, currentTables := map[string]*CreateTable{}, map[string]*CreateTable{}
+	for _, ddl := range desiredDDLs {
+		if g.isTargetDDL(ddl) {
+			objects.desired[g.ddlObjectKey(ddl)] = true
+			if createTable, ok := ddl.(*CreateTable); ok {
+				desiredTables[createTable.table.name] = createTable
+				for _, key := range tableObjectKeys(createTable.table) {
+					objects.desired[key] = true
+				}
+			}
+		}
+	}
+	for _, ddl := range currentDDLs {
+		if createTable, ok := ddl.(*CreateTable); ok {
+			currentTables[createTable.table.name] = createTable
+		}
+	}
+	tableExists := func(name string) bool {
+		if findViewByTableName(g.mode, g.desiredViews, name) != nil {
+			return true // indexes and triggers of a view are kept with it
+		}
+		key := objectKey(ObjectTable, name)
+		return (objects.desired[key] && !objects.skipped[key]) || (currentTables[name] != nil && objects.keepsCurrent(key))
+	}
+	mergedTable := func(name string) bool {
+		return skippedTables[name] && appliedTables[name] && desiredTables[name] != nil && currentTables[name] != nil
+	}
+
+	schema := []string{}
+	for _, ddl := range desiredDDLs {
+		if !g.isTargetDDL(ddl) || (ddlTableName(ddl) != "" && !tableExists(ddlTableName(ddl))) {
+			continue
+		}
+		if createTable, ok := ddl.(*CreateTable); ok && mergedTable(createTable.table.name) {
+			statement, err := g.generateCreateTable(objects.table(createTable.table, currentTables[createTable.table.name].table, g.mode))
+			if err != nil {
+				return nil, err
+			}
+			schema = append(schema, statement)
+			continue
+		}
+		if objects.skipped[g.ddlObjectKey(ddl)] {
+			continue
+		}
+		schema = append(schema, strings.TrimSpace(ddl.Statement()))
+	}
+	for _, ddl := range currentDDLs {
+		if createTable, ok := ddl.(*CreateTable); ok && mergedTable(createTable.table.name) {
+			continue
+		}
+		if !objects.keepsCurrent(g.ddlObjectKey(ddl)) || (ddlTableName(ddl) != "" && !tableExists(ddlTableName(ddl))) {
+			continue
+		}
+		schema = append(schema, strings.TrimSpace(ddl.Statement()))
+	}
+	return schema, nil
+}
+
+// Objects which the DDLs change, named by `objectKey`
+type resultObjects struct {
+	skipped map[string]bool // objects whose change is skipped
+	applied map[string]bool // objects changed by DDLs which are not skipped
+	desired map[string]bool // objects in the desired schema, including columns and constraints of tables
+}
+
+// Whether a current object remains: its change is skipped, or it's neither desired nor changed like a table which is not managed
+func (o resultObjects) keepsCurrent(key string) bool {
+	return o.skipped[key] || (!o.desired[key] && !o.applied[key])
+}
+
+// A table whose objects are the desired ones, except the current ones whose changes are skipped or which are not changed.
+// When the change of the table itself is skipped, like a rebuild, only its objects changed by other DDLs are the desired ones.
+func (o resultObjects) table(desired Table, current Table, mode GeneratorMode) Table {
+	table := desired
+	tableSkipped := o.skipped[objectKey(ObjectTable, desired.name)]
+	if tableSkipped {
+		table = current
+		table.name = desired.name
+	}
+	skipped := func(key string) bool {
+		return o.skipped[key] || (tableSkipped && !o.applied[key])
+	}
+	keepsCurrent := func(key string) bool {
+		return skipped(key) || o.keepsCurrent(key)
+	}
+
+	// Columns follow the desired order, and the remaining current ones follow them
+	table.columns = []Column{}
+	for _, column := range desired.columns {
+		key := objectKey(ObjectColumn, desired.name+"."+column.name)
+		if !skipped(key) {
+			table.columns = append(table.columns, column)
+		} else if currentColumn := findColumnByName(current.columns, column.name); currentColumn != nil {
+			table.columns = append(table.columns, *currentColumn)
+		}
+	}
+	for _, column := range current.columns {
+		if findColumnByName(desired.columns, column.name) == nil && keepsCurrent(objectKey(ObjectColumn, desired.name+"."+column.name)) {
+			table.columns = append(table.columns, column)
+		}
+	}
+	for i, column := range table.columns {
+		if column.keyOption == ColumnKeyPrimary { // the primary key is taken as a whole below
+			table.columns[i].keyOption = ColumnKeyNone
+			if column.notNull == nil {
+				notNull := true
+				table.columns[i].notNull = &notNull
+			}
+		}
+	}
+
+	table.indexes = []Index{}
+	primaryKey := desired.PrimaryKey()
+	for _, name := range primaryKeyNames(desired, current, mode) {
+		if skipped(objectKey(ObjectConstraint, desired.name+"."+name)) {
+			primaryKey = current.PrimaryKey()
+		}
+	}
+	if primaryKey != nil {
+		table.indexes = append(table.indexes, *primaryKey)
+	}
+	for _, index := range desired.indexes {
+		if index.primary {
+			continue
+		}
+		if key := indexObjectKey(desired.name, index); !skipped(key) {
+			table.indexes = append(table.indexes, index)
+		} else if currentIndex := findIndexByName(current.indexes, index.name); currentIndex != nil {
+			table.indexes = append(table.indexes, *currentIndex)
+		}
+	}
+	for _, index := range current.indexes {
+		if !index.primary && findIndexByName(desired.indexes, index.name) == nil && keepsCurrent(indexObjectKey(desired.name, index)) {
+			table.indexes = append(table.indexes, index)
+		}
+	}
+
+	table.foreignKeys = []ForeignKey{}
+	for _, foreignKey := range desired.foreignKeys {
+		if key := objectKey(ObjectConstraint, desired.name+"."+foreignKey.constraintName); !skipped(key) {
+			table.foreignKeys = append(table.foreignKeys, foreignKey)
+		} else if currentForeignKey := findForeignKeyByName(current.foreignKeys, foreignKey.constraintName); currentForeignKey != nil {
+			table.foreignKeys = append(table.foreignKeys, *currentForeignKey)
+		}
+	}
+	for _, foreignKey := range current.foreignKeys {
+		if findForeignKeyByName(desired.foreignKeys, foreignKey.constraintName) == nil &&
+			keepsCurrent(objectKey(ObjectConstraint, desired.name+"."+foreignKey.constraintName)) {
+			table.foreignKeys = append(table.foreignKeys, foreignKey)
+		}
+	}
+
+	table.checks = []CheckDefinition{}
+	for _, check := range desired.checks {
+		if key := objectKey(ObjectConstraint, desired.name+"."+check.constraintName); !skipped(key) {
+			table.checks = append(table.checks, check)
+		} else if currentCheck := findCheckByName(current.checks, check.constraintName); currentCheck != nil {
+			table.checks = append(table.checks, *currentCheck)
+		}
+	}
+	for _, check := range current.checks {
+		if findCheckByName(desired.checks, check.constraintName) == nil &&
+			keepsCurrent(objectKey(ObjectConstraint, desired.name+"."+check.constraintName)) {
+			table.checks = append(table.checks, check)
+		}
+	}
+
+	table.exclusions = []Exclusion{}
+	for _, exclusion := range desired.exclusions {
+		if key := objectKey(ObjectConstraint, desired.name+"."+exclusion.constraintName); !skipped(key) {
+			table.exclusions = append(table.exclusions, exclusion)
+		} else if currentExclusion := findExclusionByName(current.exclusions, exclusion.constraintName); currentExclusion != nil {
+			table.exclusions = append(table.exclusions, *currentExclusion)
+		}
+	}
+	for _, exclusion := range current.exclusions {
+		if findExclusionByName(desired.exclusions, exclusion.constraintName) == nil &&
+			keepsCurrent(objectKey(ObjectConstraint, desired.name+"."+exclusion.constraintName)) {
+			table.exclusions = append(table.exclusions, exclusion)
+		}
+	}
+	return table
+}
+
+// Names which changes of the primary key are recorded with. Postgres drops it by the default name `<table>_pkey`.
+func primaryKeyNames(desired Table, current Table, mode GeneratorMode) []string {
+	names := []string{}
+	if mode == GeneratorModePostgres {
+		names = append(names, unqualifiedName(desired.name)+"_pkey")
+	}
+	for _, primaryKey := range []*Index{desired.PrimaryKey(), current.PrimaryKey()} {
+		if primaryKey != nil {
+			names = append(names, primaryKey.name)
+		}
+	}
+	return names
+}
+
+// Columns and constraints defined in CREATE TABLE, named by `objectKey`
+func tableObjectKeys(table Table) []string {
+	keys := []string{}
+	for _, column := range table.columns {
+		keys = append(keys, objectKey(ObjectColumn, table.name+"."+column.name))
+	}
+	for _, index := range table.indexes {
+		keys = append(keys, indexObjectKey(table.name, index))
+	}
+	for _, foreignKey := range table.foreignKeys {
+		keys = append(keys, objectKey(ObjectConstraint, table.name+"."+foreignKey.constraintName))
+	}
+	for _, check := range table.checks {
+		keys = append(keys, objectKey(ObjectConstraint, table.name+"."+check.constraintName))
+	}
+	for _, exclusion := range table.exclusions {
+		keys = append(keys, objectKey(ObjectConstraint, table.name+"."+exclusion.constraintName))
+	}
+	return keys
+}
+
+func objectKey(objectType ObjectType, objectName string) string {
+	return string(objectType) + " " + objectName
+}
+
+// The object which a DDL defines, named like `Change.ObjectName`
+func (g *Generator) ddlObjectKey(ddl DDL) string {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		return objectKey(ObjectTable, stmt.table.name)
+	case *CreateIndex:
+		if view := findViewByTableName(g.mode, g.desiredViews, stmt.tableName); view != nil {
+			return objectKey(ObjectIndex, g.normalizeObjectName(view.name)+"."+stmt.index.name)
+		}
+		return indexObjectKey(stmt.tableName, stmt.index)
+	case *AddIndex:
+		return indexObjectKey(stmt.tableName, stmt.index)
+	case *AddPrimaryKey:
+		return indexObjectKey(stmt.tableName, stmt.index)
+	case *AddForeignKey:
+		return objectKey(ObjectConstraint, stmt.tableName+"."+stmt.foreignKey.constraintName)
+	case *AddPolicy:
+		return objectKey(ObjectPolicy, stmt.tableName+"."+stmt.policy.name)
+	case *CommentOnColumn:
+		return objectKey(ObjectColumn, stmt.tableName+"."+stmt.columnName)
+	case *CommentOnTable:
+		return objectKey(ObjectTable, stmt.tableName)
+	case *ClusterOn:
+		return objectKey(ObjectTable, stmt.tableName)
+	case *View:
+		return objectKey(ObjectView, g.normalizeObjectName(stmt.name))
+	case *CreateType:
+		return objectKey(ObjectDataType, stmt.name)
+	case *CreateDomain:
+		return objectKey(ObjectDomain, stmt.name)
+	case *Function:
+		return objectKey(ObjectFunction, g.normalizeObjectName(stmt.name))
+	case *Trigger:
+		return objectKey(ObjectTrigger, stmt.name)
+	default:
+		return ""
+	}
+}
+
+// Same as `indexChange`
+func indexObjectKey(tableName string, index Index) string {
+	if index.primary || index.constraint {
+		return objectKey(ObjectConstraint, tableName+"."+index.name)
+	}
+	return objectKey(ObjectIndex, tableName+"."+index.name)
+}
+
+// Combine consecutive ALTER TABLE of the same table like `ALTER TABLE t ADD COLUMN a int, ADD COLUMN b int`.
+// Unsafe DDLs are kept separated since they may be skipped. So are DROP clauses from the others, which `SkipDrop` would skip together.
+func (g *Generator) mergeAlterTables(ddls []string) []string {
+	merged := []string{}
+	lastTable := "" // the table of the last statement in `merged` if it can be combined with the next one
+	lastDrop := false
+	for _, ddl := range ddls {
+		match := mergeableAlterTable.FindStringSubmatch(ddl)
+		if match == nil || g.unsafeDDLs[ddl] {
+			merged = append(merged, ddl)
+			lastTable = ""
+			continue
+		}
+		drop := strings.Contains(match[2], "DROP")
+		if match[1] == lastTable && drop == lastDrop {
+			merged[len(merged)-1] += ", " + match[2]
+			g.tableChange(ObjectTable, g.changedTables[ddl], "", OperationAlter, merged[len(merged)-1])
+		} else {
+			merged = append(merged, ddl)
+			lastTable = match[1]
+			lastDrop = drop
+		}
 	}
-	return generator.generateDDLs(desiredDDLs)
+	return merged
 }
 
 // Main part of DDL genearation
 func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 	ddls := []string{}
 
+	if g.mode == GeneratorModePostgres {
+		if err := g.validateIndexNames(desiredDDLs); err != nil {
+			return ddls, err
+		}
+	}
+	if g.mode == GeneratorModeMysql {
+		if err := validateBlobDefaults(desiredDDLs); err != nil {
+			return ddls, err
+		}
+	}
+
+	sortedDDLs, circularDDLs := g.sortDDLsByDependency(desiredDDLs)
+	prematureTables := g.findPrematureTables(circularDDLs)
+	disableForeignKeyChecks := false
+	switch g.mode {
+	case GeneratorModeMysql:
+		// MySQL can create a table referencing a missing table only when foreign key checks are disabled
+		disableForeignKeyChecks = len(prematureTables) > 0
+	case GeneratorModePostgres, GeneratorModeMssql:
+		for _, table := range prematureTables {
+			g.warnings = append(g.warnings, fmt.Sprintf(
+				"table '%s' references a table created after it by circular foreign keys; add the foreign key by ALTER TABLE after the tables instead",
+				table,
+			))
+		}
+	}
+
 	// Incrementally examine desiredDDLs
-	for _, ddl := range desiredDDLs {
+	for i, ddl := range append(sortedDDLs, circularDDLs...) {
+		if disableForeignKeyChecks && i == len(sortedDDLs) {
+			ddls = append(ddls, "SET FOREIGN_KEY_CHECKS = 0")
+		}
+		if !g.isTargetDDL(ddl) {
+			continue
+		}
+
 		switch desired := ddl.(type) {
 		case *CreateTable:
+			desiredTable := withAddedPrimaryKeys(desired.table, desiredDDLs)
 			if currentTable := findTableByName(g.currentTables, desired.table.name); currentTable != nil {
 				// Table already exists, guess required DDLs.
-				tableDDLs, err := g.generateDDLsForCreateTable(*currentTable, *desired)
+				tableDDLs, err := g.generateDDLsForCreateTable(*currentTable, CreateTable{statement: desired.statement, table: desiredTable})
 				if err != nil {
 					return ddls, err
 				}
-				ddls = append(ddls, tableDDLs...)
-				mergeTable(currentTable, desired.table)
+				ddls = append(ddls, g.tableChanges(ObjectTable, desired.table.name, "", OperationAlter, tableDDLs)...)
+				mergeTable(currentTable, desiredTable)
 			} else {
-				// Table not found, create table.
-				ddls = append(ddls, desired.statement)
+				// Table not found, create table. The primary key added by ALTER TABLE is added after it.
+				ddls = append(ddls, g.generateDDLsForCreateSchema(desired.table)...)
+				ddls = append(ddls, g.tableChange(ObjectTable, desired.table.name, "", OperationCreate, g.generateCreateTableStatement(desired)))
 				table := desired.table // copy table
 				g.currentTables = append(g.currentTables, &table)
 			}
-			table := desired.table // copy table
-			g.desiredTables = append(g.desiredTables, &table)
+			g.desiredTables = append(g.desiredTables, &desiredTable)
 		case *CreateIndex:
-			indexDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "CREATE INDEX", ddl.Statement())
+			statement := g.generateCreateIndexStatement(ddl.Statement())
+			if desiredView := findViewByTableName(g.mode, g.desiredViews, desired.tableName); desiredView != nil {
+				indexDDLs, err := g.generateDDLsForCreateViewIndex(desiredView, desired.index, statement)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, indexDDLs...)
+				continue
+			}
+			indexDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "CREATE INDEX", statement)
 			if err != nil {
 				return ddls, err
 			}
-			ddls = append(ddls, indexDDLs...)
+			ddls = append(ddls, g.afterRebuild(desired.tableName, indexDDLs)...)
 		case *AddIndex:
 			indexDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "ALTER TABLE", ddl.Statement())
 			if err != nil {
 				return ddls, err
 			}
 			ddls = append(ddls, indexDDLs...)
+		case *AddPrimaryKey:
+			currentTable := findTableByName(g.currentTables, desired.tableName)
+			if currentTable == nil {
+				return ddls, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", desired.statement)
+			}
+			// The primary key of an existing table is changed with the table
+			if currentTable.PrimaryKey() == nil {
+				ddls = append(ddls, g.indexChange(desired.tableName, desired.index, OperationCreate, ddl.Statement()))
+				addPrimaryKey(currentTable, desired.index)
+			}
 		case *AddForeignKey:
 			fkeyDDLs, err := g.generateDDLsForAddForeignKey(desired.tableName, desired.foreignKey, "ALTER TABLE", ddl.Statement())
 			if err != nil {
@@ -118,27 +671,90 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				return ddls, err
 			}
 			ddls = append(ddls, policyDDLs...)
+		case *CommentOnColumn:
+			commentDDLs, err := g.generateDDLsForCommentOnColumn(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, commentDDLs...)
+		case *CommentOnTable:
+			commentDDLs, err := g.generateDDLsForCommentOnTable(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, commentDDLs...)
+		case *ClusterOn:
+			// Changed with other table attributes after indexes are created
+			desiredTable := findTableByName(g.desiredTables, desired.tableName)
+			if desiredTable == nil {
+				return ddls, fmt.Errorf("CLUSTER ON is performed before create table '%s': '%s'", desired.tableName, desired.statement)
+			}
+			desiredTable.clusterIndex = desired.indexName
 		case *View:
 			viewDDLs, err := g.generateDDLsForCreateView(desired.name, desired)
 			if err != nil {
 				return ddls, err
 			}
 			ddls = append(ddls, viewDDLs...)
+		case *CreateType:
+			typeDDLs, err := g.generateDDLsForCreateType(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, typeDDLs...)
+		case *CreateDomain:
+			domainDDLs, err := g.generateDDLsForCreateDomain(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, domainDDLs...)
+		case *Function:
+			functionDDLs, err := g.generateDDLsForCreateFunction(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, functionDDLs...)
+		case *Trigger:
+			triggerDDLs, err := g.generateDDLsForCreateTrigger(desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, g.afterRebuild(desired.tableName, triggerDDLs)...)
 		default:
 			return nil, fmt.Errorf("unexpected ddl type in generateDDLs: %v", desired)
 		}
 	}
+	if disableForeignKeyChecks {
+		ddls = append(ddls, "SET FOREIGN_KEY_CHECKS = 1")
+	}
 
 	// Clean up obsoleted tables, indexes, columns
 	for _, currentTable := range g.currentTables {
+		if !g.isTargetTable(currentTable.name) {
+			continue
+		}
 		desiredTable := findTableByName(g.desiredTables, currentTable.name)
 		if desiredTable == nil {
-			// Obsoleted table found. Drop table.
-			ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name)))
+			// Obsoleted table found. Drop table only when it's explicitly enabled.
+			if !g.dropTablesEnabled {
+				g.skippedDropTables = append(g.skippedDropTables, currentTable.name)
+				continue
+			}
+			ddls = append(ddls, g.tableChange(ObjectTable, currentTable.name, "", OperationDrop, fmt.Sprintf("DROP TABLE %s%s", g.ifExists(), g.escapeTableName(currentTable.name))))
 			g.currentTables = removeTableByName(g.currentTables, currentTable.name)
 			continue
 		}
 
+		// Postgres and MSSQL table comments are given by separate statements. Remove the one that is no longer given.
+		if currentTable.comment != nil && desiredTable.comment == nil {
+			switch g.mode {
+			case GeneratorModePostgres:
+				ddls = append(ddls, g.tableChange(ObjectTable, currentTable.name, "", OperationAlter, fmt.Sprintf("COMMENT ON TABLE %s IS NULL", g.escapeTableName(currentTable.name))))
+			case GeneratorModeMssql:
+				ddls = append(ddls, g.tableChange(ObjectTable, currentTable.name, "", OperationAlter, g.generateMssqlComment(currentTable.name, "", currentTable.comment, nil)))
+			}
+		}
+
 		// Table is expected to exist. Drop foreign keys prior to index deletion
 		for _, foreignKey := range currentTable.foreignKeys {
 			if containsString(convertForeignKeysToConstraintNames(desiredTable.foreignKeys), foreignKey.constraintName) {
@@ -169,99 +785,335 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 
 		// Check columns.
 		for _, column := range currentTable.columns {
-			if containsString(convertColumnsToColumnNames(desiredTable.columns), column.name) {
+			if desiredColumn := findDesiredColumn(desiredTable.columns, column); desiredColumn != nil {
+				// Postgres and MSSQL column comments are given by separate statements. Remove the one that is no longer given.
+				if column.comment != nil && desiredColumn.comment == nil {
+					switch g.mode {
+					case GeneratorModePostgres:
+						ddls = append(ddls, g.tableChange(ObjectColumn, currentTable.name, column.name, OperationAlter, fmt.Sprintf("COMMENT ON COLUMN %s.%s IS NULL", g.escapeTableName(currentTable.name), g.escapeSQLName(column.name))))
+					case GeneratorModeMssql:
+						ddls = append(ddls, g.tableChange(ObjectColumn, currentTable.name, column.name, OperationAlter, g.generateMssqlComment(currentTable.name, column.name, column.comment, nil)))
+					}
+				}
 				continue // Column is expected to exist.
 			}
 
+			// A column inherited from a parent table can't be dropped, and is not necessarily given in the child table.
+			if g.isInheritedColumn(*desiredTable, column.name) {
+				continue
+			}
+
 			// Column is obsoleted. Drop column.
 			columnDDLs := g.generateDDLsForAbsentColumn(currentTable, column.name)
 			ddls = append(ddls, columnDDLs...)
 			// TODO: simulate to remove column from `currentTable.columns`?
 		}
 
+		// Check table-level checks.
+		if g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql {
+			for _, check := range currentTable.checks {
+				if findDesiredCheckByCurrentCheck(desiredTable.checks, check) != nil {
+					continue
+				}
+				if g.mode == GeneratorModePostgres && isCheckRenamed(currentTable.checks, desiredTable.checks, check) {
+					continue // renamed by RENAME CONSTRAINT
+				}
+				ddls = append(ddls, g.tableChange(ObjectConstraint, currentTable.name, check.constraintName, OperationDrop, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(currentTable.name), g.ifConstraintExists(), g.escapeSQLName(check.constraintName))))
+			}
+		}
+
+		// Check exclusion constraints.
+		if g.mode == GeneratorModePostgres {
+			for _, exclusion := range currentTable.exclusions {
+				if findExclusionByName(desiredTable.exclusions, exclusion.constraintName) == nil {
+					ddls = append(ddls, g.tableChange(ObjectConstraint, currentTable.name, exclusion.constraintName, OperationDrop, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(currentTable.name), g.ifConstraintExists(), g.escapeSQLName(exclusion.constraintName))))
+				}
+			}
+		}
+
 		// Check policies.
 		for _, policy := range currentTable.policies {
 			if containsString(convertPolicyNames(desiredTable.policies), policy.name) {
 				continue
 			}
-			ddls = append(ddls, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(policy.name), g.escapeTableName(currentTable.name)))
+			ddls = append(ddls, g.tableChange(ObjectPolicy, currentTable.name, policy.name, OperationDrop, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(policy.name), g.escapeTableName(currentTable.name))))
+		}
+
+		if g.mode == GeneratorModePostgres {
+			ddls = append(ddls, g.tableChanges(ObjectTable, currentTable.name, "", OperationAlter, g.generateDDLsForTableAttributes(*currentTable, *desiredTable))...)
 		}
 	}
 
 	// Clean up obsoleted views
 	for _, currentView := range g.currentViews {
-		if containsString(convertViewNames(g.desiredViews), currentView.name) {
+		desiredView := findViewByName(g.desiredViews, currentView.name)
+		if desiredView == nil {
+			ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(currentView.name), OperationDrop, g.generateDropView(*currentView)))
 			continue
 		}
-		ddls = append(ddls, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(currentView.name)))
+
+		// Clean up obsoleted indexes of materialized views
+		for _, index := range currentView.indexes {
+			if containsString(convertIndexesToIndexNames(desiredView.indexes), index.name) {
+				continue
+			}
+			ddls = append(ddls, g.tableChange(ObjectIndex, g.normalizeObjectName(currentView.name), index.name, OperationDrop, g.generateDropIndex(currentView.name, index)))
+		}
 	}
 
-	return ddls, nil
-}
+	// Clean up obsoleted triggers. Ones on dropped tables and views are dropped with them.
+	for _, currentTrigger := range g.currentTriggers {
+		if !g.isDesiredTableOrView(currentTrigger.tableName) {
+			continue
+		}
+		if g.findTriggerByName(g.desiredTriggers, currentTrigger.name, currentTrigger.tableName) == nil {
+			ddls = append(ddls, g.change(ObjectTrigger, currentTrigger.name, OperationDrop, g.generateDropTrigger(currentTrigger)))
+		}
+	}
 
-func (g *Generator) generateDDLsForAbsentColumn(currentTable *Table, columnName string) []string {
-	ddls := []string{}
+	// Clean up obsoleted functions after the views using them
+	for _, currentFunction := range g.currentFunctions {
+		if findFunctionBySignature(g.desiredFunctions, currentFunction.signature()) == nil {
+			ddls = append(ddls, g.change(ObjectFunction, g.normalizeObjectName(currentFunction.name), OperationDrop, g.generateDropFunction(currentFunction)))
+		}
+	}
 
-	// Only MSSQL has column default constraints. They need to be deleted before dropping the column.
-	if g.mode == GeneratorModeMssql {
-		for _, column := range currentTable.columns {
-			if column.name == columnName && column.defaultDef != nil && column.defaultDef.constraintName != "" {
-				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(column.defaultDef.constraintName))
-				ddls = append(ddls, ddl)
+	// Clean up obsoleted domains after the tables using them. Like tables, they're dropped only when it's explicitly enabled.
+	for _, currentDomain := range g.currentDomains {
+		if findDomainByName(g.desiredDomains, currentDomain.name) == nil {
+			if !g.dropTablesEnabled {
+				g.skippedDropDomains = append(g.skippedDropDomains, currentDomain.name)
+				continue
 			}
+			ddls = append(ddls, g.change(ObjectDomain, currentDomain.name, OperationDrop, fmt.Sprintf("DROP DOMAIN %s", g.escapeTableName(currentDomain.name))))
 		}
 	}
 
-	ddl := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(columnName))
-	return append(ddls, ddl)
+	return ddls, nil
 }
 
-// In the caller, `mergeTable` manages `g.currentTables`.
-func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]string, error) {
-	ddls := []string{}
+// Unlike MySQL, Postgres index names are unique within a schema, not within a table.
+// Reject desired indexes whose names are taken by another table's index, which would fail on apply.
+func (g *Generator) validateIndexNames(desiredDDLs []DDL) error {
+	indexTables := map[string]string{} // schema-qualified index name -> table name
+	checkIndex := func(tableName string, index Index) error {
+		if index.primary || index.name == "" {
+			return nil
+		}
+		schemaName := strings.SplitN(tableName, ".", 2)[0]
+		qualifiedName := schemaName + "." + index.name
 
-	// Examine each column
-	for i, desiredColumn := range desired.table.columns {
-		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
-		if currentColumn == nil || !currentColumn.autoIncrement {
-			// We may not be able to add AUTO_INCREMENT yet. It will be added after adding keys (primary or not) at the "Add new AUTO_INCREMENT" place.
-			desiredColumn.autoIncrement = false
+		if otherTable, ok := indexTables[qualifiedName]; ok && otherTable != tableName {
+			return fmt.Errorf("index '%s' is created against both table '%s' and table '%s', but index names must be unique in a schema", index.name, otherTable, tableName)
 		}
-		if currentColumn == nil {
-			definition, err := g.generateColumnDefinition(desiredColumn, true)
-			if err != nil {
-				return ddls, err
-			}
+		indexTables[qualifiedName] = tableName
 
-			// Column not found, add column.
-			var ddl string
-			switch g.mode {
-			case GeneratorModeMssql:
-				ddl = fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
-			default:
-				ddl = fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", g.escapeTableName(desired.table.name), definition)
+		for _, currentTable := range g.currentTables {
+			if currentTable.name == tableName || strings.SplitN(currentTable.name, ".", 2)[0] != schemaName {
+				continue
 			}
-
-			if g.mode == GeneratorModeMysql {
-				after := " FIRST"
-				if i > 0 {
-					after = " AFTER " + g.escapeSQLName(desired.table.columns[i-1].name)
-				}
-				ddl += after
+			if findIndexByName(currentTable.indexes, index.name) != nil {
+				return fmt.Errorf("index '%s' of table '%s' is already used by existing table '%s', but index names must be unique in a schema", index.name, tableName, currentTable.name)
 			}
+		}
+		return nil
+	}
+
+	for _, ddl := range desiredDDLs {
+		switch desired := ddl.(type) {
+		case *CreateTable:
+			for _, index := range desired.table.indexes {
+				if err := checkIndex(desired.table.name, index); err != nil {
+					return err
+				}
+			}
+		case *CreateIndex:
+			if err := checkIndex(desired.tableName, desired.index); err != nil {
+				return err
+			}
+		case *AddIndex:
+			if err := checkIndex(desired.tableName, desired.index); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// MySQL doesn't allow a literal default for TEXT, BLOB and JSON columns, so ADD COLUMN and CHANGE COLUMN with it would fail.
+// An expression default like `DEFAULT (JSON_ARRAY())` is allowed.
+func validateBlobDefaults(desiredDDLs []DDL) error {
+	for _, ddl := range desiredDDLs {
+		if desired, ok := ddl.(*CreateTable); ok {
+			for _, column := range desired.table.columns {
+				if column.defaultDef != nil && column.defaultDef.value != nil && !isNullValue(column.defaultDef.value) && isBlobType(column.typeName) {
+					return fmt.Errorf("column '%s' of table '%s' has a default value, but MySQL doesn't allow a default value for %s columns", column.name, desired.table.name, strings.ToUpper(column.typeName))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func isBlobType(typeName string) bool {
+	switch strings.ToLower(typeName) {
+	case "tinytext", "text", "mediumtext", "longtext", "tinyblob", "blob", "mediumblob", "longblob", "json":
+		return true
+	default:
+		return false
+	}
+}
+
+// Whether a parent table of `table` has the column in the desired or current schema.
+// A column dropped from the current parent is dropped from the child as well.
+func (g *Generator) isInheritedColumn(table Table, columnName string) bool {
+	for _, parentName := range table.inherits {
+		for _, parent := range []*Table{findTableByName(g.desiredTables, parentName), findTableByName(g.currentTables, parentName)} {
+			if parent == nil {
+				continue
+			}
+			for _, column := range parent.columns {
+				if column.name == columnName {
+					return true
+				}
+			}
+			if g.isInheritedColumn(*parent, columnName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (g *Generator) generateDDLsForAbsentColumn(currentTable *Table, columnName string) []string {
+	ddls := []string{}
+
+	// Only MSSQL has column default constraints. They need to be deleted before dropping the column.
+	if g.mode == GeneratorModeMssql {
+		for _, column := range currentTable.columns {
+			if column.name == columnName && column.defaultDef != nil && column.defaultDef.constraintName != "" {
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(currentTable.name), g.ifConstraintExists(), g.escapeSQLName(column.defaultDef.constraintName))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, currentTable.name, column.defaultDef.constraintName, OperationDrop, ddl))
+			}
+		}
+	}
+
+	ddl := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(columnName))
+	return append(ddls, g.tableChange(ObjectColumn, currentTable.name, columnName, OperationDrop, g.unsafe(ddl)))
+}
+
+// In the caller, `mergeTable` manages `g.currentTables`.
+func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]string, error) {
+	ddls := []string{}
+
+	// Postgres can't change how a table is partitioned in place
+	if currentTable.partitionDef != desired.table.partitionDef {
+		return ddls, fmt.Errorf(
+			"changing the partitioning of table '%s' is not supported (current: '%s', desired: '%s'): '%s'",
+			currentTable.name, currentTable.partitionDef, desired.table.partitionDef, desired.statement,
+		)
+	}
+
+	if g.mode == GeneratorModePostgres {
+		g.checkColumnOrder(currentTable, desired.table)
+
+		// Change inheritance before columns, which may be inherited
+		for _, parent := range desired.table.inherits {
+			if !containsString(currentTable.inherits, parent) {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s INHERIT %s", g.escapeTableName(desired.table.name), g.escapeTableName(parent)))
+			}
+		}
+		for _, parent := range currentTable.inherits {
+			if !containsString(desired.table.inherits, parent) {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s NO INHERIT %s", g.escapeTableName(desired.table.name), g.escapeTableName(parent)))
+			}
+		}
+	}
+
+	// SQLite can't change a column but by rebuilding the table
+	if g.mode == GeneratorModeSQLite3 && g.needsSQLite3TableRebuild(currentTable, desired.table) {
+		return g.generateDDLsForRebuildTable(currentTable, desired)
+	}
+
+	// Change table options before columns since converting a charset changes the columns too
+	if g.mode == GeneratorModeMysql {
+		ddls = append(ddls, g.generateDDLsForTableOptions(currentTable, desired.table)...)
+	}
+
+	// Column names in the order after preceding DDLs, to move a MySQL column only when it's really misplaced.
+	// Columns to be dropped are excluded since they don't affect the order of the others.
+	columnOrder := []string{}
+	for _, currentColumn := range currentTable.columns {
+		if findDesiredColumn(desired.table.columns, currentColumn) != nil {
+			columnOrder = append(columnOrder, currentColumn.name)
+		}
+	}
+
+	// Examine each column
+	for i, desiredColumn := range desired.table.columns {
+		columnStart := len(ddls)
+		previousColumnName := ""
+		if i > 0 {
+			previousColumnName = desired.table.columns[i-1].name
+		}
 
+		currentColumn := findCurrentColumn(currentTable.columns, desiredColumn)
+		if currentColumn == nil || !currentColumn.autoIncrement {
+			// We may not be able to add AUTO_INCREMENT yet. It will be added after adding keys (primary or not) at the "Add new AUTO_INCREMENT" place.
+			desiredColumn.autoIncrement = false
+		}
+		if currentColumn == nil {
+			// Column not found, add column.
+			ddl, err := g.generateAddColumn(desired.table, desiredColumn, i)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, ddl)
+			columnOrder = placeColumnAfter(columnOrder, desiredColumn.name, previousColumnName)
+		} else if !areSameGeneratedColumn(*currentColumn, desiredColumn) && !g.canDropGeneration(*currentColumn, desiredColumn) {
+			// A generated column can't be converted from or to a regular one, nor change its expression in place
+			// (only MySQL can change it for VIRTUAL columns, and Postgres can make it regular). Drop and add the column again.
+			ddls = append(ddls, g.generateDDLsForAbsentColumn(&currentTable, currentColumn.name)...)
+			ddl, err := g.generateAddColumn(desired.table, desiredColumn, i)
+			if err != nil {
+				return ddls, err
+			}
 			ddls = append(ddls, ddl)
+			columnOrder = placeColumnAfter(removeString(columnOrder, currentColumn.name), desiredColumn.name, previousColumnName)
 		} else {
+			// Rename column as needed. MySQL renames it by CHANGE COLUMN below.
+			if currentColumn.name != desiredColumn.name && g.mode != GeneratorModeMysql {
+				ddls = append(ddls, g.generateRenameColumn(currentTable.name, currentColumn.name, desiredColumn.name))
+				renameColumn(&currentTable, currentColumn.name, desiredColumn.name)
+				currentColumn.name = desiredColumn.name
+			}
+
 			// Change column data type or order as needed.
 			switch g.mode {
 			case GeneratorModeMysql:
-				currentPos := currentColumn.position
-				desiredPos := desiredColumn.position
-				changeOrder := currentPos > desiredPos && currentPos-desiredPos > len(currentTable.columns)-len(desired.table.columns)
+				changeOrder := !isColumnPlacedAfter(columnOrder, currentColumn.name, previousColumnName)
+				columnOrder = placeColumnAfter(removeString(columnOrder, currentColumn.name), desiredColumn.name, previousColumnName)
 
-				// Change column type and orders, *except* AUTO_INCREMENT and UNIQUE KEY.
-				if !g.haveSameColumnDefinition(*currentColumn, desiredColumn) || !areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) || changeOrder {
-					definition, err := g.generateColumnDefinition(desiredColumn, false)
+				resolvedCurrent, resolvedDesired := resolveColumnCharsets(*currentColumn, desiredColumn, currentTable.options, desired.table.options)
+
+				onlyDefaultChanged := currentColumn.name == desiredColumn.name && g.haveSameColumnDefinition(resolvedCurrent, resolvedDesired) && !changeOrder &&
+					!areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef)
+				if onlyDefaultChanged && (desiredColumn.defaultDef == nil || (desiredColumn.defaultDef.value != nil && desiredColumn.defaultDef.value.valueType != ValueTypeValArg)) {
+					// Change only the default, which is lighter than CHANGE COLUMN. SET DEFAULT takes a literal but not CURRENT_TIMESTAMP or NULL.
+					if desiredColumn.defaultDef == nil {
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+					} else {
+						definition, err := generateDefaultDefinition(*desiredColumn.defaultDef)
+						if err != nil {
+							return ddls, err
+						}
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), definition))
+					}
+				} else if currentColumn.name != desiredColumn.name || !g.haveSameColumnDefinition(resolvedCurrent, resolvedDesired) || !areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) || changeOrder {
+					// Change column name, type and orders, *except* AUTO_INCREMENT, UNIQUE KEY and CHECK.
+					changedColumn := desiredColumn
+					changedColumn.check = nil // CHANGE COLUMN with CHECK would add another check
+					definition, err := g.generateColumnDefinition(changedColumn, false)
 					if err != nil {
 						return ddls, err
 					}
@@ -274,6 +1126,9 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						}
 						ddl += after
 					}
+					if !g.haveSameDataType(*currentColumn, desiredColumn) && g.isNarrowingTypeChange(*currentColumn, desiredColumn) {
+						ddl = g.unsafe(ddl)
+					}
 					ddls = append(ddls, ddl)
 				}
 
@@ -283,22 +1138,49 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 					ddl := fmt.Sprintf("ALTER TABLE %s ADD UNIQUE KEY %s(%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), g.escapeSQLName(desiredColumn.name))
 					ddls = append(ddls, ddl)
 				}
+
+				// Change CHECK by itself, which doesn't rebuild the column unlike CHANGE COLUMN.
+				if !areSameCheckDefinition(currentColumn.check, desiredColumn.check) {
+					if currentColumn.check != nil {
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CHECK %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.check.constraintName)))
+					}
+					if desiredColumn.check != nil {
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateCheckDefinition(*desiredColumn.check)))
+					}
+				}
 			case GeneratorModePostgres:
-				if !g.haveSameDataType(*currentColumn, desiredColumn) {
-					// Change type
-					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), generateDataType(desiredColumn))
-					ddls = append(ddls, ddl)
+				if g.canDropGeneration(*currentColumn, desiredColumn) {
+					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP EXPRESSION", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
 				}
 
-				if !isPrimaryKey(*currentColumn, currentTable) { // Primary Key implies NOT NULL
-					if g.notNull(*currentColumn) && !g.notNull(desiredColumn) {
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
-					} else if !g.notNull(*currentColumn) && g.notNull(desiredColumn) {
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+				if !g.haveSameDataType(*currentColumn, desiredColumn) || currentColumn.timezone != desiredColumn.timezone || currentColumn.collate != desiredColumn.collate {
+					// Change type. Collation can be changed only together with a type.
+					changedColumn := desiredColumn
+					if baseType, ok := serialBaseTypes[g.normalizeDataType(changedColumn.typeName)]; ok {
+						changedColumn.typeName = baseType // serial is not a real type but only a shorthand of CREATE TABLE
+					}
+					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), generateDataType(changedColumn))
+					if desiredColumn.timezone {
+						ddl += " WITH TIME ZONE"
+					}
+					if desiredColumn.collate != "" {
+						ddl += fmt.Sprintf(" COLLATE %s", g.generateCollate(desiredColumn.collate))
+					} else if currentColumn.collate != "" {
+						ddl += " COLLATE \"default\""
 					}
+					if needsExplicitCast(*currentColumn, desiredColumn) {
+						ddl += fmt.Sprintf(" USING %s::%s", g.escapeSQLName(currentColumn.name), generateDataType(desiredColumn))
+					}
+					if !g.haveSameDataType(*currentColumn, desiredColumn) && g.isNarrowingTypeChange(*currentColumn, desiredColumn) {
+						ddl = g.unsafe(ddl)
+					}
+					ddls = append(ddls, ddl)
 				}
 
 				// GENERATED AS IDENTITY
+				identityDDLs := []string{}
+				defaultDropped := false
+				currentSequence, desiredSequence := g.sequenceOfDefault(currentTable.name, *currentColumn), g.sequenceOfDefault(desired.table.name, desiredColumn)
 				if currentColumn.identity != desiredColumn.identity {
 					if currentColumn.identity == "" {
 						// add
@@ -306,25 +1188,52 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						if desiredColumn.sequence != nil {
 							alter += " (" + generateSequenceClause(desiredColumn.sequence) + ")"
 						}
-						ddls = append(ddls, alter)
+						if currentSequence != "" && desiredColumn.defaultDef == nil {
+							identityDDLs = append(identityDDLs, g.generateDDLsForSerialToIdentity(desired.table.name, currentColumn.name, currentSequence, alter)...)
+							defaultDropped = true
+						} else {
+							identityDDLs = append(identityDDLs, alter)
+						}
 					} else if desiredColumn.identity == "" {
 						// remove
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
+						identityDDLs = append(identityDDLs, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
 					} else {
 						// set
 						// not support changing sequence
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity))
+						identityDDLs = append(identityDDLs, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity))
 					}
 				}
 
+				notNullDDLs := []string{}
+				if !isPrimaryKey(*currentColumn, currentTable) { // Primary Key implies NOT NULL
+					if g.notNull(*currentColumn) && !g.notNull(desiredColumn) {
+						notNullDDLs = append(notNullDDLs, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+					} else if !g.notNull(*currentColumn) && g.notNull(desiredColumn) {
+						notNullDDLs = append(notNullDDLs, g.generateSetNotNull(desired.table.name, currentColumn.name)...)
+					}
+				}
+
+				// An identity column must be NOT NULL. Set it before adding identity, and drop it after removing identity.
+				if currentColumn.identity == "" {
+					ddls = append(ddls, notNullDDLs...)
+					ddls = append(ddls, identityDDLs...)
+				} else {
+					ddls = append(ddls, identityDDLs...)
+					ddls = append(ddls, notNullDDLs...)
+				}
+
 				// default
-				if !areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) {
-					if desiredColumn.defaultDef == nil {
+				if !defaultDropped && (currentSequence != desiredSequence || (currentSequence == "" && !areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef))) {
+					if desiredColumn.defaultDef == nil && desiredSequence != "" {
+						// serial
+						ddls = append(ddls, fmt.Sprintf("CREATE SEQUENCE IF NOT EXISTS %s OWNED BY %s.%s", g.escapeTableName(desiredSequence), g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name)))
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET DEFAULT nextval('%s'::regclass)", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), strings.ReplaceAll(g.escapeTableName(desiredSequence), "'", "''")))
+					} else if desiredColumn.defaultDef == nil {
 						// drop
 						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
 					} else {
 						// set
-						definition, err := generateDefaultDefinition(*desiredColumn.defaultDef.value)
+						definition, err := generateDefaultDefinition(*desiredColumn.defaultDef)
 						if err != nil {
 							return ddls, err
 						}
@@ -333,27 +1242,38 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				}
 
 				if !areSameCheckDefinition(currentColumn.check, desiredColumn.check) || currentColumn.checkNoInherit != desiredColumn.checkNoInherit {
-					constraintName := fmt.Sprintf("%s_%s_check", strings.Replace(desired.table.name, "public.", "", 1), desiredColumn.name)
+					constraintName := defaultCheckConstraintName(desired.table.name, []string{desiredColumn.name})
 					if currentColumn.check != nil {
-						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), constraintName)
+						// The existing constraint may not have the default name
+						currentConstraintName := constraintName
+						if currentColumn.check.constraintName != "" {
+							currentConstraintName = currentColumn.check.constraintName
+						}
+						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(currentConstraintName))
 						ddls = append(ddls, ddl)
 					}
 					if desiredColumn.check != nil {
-						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), constraintName, desiredColumn.check.definition)
+						desiredConstraintName := constraintName
+						if desiredColumn.check.constraintName != "" {
+							desiredConstraintName = desiredColumn.check.constraintName
+						}
+						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredConstraintName), desiredColumn.check.definition)
 						if desiredColumn.checkNoInherit {
 							ddl += " NO INHERIT"
 						}
 						ddls = append(ddls, ddl)
 					}
+				} else if desiredColumn.check != nil && desiredColumn.check.constraintName != "" && currentColumn.check.constraintName != desiredColumn.check.constraintName {
+					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME CONSTRAINT %s TO %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.check.constraintName), g.escapeSQLName(desiredColumn.check.constraintName)))
 				}
 
 				// TODO: support adding a column's `references`
 			case GeneratorModeMssql:
 				if !areSameCheckDefinition(currentColumn.check, desiredColumn.check) || currentColumn.checkNoInherit != desiredColumn.checkNoInherit {
-					constraintName := fmt.Sprintf("%s_%s_check", strings.Replace(desired.table.name, "dbo.", "", 1), desiredColumn.name)
+					constraintName := mssqlCheckConstraintName(desired.table.name, desiredColumn.name)
 					if currentColumn.check != nil {
 						currentConstraintName := currentColumn.check.constraintName
-						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), currentConstraintName)
+						ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(currentConstraintName))
 						ddls = append(ddls, ddl)
 					}
 					if desiredColumn.check != nil {
@@ -361,26 +1281,42 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 						if desiredConstraintName == "" {
 							desiredConstraintName = constraintName
 						}
-						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), desiredConstraintName, desiredColumn.check.definition)
+						ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredConstraintName), desiredColumn.check.definition)
 						ddls = append(ddls, ddl)
 					}
 				}
+
+				// MSSQL can't alter IDENTITY of an existing column, so it needs rebuilding the table by hand
+				if currentColumn.sequence != nil && desiredColumn.sequence != nil &&
+					(*currentColumn.sequence.StartWith != *desiredColumn.sequence.StartWith || *currentColumn.sequence.IncrementBy != *desiredColumn.sequence.IncrementBy) {
+					g.warnings = append(g.warnings, fmt.Sprintf(
+						"column '%s' of table '%s' changes IDENTITY(%d,%d) to IDENTITY(%d,%d), which needs rebuilding the table",
+						desiredColumn.name, desired.table.name,
+						*currentColumn.sequence.StartWith, *currentColumn.sequence.IncrementBy,
+						*desiredColumn.sequence.StartWith, *desiredColumn.sequence.IncrementBy,
+					))
+				}
 			default:
 			}
 		}
+		g.tableChanges(ObjectColumn, desired.table.name, desiredColumn.name, OperationAlter, ddls[columnStart:])
 	}
 
 	// Remove old AUTO_INCREMENT from deleted column before deleting key (primary or not)
 	if g.mode == GeneratorModeMysql {
 		for _, currentColumn := range currentTable.columns {
-			desiredColumn := findColumnByName(desired.table.columns, currentColumn.name)
+			desiredColumn := findDesiredColumn(desired.table.columns, currentColumn)
+			if desiredColumn != nil && desiredColumn.name != currentColumn.name {
+				continue // AUTO_INCREMENT is already removed by the CHANGE COLUMN for renaming
+			}
 			if currentColumn.autoIncrement && (desiredColumn == nil || !desiredColumn.autoIncrement) {
 				currentColumn.autoIncrement = false
 				definition, err := g.generateColumnDefinition(currentColumn, false)
 				if err != nil {
 					return ddls, err
 				}
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition))
+				ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name), definition)
+				ddls = append(ddls, g.tableChange(ObjectColumn, currentTable.name, currentColumn.name, OperationAlter, ddl))
 			}
 		}
 	}
@@ -392,15 +1328,29 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		if currentPrimaryKey != nil {
 			switch g.mode {
 			case GeneratorModeMysql:
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", g.escapeTableName(desired.table.name)))
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY", g.escapeTableName(desired.table.name))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, currentPrimaryKey.name, OperationDrop, ddl))
 			case GeneratorModePostgres:
 				tableName := strings.SplitN(desired.table.name, ".", 2)[1] // without schema
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(tableName+"_pkey")))
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(tableName+"_pkey"))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, tableName+"_pkey", OperationDrop, ddl))
 			default:
 			}
 		}
 		if desiredPrimaryKey != nil {
-			ddls = append(ddls, g.generateAddIndex(desired.table.name, *desiredPrimaryKey))
+			if g.mode == GeneratorModeMssql {
+				// Drop an index promoted to the primary key first. A table can have only one clustered index,
+				// and the constraint can't take the name of an existing index.
+				for _, currentIndex := range currentTable.indexes {
+					if !currentIndex.primary && (currentIndex.name == desiredPrimaryKey.name || (currentIndex.clustered && desiredPrimaryKey.clustered)) {
+						ddls = append(ddls, g.indexChange(desired.table.name, currentIndex, OperationDrop, g.generateDropIndex(desired.table.name, currentIndex)))
+						if table := findTableByName(g.currentTables, currentTable.name); table != nil {
+							table.indexes = removeIndexByName(table.indexes, currentIndex.name)
+						}
+					}
+				}
+			}
+			ddls = append(ddls, g.indexChange(desired.table.name, *desiredPrimaryKey, OperationCreate, g.generateAddIndex(desired.table.name, *desiredPrimaryKey)))
 		}
 	}
 
@@ -413,25 +1363,26 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		if currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name); currentIndex != nil {
 			// Drop and add index as needed.
 			if !areSameIndexes(*currentIndex, desiredIndex) {
-				ddls = append(ddls, g.generateDropIndex(desired.table.name, desiredIndex.name))
-				ddls = append(ddls, g.generateAddIndex(desired.table.name, desiredIndex))
+				ddls = append(ddls, g.indexChange(desired.table.name, *currentIndex, OperationDrop, g.generateDropIndex(desired.table.name, *currentIndex)))
+				ddls = append(ddls, g.indexChange(desired.table.name, desiredIndex, OperationCreate, g.generateAddIndex(desired.table.name, desiredIndex)))
 			}
 		} else {
 			// Index not found, add index.
-			ddls = append(ddls, g.generateAddIndex(desired.table.name, desiredIndex))
+			ddls = append(ddls, g.indexChange(desired.table.name, desiredIndex, OperationCreate, g.generateAddIndex(desired.table.name, desiredIndex)))
 		}
 	}
 
 	// Add new AUTO_INCREMENT after adding index and primary key
 	if g.mode == GeneratorModeMysql {
 		for _, desiredColumn := range desired.table.columns {
-			currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
+			currentColumn := findCurrentColumn(currentTable.columns, desiredColumn)
 			if desiredColumn.autoIncrement && (currentColumn == nil || !currentColumn.autoIncrement) {
 				definition, err := g.generateColumnDefinition(desiredColumn, false)
 				if err != nil {
 					return ddls, err
 				}
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), definition))
+				ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(currentTable.name), g.escapeSQLName(desiredColumn.name), definition)
+				ddls = append(ddls, g.tableChange(ObjectColumn, currentTable.name, desiredColumn.name, OperationAlter, ddl))
 			}
 		}
 	}
@@ -451,18 +1402,60 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			if !g.areSameForeignKeys(*currentForeignKey, desiredForeignKey) {
 				switch g.mode {
 				case GeneratorModeMysql:
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentForeignKey.constraintName)))
+					ddl := fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentForeignKey.constraintName))
+					ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, currentForeignKey.constraintName, OperationDrop, ddl))
 				case GeneratorModePostgres, GeneratorModeMssql:
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentForeignKey.constraintName)))
+					ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(currentForeignKey.constraintName))
+					ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, currentForeignKey.constraintName, OperationDrop, ddl))
 				default:
 				}
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateForeignKeyDefinition(desiredForeignKey)))
+				ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateForeignKeyDefinition(desiredForeignKey))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, desiredForeignKey.constraintName, OperationCreate, ddl))
 			}
 		} else {
 			// Foreign key not found, add foreign key.
 			definition := g.generateForeignKeyDefinition(desiredForeignKey)
 			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
-			ddls = append(ddls, ddl)
+			ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, desiredForeignKey.constraintName, OperationCreate, ddl))
+		}
+	}
+
+	// Examine each table-level check
+	if g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql {
+		for _, desiredCheck := range desired.table.checks {
+			currentCheck := findCheckByDesiredCheck(currentTable.checks, desiredCheck)
+			if currentCheck != nil && areSameTableChecks(*currentCheck, desiredCheck) {
+				continue
+			}
+			if currentCheck == nil && g.mode == GeneratorModePostgres {
+				if renamedCheck := findRenamedCheck(currentTable.checks, desired.table.checks, desiredCheck); renamedCheck != nil {
+					ddl := fmt.Sprintf("ALTER TABLE %s RENAME CONSTRAINT %s TO %s", g.escapeTableName(desired.table.name), g.escapeSQLName(renamedCheck.constraintName), g.escapeSQLName(desiredCheck.constraintName))
+					ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, desiredCheck.constraintName, OperationAlter, ddl))
+					continue
+				}
+			}
+			if currentCheck != nil {
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(currentCheck.constraintName))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, currentCheck.constraintName, OperationDrop, ddl))
+			}
+			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateCheckDefinition(desiredCheck))
+			ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, desiredCheck.constraintName, OperationCreate, ddl))
+		}
+	}
+
+	// Examine each exclusion constraint. It can't be altered, so it's dropped and added again when changed.
+	if g.mode == GeneratorModePostgres {
+		for _, desiredExclusion := range desired.table.exclusions {
+			currentExclusion := findExclusionByName(currentTable.exclusions, desiredExclusion.constraintName)
+			if currentExclusion != nil && areSameExclusions(*currentExclusion, desiredExclusion) {
+				continue
+			}
+			if currentExclusion != nil {
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(desired.table.name), g.ifConstraintExists(), g.escapeSQLName(currentExclusion.constraintName))
+				ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, currentExclusion.constraintName, OperationDrop, ddl))
+			}
+			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), g.generateExclusionDefinition(desiredExclusion))
+			ddls = append(ddls, g.tableChange(ObjectConstraint, desired.table.name, desiredExclusion.constraintName, OperationCreate, ddl))
 		}
 	}
 
@@ -482,13 +1475,13 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 	currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name)
 	if currentIndex == nil {
 		// Index not found, add index.
-		ddls = append(ddls, statement)
+		ddls = append(ddls, g.indexChange(tableName, desiredIndex, OperationCreate, statement))
 		currentTable.indexes = append(currentTable.indexes, desiredIndex)
 	} else {
 		// Index found. If it's different, drop and add index.
 		if !areSameIndexes(*currentIndex, desiredIndex) {
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
-			ddls = append(ddls, statement)
+			ddls = append(ddls, g.indexChange(tableName, *currentIndex, OperationDrop, g.generateDropIndex(currentTable.name, *currentIndex)))
+			ddls = append(ddls, g.indexChange(tableName, desiredIndex, OperationCreate, statement))
 
 			newIndexes := []Index{}
 			for _, currentIndex := range currentTable.indexes {
@@ -515,6 +1508,39 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 	return ddls, nil
 }
 
+func (g *Generator) generateDDLsForCreateViewIndex(desiredView *View, desiredIndex Index, statement string) ([]string, error) {
+	ddls := []string{}
+
+	if !desiredView.materialized {
+		return nil, fmt.Errorf("CREATE INDEX is performed for non-materialized view '%s': '%s'", desiredView.name, statement)
+	}
+
+	currentView := findViewByName(g.currentViews, desiredView.name)
+	if currentView == nil {
+		return nil, fmt.Errorf("CREATE INDEX is performed before create view '%s': '%s'", desiredView.name, statement)
+	}
+
+	currentIndex := findIndexByName(currentView.indexes, desiredIndex.name)
+	if currentIndex == nil {
+		// Index not found, add index.
+		ddls = append(ddls, g.tableChange(ObjectIndex, g.normalizeObjectName(currentView.name), desiredIndex.name, OperationCreate, statement))
+		currentView.indexes = append(currentView.indexes, desiredIndex)
+	} else if !areSameIndexes(*currentIndex, desiredIndex) {
+		// Index found. If it's different, drop and add index.
+		ddls = append(ddls, g.tableChange(ObjectIndex, g.normalizeObjectName(currentView.name), currentIndex.name, OperationDrop, g.generateDropIndex(currentView.name, *currentIndex)))
+		ddls = append(ddls, g.tableChange(ObjectIndex, g.normalizeObjectName(currentView.name), desiredIndex.name, OperationCreate, statement))
+		*currentIndex = desiredIndex
+	}
+
+	// Examine indexes in desiredView to delete obsoleted indexes later
+	if containsString(convertIndexesToIndexNames(desiredView.indexes), desiredIndex.name) {
+		return nil, fmt.Errorf("index '%s' is doubly created against view '%s': '%s'", desiredIndex.name, desiredView.name, statement)
+	}
+	desiredView.indexes = append(desiredView.indexes, desiredIndex)
+
+	return ddls, nil
+}
+
 func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeignKey ForeignKey, action string, statement string) ([]string, error) {
 	var ddls []string
 
@@ -544,13 +1570,14 @@ func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy
 	currentPolicy := findPolicyByName(currentTable.policies, desiredPolicy.name)
 	if currentPolicy == nil {
 		// Policy not found, add policy.
-		ddls = append(ddls, statement)
+		ddls = append(ddls, g.tableChange(ObjectPolicy, tableName, desiredPolicy.name, OperationCreate, statement))
 		currentTable.policies = append(currentTable.policies, desiredPolicy)
 	} else {
 		// policy found. If it's different, drop and add or alter policy.
 		if !areSamePolicies(*currentPolicy, desiredPolicy) {
-			ddls = append(ddls, fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(currentPolicy.name), g.escapeTableName(currentTable.name)))
-			ddls = append(ddls, statement)
+			ddl := fmt.Sprintf("DROP POLICY %s ON %s", g.escapeSQLName(currentPolicy.name), g.escapeTableName(currentTable.name))
+			ddls = append(ddls, g.tableChange(ObjectPolicy, tableName, currentPolicy.name, OperationDrop, ddl))
+			ddls = append(ddls, g.tableChange(ObjectPolicy, tableName, desiredPolicy.name, OperationCreate, statement))
 		}
 	}
 
@@ -567,21 +1594,152 @@ func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy
 	return ddls, nil
 }
 
+func (g *Generator) generateDDLsForCommentOnColumn(desired *CommentOnColumn) ([]string, error) {
+	var ddls []string
+
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("COMMENT ON COLUMN is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+
+	// A column added in this run is not in currentTable yet, and it has no comment.
+	var currentComment *Value
+	if currentColumn := findColumnByName(currentTable.columns, desired.columnName); currentColumn != nil {
+		currentComment = currentColumn.comment
+	}
+	if !areSameValue(currentComment, desired.comment) {
+		ddl := desired.statement
+		if g.mode == GeneratorModeMssql {
+			ddl = g.generateMssqlComment(desired.tableName, desired.columnName, currentComment, desired.comment)
+		}
+		ddls = append(ddls, g.tableChange(ObjectColumn, desired.tableName, desired.columnName, OperationAlter, ddl))
+		setColumnComment(currentTable, desired.columnName, desired.comment)
+	}
+
+	// Examine comments in desiredTable to delete obsoleted comments later
+	desiredTable := findTableByName(g.desiredTables, desired.tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("COMMENT ON COLUMN is performed before create table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	if !setColumnComment(desiredTable, desired.columnName, desired.comment) {
+		return nil, fmt.Errorf("COMMENT ON COLUMN is performed for inexistent column '%s' of table '%s': '%s'", desired.columnName, desired.tableName, desired.statement)
+	}
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForCommentOnTable(desired *CommentOnTable) ([]string, error) {
+	var ddls []string
+
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("COMMENT ON TABLE is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	if !areSameValue(currentTable.comment, desired.comment) {
+		ddl := desired.statement
+		if g.mode == GeneratorModeMssql {
+			ddl = g.generateMssqlComment(desired.tableName, "", currentTable.comment, desired.comment)
+		}
+		ddls = append(ddls, g.tableChange(ObjectTable, desired.tableName, "", OperationAlter, ddl))
+		currentTable.comment = desired.comment
+	}
+
+	// Examine comments in desiredTable to delete obsoleted comments later
+	desiredTable := findTableByName(g.desiredTables, desired.tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("COMMENT ON TABLE is performed before create table '%s': '%s'", desired.tableName, desired.statement)
+	}
+	desiredTable.comment = desired.comment
+
+	return ddls, nil
+}
+
+// MSSQL has no COMMENT ON, but keeps a comment of a table or a column as its extended property `MS_Description`.
+// The property is added, updated and dropped by different procedures.
+func (g *Generator) generateMssqlComment(tableName string, columnName string, currentComment *Value, desiredComment *Value) string {
+	schemaName := "dbo"
+	if schemaTable := strings.SplitN(tableName, ".", 2); len(schemaTable) == 2 {
+		schemaName, tableName = schemaTable[0], schemaTable[1]
+	}
+	target := fmt.Sprintf("'SCHEMA', '%s', 'TABLE', '%s'", schemaName, tableName)
+	if columnName != "" {
+		target += fmt.Sprintf(", 'COLUMN', '%s'", columnName)
+	}
+
+	switch {
+	case desiredComment == nil:
+		return fmt.Sprintf("EXEC sp_dropextendedproperty 'MS_Description', %s", target)
+	case currentComment == nil:
+		return fmt.Sprintf("EXEC sp_addextendedproperty 'MS_Description', N'%s', %s", strings.ReplaceAll(desiredComment.strVal, "'", "''"), target)
+	default:
+		return fmt.Sprintf("EXEC sp_updateextendedproperty 'MS_Description', N'%s', %s", strings.ReplaceAll(desiredComment.strVal, "'", "''"), target)
+	}
+}
+
+// Postgres table attributes other than columns and constraints are compared together, and changed in this order:
+// storage parameters first so that SET TABLESPACE rewrites the table with them, and CLUSTER ON after indexes are created.
+func (g *Generator) generateDDLsForTableAttributes(currentTable Table, desiredTable Table) []string {
+	ddls := []string{}
+	tableName := g.escapeTableName(currentTable.name)
+
+	setParams, resetParams := []string{}, []string{}
+	for _, name := range sortedKeys(desiredTable.storageParameters) {
+		if value, ok := currentTable.storageParameters[name]; !ok || value != desiredTable.storageParameters[name] {
+			setParams = append(setParams, fmt.Sprintf("%s = %s", name, desiredTable.storageParameters[name]))
+		}
+	}
+	for _, name := range sortedKeys(currentTable.storageParameters) {
+		if _, ok := desiredTable.storageParameters[name]; !ok {
+			resetParams = append(resetParams, name)
+		}
+	}
+	if len(setParams) > 0 {
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET (%s)", tableName, strings.Join(setParams, ", ")))
+	}
+	if len(resetParams) > 0 {
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RESET (%s)", tableName, strings.Join(resetParams, ", ")))
+	}
+
+	if currentTable.tablespace != desiredTable.tablespace {
+		tablespace := desiredTable.tablespace
+		if tablespace == "" {
+			tablespace = "pg_default"
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET TABLESPACE %s", tableName, g.escapeSQLName(tablespace)))
+	}
+
+	if currentTable.clusterIndex != desiredTable.clusterIndex {
+		if desiredTable.clusterIndex == "" {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET WITHOUT CLUSTER", tableName))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CLUSTER ON %s", tableName, g.escapeSQLName(desiredTable.clusterIndex)))
+		}
+	}
+	return ddls
+}
+
 func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View) ([]string, error) {
 	var ddls []string
 
 	currentView := findViewByName(g.currentViews, viewName)
 	if currentView == nil {
 		// View not found, add view.
-		ddls = append(ddls, desiredView.statement)
+		ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationCreate, desiredView.statement))
+	} else if currentView.materialized || desiredView.materialized {
+		// Materialized views can't be replaced, nor can CREATE OR REPLACE change the kind of a view. Recreate it, which drops its indexes too.
+		if strings.ToLower(currentView.definition) != strings.ToLower(desiredView.definition) || currentView.materialized != desiredView.materialized {
+			ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationDrop, g.generateDropView(*currentView)))
+			ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationCreate, desiredView.statement))
+			currentView.indexes = nil
+		}
 	} else {
 		// View found. If it's different, create or replace view.
 		if strings.ToLower(currentView.definition) != strings.ToLower(desiredView.definition) {
 			if g.mode == GeneratorModeSQLite3 || g.mode == GeneratorModeMssql {
-				ddls = append(ddls, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(viewName)))
-				ddls = append(ddls, fmt.Sprintf("CREATE VIEW %s AS %s", g.escapeTableName(viewName), desiredView.definition))
+				ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationDrop, fmt.Sprintf("DROP VIEW %s%s", g.ifExists(), g.escapeTableName(viewName))))
+				ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationCreate, fmt.Sprintf("CREATE VIEW %s AS %s", g.escapeTableName(viewName), desiredView.definition)))
 			} else {
-				ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", g.escapeTableName(viewName), desiredView.definition))
+				ddls = append(ddls, g.change(ObjectView, g.normalizeObjectName(viewName), OperationAlter, fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", g.escapeTableName(viewName), desiredView.definition)))
 			}
 		}
 	}
@@ -595,30 +1753,456 @@ func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View
 	return ddls, nil
 }
 
-// Even though simulated table doesn't have a foreign key, references could exist in column definitions.
-// This carefully generates DROP CONSTRAINT for such situations.
-func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey, currentTable Table, desiredTable Table) []string {
-	ddls := []string{}
+// A column taking its default from a sequence can't be identity until the default is dropped. The identity starts from the next
+// value of the sequence, and then the sequence is dropped if the column owns it like serial. `pg_get_serial_sequence` finds it
+// before adding identity, which owns another sequence. They're skipped together because ADD IDENTITY fails without DROP DEFAULT.
+func (g *Generator) generateDDLsForSerialToIdentity(tableName string, columnName string, sequenceName string, addIdentity string) []string {
+	restart := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s RESTART WITH %%s", g.escapeTableName(tableName), g.escapeSQLName(columnName))
+	return g.atomic([]string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(tableName), g.escapeSQLName(columnName)),
+		fmt.Sprintf(
+			"DO $$ DECLARE seq text := pg_get_serial_sequence('%s', '%s'); next_value bigint; BEGIN "+
+				"EXECUTE format('SELECT CASE WHEN is_called THEN last_value + 1 ELSE last_value END FROM %%s', COALESCE(seq, '%s')) INTO next_value; "+
+				"%s; EXECUTE format('%s', next_value); IF seq IS NOT NULL THEN EXECUTE format('DROP SEQUENCE %%s', seq); END IF; END $$",
+			strings.ReplaceAll(g.escapeTableName(tableName), "'", "''"), strings.ReplaceAll(columnName, "'", "''"), strings.ReplaceAll(g.escapeTableName(sequenceName), "'", "''"),
+			addIdentity, strings.ReplaceAll(restart, "'", "''"),
+		),
+	})
+}
 
-	switch g.mode {
-	case GeneratorModeMysql:
-		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName)))
-	case GeneratorModePostgres, GeneratorModeMssql:
-		var referencesColumn *Column
-		for _, column := range desiredTable.columns {
-			if column.references == currentForeignKey.referenceName {
-				referencesColumn = &column
-				break
-			}
+// Create the schema of a table before the first table in it, unless it's the default schema.
+// The schema may exist without tables, so it's created only if it doesn't exist.
+func (g *Generator) generateDDLsForCreateSchema(desiredTable Table) []string {
+	schemaName := g.tableSchema(desiredTable)
+	if schemaName == "" {
+		return nil
+	}
+	for _, table := range g.currentTables {
+		if g.tableSchema(*table) == schemaName {
+			return nil
 		}
+	}
 
-		if referencesColumn == nil {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName)))
-		}
-	default:
+	switch g.mode {
+	case GeneratorModePostgres:
+		return []string{g.change(ObjectSchema, schemaName, OperationCreate, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", g.escapeSQLName(schemaName)))}
+	default: // MSSQL
+		// CREATE SCHEMA has no IF NOT EXISTS and must be the first statement in a batch
+		ddl := fmt.Sprintf("IF SCHEMA_ID('%s') IS NULL EXEC('CREATE SCHEMA %s')", schemaName, g.escapeSQLName(schemaName))
+		return []string{g.change(ObjectSchema, schemaName, OperationCreate, ddl)}
 	}
+}
 
-	return ddls
+// The schema of a table if it's not the default one
+func (g *Generator) tableSchema(table Table) string {
+	switch g.mode {
+	case GeneratorModePostgres:
+		if schemaName := strings.SplitN(table.name, ".", 2)[0]; schemaName != "public" {
+			return schemaName
+		}
+	case GeneratorModeMssql:
+		if table.schema != "dbo" {
+			return table.schema
+		}
+	}
+	return ""
+}
+
+func (g *Generator) generateDDLsForCreateType(desired *CreateType) ([]string, error) {
+	var ddls []string
+
+	currentType := findTypeByName(g.currentTypes, desired.name)
+	if currentType == nil {
+		// Type not found, create type.
+		ddls = append(ddls, g.change(ObjectDataType, desired.name, OperationCreate, desired.statement))
+		g.currentTypes = append(g.currentTypes, desired)
+		return ddls, nil
+	}
+
+	// Type found. PostgreSQL can only add enum values, so the current ones must be kept in the same order.
+	for _, value := range currentType.enumValues {
+		if !containsString(desired.enumValues, value) {
+			return nil, fmt.Errorf("enum value %s of type '%s' can't be removed: '%s'", value, desired.name, desired.statement)
+		}
+	}
+	i := 0
+	for _, value := range desired.enumValues {
+		if i < len(currentType.enumValues) && currentType.enumValues[i] == value {
+			i++
+			continue
+		}
+		if containsString(currentType.enumValues, value) {
+			return nil, fmt.Errorf("enum values of type '%s' can't be reordered: '%s'", desired.name, desired.statement)
+		}
+		ddl := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", g.escapeTableName(desired.name), value)
+		if i < len(currentType.enumValues) {
+			ddl += fmt.Sprintf(" BEFORE %s", currentType.enumValues[i])
+		}
+		ddls = append(ddls, g.change(ObjectDataType, desired.name, OperationAlter, ddl))
+		g.nonTransactional(ddl) // A value added in a transaction can't be used until it's committed
+	}
+	currentType.enumValues = desired.enumValues
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForCreateFunction(desired *Function) ([]string, error) {
+	var ddls []string
+
+	currentFunction := findFunctionBySignature(g.currentFunctions, desired.signature())
+	if currentFunction == nil {
+		// Function not found, create function.
+		ddls = append(ddls, g.change(ObjectFunction, g.normalizeObjectName(desired.name), OperationCreate, desired.statement))
+	} else if currentFunction.returns != desired.returns || strings.Join(currentFunction.arguments, ", ") != strings.Join(desired.arguments, ", ") {
+		// CREATE OR REPLACE FUNCTION can't change the return type or argument names and defaults. Recreate it.
+		ddls = append(ddls, g.change(ObjectFunction, g.normalizeObjectName(desired.name), OperationDrop, g.generateDropFunction(currentFunction)))
+		ddls = append(ddls, g.change(ObjectFunction, g.normalizeObjectName(desired.name), OperationCreate, desired.statement))
+	} else if currentFunction.body != desired.body || currentFunction.language != desired.language ||
+		strings.Join(currentFunction.attributes, " ") != strings.Join(desired.attributes, " ") {
+		// Function found. If it's different, create or replace function.
+		ddl := createFunction.ReplaceAllString(desired.statement, "CREATE OR REPLACE FUNCTION $2(")
+		ddls = append(ddls, g.change(ObjectFunction, g.normalizeObjectName(desired.name), OperationAlter, ddl))
+	}
+
+	if findFunctionBySignature(g.desiredFunctions, desired.signature()) != nil {
+		return nil, fmt.Errorf("function '%s' is doubly created: '%s'", desired.signature(), desired.statement)
+	}
+	g.desiredFunctions = append(g.desiredFunctions, desired)
+
+	return ddls, nil
+}
+
+// Functions are overloaded by argument types, so DROP FUNCTION needs them.
+func (g *Generator) generateDropFunction(function *Function) string {
+	return fmt.Sprintf("DROP FUNCTION %s(%s)", g.escapeTableName(function.name), strings.Join(function.argumentTypes(), ", "))
+}
+
+func (g *Generator) generateDDLsForCreateTrigger(desired *Trigger) ([]string, error) {
+	var ddls []string
+
+	currentTrigger := g.findTriggerByName(g.currentTriggers, desired.name, desired.tableName)
+	if currentTrigger == nil {
+		// Trigger not found, create trigger.
+		ddls = append(ddls, g.change(ObjectTrigger, desired.name, OperationCreate, desired.statement))
+	} else if currentTrigger.tableName != desired.tableName || currentTrigger.timing != desired.timing || currentTrigger.events != desired.events ||
+		strings.ToLower(currentTrigger.body) != strings.ToLower(desired.body) {
+		// Trigger found. Most databases can't replace a trigger, so recreate it.
+		ddls = append(ddls, g.change(ObjectTrigger, desired.name, OperationDrop, g.generateDropTrigger(currentTrigger)))
+		ddls = append(ddls, g.change(ObjectTrigger, desired.name, OperationCreate, desired.statement))
+	}
+
+	if g.findTriggerByName(g.desiredTriggers, desired.name, desired.tableName) != nil {
+		return nil, fmt.Errorf("trigger '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredTriggers = append(g.desiredTriggers, desired)
+
+	return ddls, nil
+}
+
+// Postgres trigger names are unique in a table, not in a schema
+func (g *Generator) generateDropTrigger(trigger *Trigger) string {
+	if g.mode == GeneratorModePostgres {
+		return fmt.Sprintf("DROP TRIGGER %s ON %s", g.escapeSQLName(trigger.name), g.escapeTableName(trigger.tableName))
+	}
+	return fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(trigger.name))
+}
+
+func (g *Generator) generateDDLsForCreateDomain(desired *CreateDomain) ([]string, error) {
+	var ddls []string
+
+	currentDomain := findDomainByName(g.currentDomains, desired.name)
+	if currentDomain == nil {
+		// Domain not found, create domain.
+		ddls = append(ddls, g.change(ObjectDomain, desired.name, OperationCreate, desired.statement))
+	} else {
+		// Domain found. Alter its constraints, but its base type can't be changed.
+		current, desiredDefinition := currentDomain.definition, desired.definition
+		domainName := g.escapeTableName(desired.name)
+		if !g.haveSameDataType(current, desiredDefinition) {
+			return nil, fmt.Errorf("the data type of domain '%s' can't be changed: '%s'", desired.name, desired.statement)
+		}
+
+		if g.notNull(current) && !g.notNull(desiredDefinition) {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP NOT NULL", domainName))
+		} else if !g.notNull(current) && g.notNull(desiredDefinition) {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET NOT NULL", domainName))
+		}
+
+		if !areSameDefaultValue(current.defaultDef, desiredDefinition.defaultDef) {
+			if desiredDefinition.defaultDef == nil {
+				ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP DEFAULT", domainName))
+			} else {
+				definition, err := generateDefaultDefinition(*desiredDefinition.defaultDef)
+				if err != nil {
+					return ddls, err
+				}
+				ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET %s", domainName, definition))
+			}
+		}
+
+		// Checks are examined by their names. Unnamed ones are named by PostgreSQL like `email_check`, `email_check1`, ...
+		desiredChecks := nameDomainChecks(desired.name, desired.checks)
+		for _, currentCheck := range currentDomain.checks {
+			desiredCheck := findCheckByName(desiredChecks, currentCheck.constraintName)
+			if desiredCheck == nil || desiredCheck.definition != currentCheck.definition {
+				ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s%s", domainName, g.ifConstraintExists(), g.escapeSQLName(currentCheck.constraintName)))
+			}
+		}
+		for i, desiredCheck := range desiredChecks {
+			currentCheck := findCheckByName(currentDomain.checks, desiredCheck.constraintName)
+			if currentCheck == nil || currentCheck.definition != desiredCheck.definition {
+				ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s ADD %s", domainName, g.generateCheckDefinition(desired.checks[i])))
+			}
+		}
+		for _, ddl := range ddls {
+			g.change(ObjectDomain, desired.name, OperationAlter, ddl)
+		}
+	}
+
+	// Examine domains to drop obsoleted domains later
+	if findDomainByName(g.desiredDomains, desired.name) != nil {
+		return nil, fmt.Errorf("domain '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredDomains = append(g.desiredDomains, desired)
+
+	return ddls, nil
+}
+
+// Whether the table has changes which SQLite's ALTER TABLE doesn't support, i.e. anything but adding, dropping or renaming a column.
+// `WITHOUT ROWID` can't be toggled either.
+func (g *Generator) needsSQLite3TableRebuild(currentTable Table, desiredTable Table) bool {
+	for _, desiredColumn := range desiredTable.columns {
+		currentColumn := findCurrentColumn(currentTable.columns, desiredColumn)
+		if currentColumn == nil {
+			continue // ADD COLUMN
+		}
+		if !g.haveSameDataType(*currentColumn, desiredColumn) ||
+			g.notNull(*currentColumn) != g.notNull(desiredColumn) ||
+			currentColumn.keyOption != desiredColumn.keyOption ||
+			currentColumn.autoIncrement != desiredColumn.autoIncrement ||
+			currentColumn.collate != desiredColumn.collate ||
+			currentColumn.references != desiredColumn.references ||
+			!areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) ||
+			!areSameCheckDefinition(currentColumn.check, desiredColumn.check) ||
+			!areSameGeneratedColumn(*currentColumn, desiredColumn) {
+			return true
+		}
+	}
+	return !areSamePrimaryKeys(currentTable.PrimaryKey(), desiredTable.PrimaryKey()) ||
+		currentTable.withoutRowID != desiredTable.withoutRowID
+}
+
+// Only options given in the desired schema are changed since MySQL shows some of them even if they're not specified.
+func (g *Generator) generateDDLsForTableOptions(currentTable Table, desiredTable Table) []string {
+	ddls := []string{}
+	current, desired := currentTable.options, desiredTable.options
+	tableName := g.escapeTableName(currentTable.name)
+
+	if engine := desired["ENGINE"]; engine != "" && !strings.EqualFold(engine, current["ENGINE"]) {
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ENGINE = %s", tableName, engine))
+	}
+
+	if isTableCharsetChanged(current, desired) {
+		charset, collate := desired["CHARSET"], desired["COLLATE"]
+		if charset == "" {
+			charset = charsetOfCollate(collate)
+		}
+		ddl := fmt.Sprintf("ALTER TABLE %s CONVERT TO CHARACTER SET %s", tableName, charset)
+		if collate != "" {
+			ddl += " COLLATE " + collate
+		}
+		ddls = append(ddls, ddl)
+	}
+
+	// AUTO_INCREMENT grows with inserted rows, so it's changed only to be increased
+	if autoIncrement, err := strconv.Atoi(desired["AUTO_INCREMENT"]); err == nil {
+		if currentAutoIncrement, err := strconv.Atoi(current["AUTO_INCREMENT"]); err != nil || currentAutoIncrement < autoIncrement {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s AUTO_INCREMENT = %d", tableName, autoIncrement))
+		}
+	}
+	return ddls
+}
+
+// MySQL 5.7 doesn't show the default collation of a charset. Then the collation is compared only when it's shown.
+func isTableCharsetChanged(current map[string]string, desired map[string]string) bool {
+	charset, collate := desired["CHARSET"], desired["COLLATE"]
+	charsetChanged := charset != "" && !strings.EqualFold(normalizeUtf8(charset), normalizeUtf8(current["CHARSET"]))
+	collateChanged := collate != "" && current["COLLATE"] != "" && !strings.EqualFold(normalizeUtf8(collate), normalizeUtf8(current["COLLATE"]))
+	return charsetChanged || collateChanged
+}
+
+// A MySQL column without CHARACTER SET or COLLATE inherits the table's default, and MySQL shows it only when it differs.
+// Resolve the inherited ones so that an explicit charset matching the table's default doesn't make a difference.
+// The inherited collation is resolved only when both tables show it, for the same reason as isTableCharsetChanged.
+func resolveColumnCharsets(current Column, desired Column, currentOptions map[string]string, desiredOptions map[string]string) (Column, Column) {
+	if desiredOptions["CHARSET"] == "" && desiredOptions["COLLATE"] == "" {
+		// Omitted table options are not changed, so the desired table keeps the current default
+		desiredOptions = currentOptions
+	}
+	if isTableCharsetChanged(currentOptions, desiredOptions) {
+		// CONVERT TO CHARACTER SET changes every column to the table's new charset
+		current.charset, current.collate, currentOptions = "", "", desiredOptions
+	}
+	inheritCollate := currentOptions["COLLATE"] != "" && desiredOptions["COLLATE"] != ""
+	return inheritTableCharset(current, currentOptions, inheritCollate), inheritTableCharset(desired, desiredOptions, inheritCollate)
+}
+
+func inheritTableCharset(column Column, options map[string]string, inheritCollate bool) Column {
+	tableCharset := options["CHARSET"]
+	if tableCharset == "" && options["COLLATE"] != "" {
+		tableCharset = charsetOfCollate(options["COLLATE"])
+	}
+	if column.charset == "" {
+		if column.collate != "" {
+			column.charset = charsetOfCollate(column.collate)
+		} else {
+			column.charset = tableCharset
+		}
+	}
+	if column.collate == "" && inheritCollate && strings.EqualFold(normalizeUtf8(column.charset), normalizeUtf8(tableCharset)) {
+		column.collate = options["COLLATE"]
+	}
+	return column
+}
+
+// A collation name starts with its charset
+func charsetOfCollate(collate string) string {
+	return strings.SplitN(collate, "_", 2)[0]
+}
+
+// MySQL 8.0.30+ shows `utf8` as `utf8mb3`, including the prefix of collations
+func normalizeUtf8(name string) string {
+	lower := strings.ToLower(name)
+	if lower == "utf8mb3" || strings.HasPrefix(lower, "utf8mb3_") {
+		return "utf8" + lower[len("utf8mb3"):]
+	}
+	return lower
+}
+
+// Rebuild a table in the way described in https://www.sqlite.org/lang_altertable.html#otheralter:
+// create a new table, copy rows to it, drop the old table, and rename the new table.
+func (g *Generator) generateDDLsForRebuildTable(currentTable Table, desired CreateTable) ([]string, error) {
+	ddls := []string{}
+
+	newTableName := "_sqldef_new_" + currentTable.name
+	if !createTableName.MatchString(desired.statement) {
+		return ddls, fmt.Errorf("failed to find the table name to rebuild '%s': '%s'", currentTable.name, desired.statement)
+	}
+	ddls = append(ddls, createTableName.ReplaceAllLiteralString(desired.statement, "CREATE TABLE "+g.escapeTableName(newTableName)))
+
+	// Copy columns which exist in both tables. Generated columns can't be inserted.
+	var insertColumns, selectColumns []string
+	copiedColumns := map[string]bool{}
+	for _, desiredColumn := range desired.table.columns {
+		currentColumn := findCurrentColumn(currentTable.columns, desiredColumn)
+		if currentColumn == nil || desiredColumn.generatedExpr != "" {
+			continue
+		}
+		insertColumns = append(insertColumns, g.escapeSQLName(desiredColumn.name))
+		selectColumns = append(selectColumns, g.escapeSQLName(currentColumn.name))
+		copiedColumns[currentColumn.name] = true
+	}
+	if len(insertColumns) > 0 {
+		ddls = append(ddls, fmt.Sprintf(
+			"INSERT INTO %s (%s) SELECT %s FROM %s",
+			g.escapeTableName(newTableName), strings.Join(insertColumns, ", "), strings.Join(selectColumns, ", "), g.escapeTableName(currentTable.name),
+		))
+	}
+	ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name)))
+	ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeTableName(newTableName), g.escapeTableName(currentTable.name)))
+
+	// The rebuild loses data of columns which aren't copied. Then all of the statements are unsafe
+	// because the rebuild can't be partially applied.
+	for _, currentColumn := range currentTable.columns {
+		if !copiedColumns[currentColumn.name] && currentColumn.generatedExpr == "" {
+			for _, ddl := range ddls {
+				g.unsafe(ddl)
+			}
+			break
+		}
+	}
+
+	// Simulate the rebuild. Indexes other than the ones in CREATE TABLE and triggers are dropped with the old table.
+	if table := findTableByName(g.currentTables, currentTable.name); table != nil {
+		*table = desired.table
+	}
+	triggers := []*Trigger{}
+	for _, trigger := range g.currentTriggers {
+		if trigger.tableName != currentTable.name {
+			triggers = append(triggers, trigger)
+		}
+	}
+	g.currentTriggers = triggers
+	g.rebuiltTables[currentTable.name] = len(g.atomicDDLs)
+	return g.atomic(ddls), nil
+}
+
+// Dropping a rebuilt table must not cascade to, or be rejected by, foreign keys referencing it. Turn them off once around
+// the rebuilds which are not skipped. The pragma is a no-op in a transaction, so it's run between the transactions.
+func (g *Generator) disableForeignKeysDuringRebuilds(ddls []string, skippedDDLs map[string]bool) []string {
+	first, last := -1, -1
+	for i, ddl := range ddls {
+		if g.isRebuildDDL(ddl) && !skippedDDLs[ddl] {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return ddls
+	}
+
+	result := append([]string{}, ddls[:first]...)
+	result = append(result, g.nonTransactional("PRAGMA foreign_keys = OFF"))
+	result = append(result, ddls[first:last+1]...)
+	result = append(result, g.nonTransactional("PRAGMA foreign_keys = ON"))
+	return append(result, ddls[last+1:]...)
+}
+
+func (g *Generator) isRebuildDDL(ddl string) bool {
+	for _, i := range g.rebuiltTables {
+		if containsString(g.atomicDDLs[i], ddl) {
+			return true
+		}
+	}
+	return false
+}
+
+// Indexes and triggers are created again after rebuilding their table. They can't be created without the rebuild.
+func (g *Generator) afterRebuild(tableName string, ddls []string) []string {
+	if i, ok := g.rebuiltTables[tableName]; ok {
+		g.atomicDDLs[i] = append(g.atomicDDLs[i], ddls...)
+	}
+	return ddls
+}
+
+// Even though simulated table doesn't have a foreign key, references could exist in column definitions.
+// This carefully generates DROP CONSTRAINT for such situations.
+func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey, currentTable Table, desiredTable Table) []string {
+	ddls := []string{}
+
+	switch g.mode {
+	case GeneratorModeMysql:
+		ddl := fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentForeignKey.constraintName))
+		ddls = append(ddls, g.tableChange(ObjectConstraint, currentTable.name, currentForeignKey.constraintName, OperationDrop, ddl))
+	case GeneratorModePostgres, GeneratorModeMssql:
+		var referencesColumn *Column
+		for _, column := range desiredTable.columns {
+			if column.references == currentForeignKey.referenceName {
+				referencesColumn = &column
+				break
+			}
+		}
+
+		if referencesColumn == nil {
+			ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(currentTable.name), g.ifConstraintExists(), g.escapeSQLName(currentForeignKey.constraintName))
+			ddls = append(ddls, g.tableChange(ObjectConstraint, currentTable.name, currentForeignKey.constraintName, OperationDrop, ddl))
+		}
+	default:
+	}
+
+	return ddls
 }
 
 // Even though simulated table doesn't have an index, primary or unique could exist in column definitions.
@@ -626,7 +2210,17 @@ func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey
 func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable Table, desiredTable Table) ([]string, error) {
 	ddls := []string{}
 
+	// MySQL can't drop an index needed by a foreign key. Keep it while the foreign key exists.
+	if g.mode == GeneratorModeMysql && !currentIndex.primary && isIndexNeededByForeignKey(currentIndex, desiredTable) {
+		return ddls, nil
+	}
+
 	if currentIndex.primary {
+		// The same primary key named by default, like Postgres `<table>_pkey`
+		if desiredPrimaryKey := desiredTable.PrimaryKey(); desiredPrimaryKey != nil && areSameIndexes(currentIndex, *desiredPrimaryKey) {
+			return ddls, nil
+		}
+
 		var primaryKeyColumn *Column
 		for _, column := range desiredTable.columns {
 			if column.keyOption == ColumnKeyPrimary {
@@ -639,7 +2233,8 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 			// If nil, it will be `DROP COLUMN`-ed and we can usually ignore it.
 			// However, it seems like you need to explicitly drop it first for MSSQL.
 			if g.mode == GeneratorModeMssql && (primaryKeyColumn == nil || primaryKeyColumn.name != currentIndex.columns[0].column) {
-				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentIndex.name)))
+				ddl := fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(currentTable.name), g.ifConstraintExists(), g.escapeSQLName(currentIndex.name))
+				ddls = append(ddls, g.indexChange(currentTable.name, currentIndex, OperationDrop, ddl))
 			}
 		} else if primaryKeyColumn.name != currentIndex.columns[0].column { // TODO: check length of currentIndex.columns
 			// TODO: handle this. Rename primary key column...?
@@ -651,7 +2246,9 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 	} else if currentIndex.unique {
 		var uniqueKeyColumn *Column
 		for _, column := range desiredTable.columns {
-			if column.name == currentIndex.columns[0].column && column.keyOption.isUnique() {
+			// A column-level UNIQUE makes no index with INCLUDE, so such an index is never the column's one. Neither is a Postgres unique constraint of another name.
+			if len(currentIndex.includeColumns) == 0 && column.name == currentIndex.columns[0].column && column.keyOption.isUnique() &&
+				!(g.mode == GeneratorModePostgres && currentIndex.constraint) {
 				uniqueKeyColumn = &column
 				break
 			}
@@ -659,15 +2256,58 @@ func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable
 
 		if uniqueKeyColumn == nil {
 			// No unique column. Drop unique key index.
-			ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
+			ddls = append(ddls, g.indexChange(currentTable.name, currentIndex, OperationDrop, g.generateDropIndex(currentTable.name, currentIndex)))
 		}
 	} else {
-		ddls = append(ddls, g.generateDropIndex(currentTable.name, currentIndex.name))
+		ddls = append(ddls, g.indexChange(currentTable.name, currentIndex, OperationDrop, g.generateDropIndex(currentTable.name, currentIndex)))
 	}
 
 	return ddls, nil
 }
 
+// Whether a foreign key of the table can't use indexes other than the given one
+func isIndexNeededByForeignKey(index Index, table Table) bool {
+	otherIndexes := []Index{}
+	for _, otherIndex := range table.indexes {
+		if otherIndex.name != index.name {
+			otherIndexes = append(otherIndexes, otherIndex)
+		}
+	}
+	if primaryKey := table.PrimaryKey(); primaryKey != nil {
+		otherIndexes = append(otherIndexes, *primaryKey)
+	}
+
+	for _, foreignKey := range table.foreignKeys {
+		if !indexCoversColumns(index, foreignKey.indexColumns) {
+			continue
+		}
+		covered := false
+		for _, otherIndex := range otherIndexes {
+			if indexCoversColumns(otherIndex, foreignKey.indexColumns) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether the columns are the leading columns of the index, which makes the index usable for them
+func indexCoversColumns(index Index, columns []string) bool {
+	if len(columns) == 0 || len(index.columns) < len(columns) {
+		return false
+	}
+	for i, column := range columns {
+		if index.columns[i].column != column {
+			return false
+		}
+	}
+	return true
+}
+
 func generateDataType(column Column) string {
 	suffix := ""
 	if column.array {
@@ -682,7 +2322,7 @@ func generateDataType(column Column) string {
 		}
 	} else {
 		switch column.typeName {
-		case "enum":
+		case "enum", "set":
 			return fmt.Sprintf("%s(%s)%s", column.typeName, strings.Join(column.enumValues, ", "), suffix)
 		default:
 			return fmt.Sprintf("%s%s", column.typeName, suffix)
@@ -690,76 +2330,467 @@ func generateDataType(column Column) string {
 	}
 }
 
-func (g *Generator) generateColumnDefinition(column Column, enableUnique bool) (string, error) {
-	// TODO: make string concatenation faster?
-
-	definition := fmt.Sprintf("%s %s ", g.escapeSQLName(column.name), generateDataType(column))
-
-	if column.unsigned {
-		definition += "UNSIGNED "
-	}
-	if column.timezone {
-		definition += "WITH TIME ZONE "
+func (g *Generator) generateRenameColumn(tableName string, oldName string, newName string) string {
+	switch g.mode {
+	case GeneratorModeMssql:
+		return fmt.Sprintf("EXEC sp_rename '%s.%s', '%s', 'COLUMN'", g.escapeTableName(tableName), g.escapeSQLName(oldName), newName)
+	default:
+		return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", g.escapeTableName(tableName), g.escapeSQLName(oldName), g.escapeSQLName(newName))
 	}
+}
 
-	// [CHARACTER SET] and [COLLATE] should be placed before [NOT NULL | NULL] on MySQL
-	if column.charset != "" {
-		definition += fmt.Sprintf("CHARACTER SET %s ", column.charset)
+// Postgres can't reorder columns, and ADD COLUMN always appends a column to the end.
+// Record warnings when the resulting column order differs from the desired one.
+func (g *Generator) checkColumnOrder(currentTable Table, desiredTable Table) {
+	var keptColumns, addedColumns []string // names in desiredTable
+	for _, currentColumn := range currentTable.columns {
+		if desiredColumn := findDesiredColumn(desiredTable.columns, currentColumn); desiredColumn != nil {
+			keptColumns = append(keptColumns, desiredColumn.name)
+		}
 	}
-	if column.collate != "" {
-		definition += fmt.Sprintf("COLLATE %s ", column.collate)
+	for _, desiredColumn := range desiredTable.columns {
+		if findCurrentColumn(currentTable.columns, desiredColumn) == nil {
+			addedColumns = append(addedColumns, desiredColumn.name)
+		}
 	}
 
-	if column.identity == "" && ((column.notNull != nil && *column.notNull) || column.keyOption == ColumnKeyPrimary) {
-		definition += "NOT NULL "
-	} else if column.notNull != nil && !*column.notNull {
-		definition += "NULL "
+	resultColumns := append(keptColumns, addedColumns...)
+	desiredColumns := convertColumnsToColumnNames(desiredTable.columns)
+	for i, name := range addedColumns {
+		if position := len(keptColumns) + i; position >= len(desiredColumns) || desiredColumns[position] != name {
+			g.columnOrderWarnings = append(g.columnOrderWarnings, fmt.Sprintf(
+				"column '%s' is added to the end of table '%s' since PostgreSQL can't add a column in the middle",
+				name, desiredTable.name,
+			))
+		}
 	}
 
-	if column.defaultDef != nil && column.defaultDef.value != nil {
-		def, err := generateDefaultDefinition(*column.defaultDef.value)
-		if err != nil {
-			return "", fmt.Errorf("%s in column: %#v", err.Error(), column)
+	var desiredKeptColumns []string
+	for _, name := range desiredColumns {
+		if containsString(keptColumns, name) {
+			desiredKeptColumns = append(desiredKeptColumns, name)
 		}
-		definition += def + " "
 	}
-
-	if column.autoIncrement {
-		definition += "AUTO_INCREMENT "
+	if !reflect.DeepEqual(keptColumns, desiredKeptColumns) {
+		g.columnOrderWarnings = append(g.columnOrderWarnings, fmt.Sprintf(
+			"columns of table '%s' are kept in the order (%s) since PostgreSQL can't reorder columns",
+			desiredTable.name, strings.Join(resultColumns, ", "),
+		))
 	}
+}
 
-	if column.onUpdate != nil {
-		definition += fmt.Sprintf("ON UPDATE %s ", string(column.onUpdate.raw))
-	}
+// Record a DDL which may lose data
+func (g *Generator) unsafe(ddl string) string {
+	g.unsafeDDLs[ddl] = true
+	return ddl
+}
 
-	if column.check != nil {
-		definition += fmt.Sprintf("CHECK (%s) ", column.check.definition)
+// Record a DDL which can't run in a transaction
+func (g *Generator) nonTransactional(ddl string) string {
+	g.nonTransactionalDDLs[ddl] = true
+	return ddl
+}
+
+// Record the object which a DDL changes, which is reported in `Result.Changes`
+func (g *Generator) change(objectType ObjectType, objectName string, operation Operation, ddl string) string {
+	g.changes[ddl] = append(g.changes[ddl], Change{ObjectType: objectType, ObjectName: objectName, Operation: operation, Statement: ddl})
+	return ddl
+}
+
+// Record an object of a table, like a column, which is named with the table. An empty name is the table itself.
+func (g *Generator) tableChange(objectType ObjectType, tableName string, name string, operation Operation, ddl string) string {
+	objectName := tableName
+	if name != "" {
+		objectName += "." + name
 	}
-	if column.checkNoInherit {
-		definition += "NO INHERIT "
+	g.changedTables[ddl] = tableName
+	return g.change(objectType, objectName, operation, ddl)
+}
+
+// Record an index, which is a constraint when it's a primary key or a unique constraint
+func (g *Generator) indexChange(tableName string, index Index, operation Operation, ddl string) string {
+	objectType := ObjectIndex
+	if index.primary || index.constraint {
+		objectType = ObjectConstraint
 	}
+	return g.tableChange(objectType, tableName, index.name, operation, ddl)
+}
 
-	switch column.keyOption {
-	case ColumnKeyNone:
-		// noop
-	case ColumnKeyUnique:
-		if enableUnique {
-			definition += "UNIQUE "
-		}
-	case ColumnKeyUniqueKey:
-		if enableUnique {
-			definition += "UNIQUE KEY "
+// Record an object of a table which DDLs change, unless they're recorded to change another one
+func (g *Generator) tableChanges(objectType ObjectType, tableName string, name string, operation Operation, ddls []string) []string {
+	for _, ddl := range ddls {
+		if len(g.changes[ddl]) == 0 {
+			g.tableChange(objectType, tableName, name, operation, ddl)
 		}
-	case ColumnKeyPrimary:
-		// noop
-	default:
-		return "", fmt.Errorf("unsupported column key (keyOption: '%d') in column: %#v", column.keyOption, column)
 	}
+	return ddls
+}
 
-	if column.identity != "" {
-		definition += "GENERATED " + column.identity + " AS IDENTITY "
-		if column.sequence != nil {
-			definition += "(" + generateSequenceClause(column.sequence) + ") "
+// Changes of DDLs in order. Changes recorded for the same statement are taken in the order they're recorded,
+// and the last one is repeated when the statement appears more often.
+func (g *Generator) changeList(ddls []string) []Change {
+	changeList := []Change{}
+	taken := map[string]int{}
+	for _, ddl := range ddls {
+		changes := g.changes[ddl]
+		switch {
+		case len(changes) == 0:
+			changeList = append(changeList, Change{Statement: ddl})
+		case taken[ddl] < len(changes):
+			changeList = append(changeList, changes[taken[ddl]])
+		default:
+			changeList = append(changeList, changes[len(changes)-1])
+		}
+		taken[ddl]++
+	}
+	return changeList
+}
+
+// Record DDLs which can't be partially applied. When one of them is skipped, all of them are skipped.
+func (g *Generator) atomic(ddls []string) []string {
+	g.atomicDDLs = append(g.atomicDDLs, ddls)
+	return ddls
+}
+
+// DDLs skipped by `GeneratorOptions.SkipDrop` and `AllowUnsafe`
+func (g *Generator) skippedDDLs(ddls []string) map[string]bool {
+	skipped := map[string]bool{}
+	for _, ddl := range ddls {
+		if (g.skipDrop && strings.Contains(ddl, "DROP")) || (!g.allowUnsafe && g.unsafeDDLs[ddl]) {
+			skipped[ddl] = true
+		}
+	}
+	for _, atomicDDLs := range g.atomicDDLs {
+		for _, ddl := range atomicDDLs {
+			if skipped[ddl] {
+				for _, ddl := range atomicDDLs {
+					skipped[ddl] = true
+				}
+				break
+			}
+		}
+	}
+	return skipped
+}
+
+// CREATE TABLE given as is, but MSSQL column checks without names are named like `<table>_<column>_check`
+// since MSSQL names them randomly otherwise.
+func (g *Generator) generateCreateTableStatement(desired *CreateTable) string {
+	statement := desired.statement
+	if g.mode != GeneratorModeMssql {
+		return statement
+	}
+	start := strings.Index(statement, "(")
+	end := findClosingParen(statement, start)
+	if start < 0 || end < 0 {
+		return statement
+	}
+
+	definitions := splitTopLevel(statement[start+1 : end])
+	for _, column := range desired.table.columns {
+		if column.check == nil || column.check.constraintName != "" {
+			continue
+		}
+		columnName := regexp.MustCompile(`^\s*(?:\[` + regexp.QuoteMeta(column.name) + `\]|"` + regexp.QuoteMeta(column.name) + `"|` + regexp.QuoteMeta(column.name) + `)\s`)
+		for i, definition := range definitions {
+			if loc := checkKeyword.FindStringIndex(definition); loc != nil && columnName.MatchString(definition) {
+				constraint := fmt.Sprintf("CONSTRAINT %s ", g.escapeSQLName(mssqlCheckConstraintName(desired.table.name, column.name)))
+				definitions[i] = definition[:loc[0]] + constraint + definition[loc[0]:]
+				break
+			}
+		}
+	}
+	return statement[:start+1] + strings.Join(definitions, ",") + statement[end:]
+}
+
+// CREATE TABLE formatted from a table, whose columns and constraints are defined like the ones added by ALTER TABLE
+func (g *Generator) generateCreateTable(table Table) (string, error) {
+	definitions := []string{}
+	checks := []CheckDefinition{}
+	for _, column := range table.columns {
+		if column.keyOption == ColumnKeyPrimary { // defined with the other columns of the primary key below
+			column.keyOption = ColumnKeyNone
+			if column.notNull == nil {
+				notNull := true
+				column.notNull = &notNull
+			}
+		}
+		if g.mode == GeneratorModeMssql && column.check != nil && column.check.constraintName == "" {
+			check := *column.check
+			check.constraintName = mssqlCheckConstraintName(table.name, column.name)
+			column.check = &check
+		}
+		if g.mode != GeneratorModeMssql && column.check != nil && column.check.constraintName != "" {
+			// A column-level check is named only in MSSQL, so a named one is defined as a table-level one
+			check := *column.check
+			check.noInherit = column.checkNoInherit
+			checks = append(checks, check)
+			column.check, column.checkNoInherit = nil, false
+		}
+		definition, err := g.generateColumnDefinition(column, true)
+		if err != nil {
+			return "", err
+		}
+		definitions = append(definitions, definition)
+	}
+	if primaryKey := table.PrimaryKey(); primaryKey != nil {
+		definitions = append(definitions, g.generateIndexDefinition(*primaryKey))
+	}
+	for _, index := range table.indexes {
+		if !index.primary {
+			definitions = append(definitions, g.generateIndexDefinition(index))
+		}
+	}
+	for _, foreignKey := range table.foreignKeys {
+		definitions = append(definitions, g.generateForeignKeyDefinition(foreignKey))
+	}
+	for _, check := range append(checks, table.checks...) {
+		definitions = append(definitions, g.generateCheckDefinition(check))
+	}
+	for _, exclusion := range table.exclusions {
+		definitions = append(definitions, g.generateExclusionDefinition(exclusion))
+	}
+
+	statement := fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", g.escapeTableName(table.name), strings.Join(definitions, ",\n  "))
+	if table.partitionDef != "" {
+		statement += " " + table.partitionDef
+	}
+	if len(table.inherits) > 0 {
+		parents := []string{}
+		for _, parent := range table.inherits {
+			parents = append(parents, g.escapeTableName(parent))
+		}
+		statement += fmt.Sprintf(" INHERITS (%s)", strings.Join(parents, ", "))
+	}
+	if len(table.storageParameters) > 0 {
+		parameters := []string{}
+		for _, name := range sortedKeys(table.storageParameters) {
+			parameters = append(parameters, fmt.Sprintf("%s = %s", name, table.storageParameters[name]))
+		}
+		statement += fmt.Sprintf(" WITH (%s)", strings.Join(parameters, ", "))
+	}
+	if table.tablespace != "" {
+		statement += " TABLESPACE " + g.escapeSQLName(table.tablespace)
+	}
+	for _, name := range sortedKeys(table.options) {
+		statement += fmt.Sprintf(" %s=%s", name, table.options[name])
+	}
+	if table.withoutRowID {
+		statement += " WITHOUT ROWID"
+	}
+	return statement, nil
+}
+
+// The index of `)` closing `(` at `start`, or -1
+func findClosingParen(text string, start int) int {
+	if start < 0 {
+		return -1
+	}
+	depth := 0
+	inString := false
+	for i := start; i < len(text); i++ {
+		switch {
+		case text[i] == '\'':
+			inString = !inString
+		case inString:
+		case text[i] == '(':
+			depth++
+		case text[i] == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Apply `GeneratorOptions` for creating indexes to CREATE INDEX given as is
+func (g *Generator) generateCreateIndexStatement(statement string) string {
+	// IF NOT EXISTS needs the index name, which Postgres doesn't require
+	if loc := createIndex.FindStringIndex(statement); loc != nil && g.ifIndexNotExists() != "" && !ifNotExists.MatchString(statement[loc[1]:]) && !unnamedIndex.MatchString(statement[loc[1]:]) {
+		statement = statement[:loc[1]] + g.ifIndexNotExists() + statement[loc[1]:]
+	}
+	switch {
+	case g.mode == GeneratorModeMssql && g.onlineIndex:
+		return statement + " WITH (ONLINE = ON)" // CREATE INDEX of MSSQL is parsed without options
+	case g.mode == GeneratorModePostgres && g.indexConcurrently:
+		if loc := createIndex.FindStringIndex(statement); loc != nil {
+			return g.nonTransactional(statement[:loc[1]] + "CONCURRENTLY " + statement[loc[1]:])
+		}
+	}
+	return statement
+}
+
+// Whether changing the type of a column may lose data. Only changes known to keep every value are safe.
+func (g *Generator) isNarrowingTypeChange(current Column, desired Column) bool {
+	if current.array != desired.array {
+		return true
+	}
+
+	currentType := g.normalizeDataType(current.typeName)
+	desiredType := g.normalizeDataType(desired.typeName)
+	if currentType == desiredType {
+		return current.length != nil && desired.length != nil && desired.length.intVal < current.length.intVal
+	}
+	if currentRank, ok := integerTypeRanks[currentType]; ok {
+		if desiredRank, ok := integerTypeRanks[desiredType]; ok {
+			return desiredRank < currentRank
+		}
+	}
+	// Any value can be stored in an unbounded string
+	return desiredType != "text" && desiredType != "longtext"
+}
+
+// Postgres converts a value among numeric types, or to a string type, by assignment casts. Other
+// conversions like `text` to `integer` are rejected by ALTER COLUMN TYPE without a USING clause.
+func needsExplicitCast(currentColumn Column, desiredColumn Column) bool {
+	if currentColumn.array || desiredColumn.array {
+		return false
+	}
+	currentCategory := postgresTypeCategory(currentColumn.typeName)
+	desiredCategory := postgresTypeCategory(desiredColumn.typeName)
+	if currentCategory == "" || desiredCategory == "" || currentCategory == desiredCategory {
+		return false
+	}
+	return desiredCategory != "string"
+}
+
+func postgresTypeCategory(typeName string) string {
+	switch strings.ToLower(typeName) {
+	case "smallint", "integer", "int", "bigint", "int2", "int4", "int8", "smallserial", "serial", "bigserial",
+		"decimal", "numeric", "real", "double precision", "float", "float4", "float8":
+		return "numeric"
+	case "text", "varchar", "character varying", "char", "character", "bpchar", "citext":
+		return "string"
+	case "boolean", "bool":
+		return "boolean"
+	default:
+		return ""
+	}
+}
+
+// ADD COLUMN for `column`, which is placed at `table.columns[i]`
+func (g *Generator) generateAddColumn(table Table, column Column, i int) (string, error) {
+	if g.mode == GeneratorModeMssql && column.check != nil && column.check.constraintName == "" {
+		check := *column.check
+		check.constraintName = mssqlCheckConstraintName(table.name, column.name)
+		column.check = &check
+	}
+	definition, err := g.generateColumnDefinition(column, true)
+	if err != nil {
+		return "", err
+	}
+
+	var ddl string
+	switch g.mode {
+	case GeneratorModeMssql:
+		ddl = fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(table.name), definition)
+	default:
+		ddl = fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", g.escapeTableName(table.name), definition)
+	}
+
+	if g.mode == GeneratorModeMysql {
+		after := " FIRST"
+		if i > 0 {
+			after = " AFTER " + g.escapeSQLName(table.columns[i-1].name)
+		}
+		ddl += after
+	}
+	return g.tableChange(ObjectColumn, table.name, column.name, OperationCreate, ddl), nil
+}
+
+func (g *Generator) generateColumnDefinition(column Column, enableUnique bool) (string, error) {
+	// TODO: make string concatenation faster?
+
+	// MSSQL computed columns have no data type
+	if g.mode == GeneratorModeMssql && column.generatedExpr != "" {
+		definition := fmt.Sprintf("%s AS (%s)", g.escapeSQLName(column.name), column.generatedExpr)
+		if column.generatedKind == "STORED" {
+			definition += " PERSISTED"
+		}
+		return definition, nil
+	}
+
+	definition := fmt.Sprintf("%s %s ", g.escapeSQLName(column.name), generateDataType(column))
+
+	if column.unsigned {
+		definition += "UNSIGNED "
+	}
+	if column.timezone {
+		definition += "WITH TIME ZONE "
+	}
+
+	// [CHARACTER SET] and [COLLATE] should be placed before [NOT NULL | NULL] on MySQL
+	if column.charset != "" {
+		definition += fmt.Sprintf("CHARACTER SET %s ", column.charset)
+	}
+	if column.collate != "" {
+		definition += fmt.Sprintf("COLLATE %s ", g.generateCollate(column.collate))
+	}
+
+	// MySQL requires a generated column expression to be placed before [NOT NULL | NULL]
+	if column.generatedExpr != "" {
+		definition += fmt.Sprintf("GENERATED ALWAYS AS (%s) %s ", column.generatedExpr, column.generatedKind)
+	}
+
+	if column.identity == "" && ((column.notNull != nil && *column.notNull) || column.keyOption == ColumnKeyPrimary) {
+		definition += "NOT NULL "
+	} else if column.notNull != nil && !*column.notNull {
+		definition += "NULL "
+	}
+
+	if column.defaultDef != nil && (column.defaultDef.value != nil || column.defaultDef.expression != "") {
+		def, err := generateDefaultDefinition(*column.defaultDef)
+		if err != nil {
+			return "", fmt.Errorf("%s in column: %#v", err.Error(), column)
+		}
+		definition += def + " "
+	}
+
+	if column.autoIncrement {
+		definition += "AUTO_INCREMENT "
+	}
+
+	if column.onUpdate != nil {
+		definition += fmt.Sprintf("ON UPDATE %s ", string(column.onUpdate.raw))
+	}
+
+	if g.mode == GeneratorModeMysql && column.comment != nil {
+		definition += fmt.Sprintf("COMMENT '%s' ", strings.ReplaceAll(column.comment.strVal, "'", "''"))
+	}
+
+	if column.check != nil {
+		if g.mode == GeneratorModeMssql && column.check.constraintName != "" {
+			definition += fmt.Sprintf("CONSTRAINT %s ", g.escapeSQLName(column.check.constraintName))
+		}
+		definition += fmt.Sprintf("CHECK (%s) ", column.check.definition)
+	}
+	if column.checkNoInherit {
+		definition += "NO INHERIT "
+	}
+
+	switch column.keyOption {
+	case ColumnKeyNone:
+		// noop
+	case ColumnKeyUnique:
+		if enableUnique {
+			definition += "UNIQUE "
+		}
+	case ColumnKeyUniqueKey:
+		if enableUnique {
+			definition += "UNIQUE KEY "
+		}
+	case ColumnKeyPrimary:
+		// noop
+	default:
+		return "", fmt.Errorf("unsupported column key (keyOption: '%d') in column: %#v", column.keyOption, column)
+	}
+
+	if column.identity != "" {
+		definition += "GENERATED " + column.identity + " AS IDENTITY "
+		if column.sequence != nil {
+			definition += "(" + generateSequenceClause(column.sequence) + ") "
 		}
 	} else if g.mode == GeneratorModeMssql && column.sequence != nil {
 		definition += fmt.Sprintf("IDENTITY(%d,%d)", *column.sequence.StartWith, *column.sequence.IncrementBy)
@@ -781,21 +2812,19 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 		clusteredOption = " NONCLUSTERED"
 	}
 
-	columns := []string{}
-	for _, indexColumn := range index.columns {
-		column := g.escapeSQLName(indexColumn.column)
-		if indexColumn.length != nil {
-			column += fmt.Sprintf("(%d)", *indexColumn.length)
-		}
-		columns = append(columns, column)
-	}
+	columns := g.generateIndexColumns(index)
+	includeDefinition := g.generateIncludeDefinition(index)
 
-	optionDefinition := g.generateIndexOptionDefinition(index.options)
+	indexOptions := append([]IndexOption{}, index.options...)
+	if g.mode == GeneratorModeMssql && g.onlineIndex {
+		indexOptions = append(indexOptions, IndexOption{optionName: "online", value: &Value{valueType: ValueTypeBool, raw: []byte("true")}})
+	}
+	optionDefinition := g.generateIndexOptionDefinition(indexOptions)
 
 	switch g.mode {
 	case GeneratorModeMssql:
 		var ddl string
-		if !index.primary {
+		if !index.primary && !index.constraint {
 			ddl = fmt.Sprintf(
 				"CREATE%s%s INDEX %s ON %s",
 				uniqueOption,
@@ -812,8 +2841,39 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 
 			ddl += fmt.Sprintf(" %s%s", index.indexType, clusteredOption)
 		}
-		ddl += fmt.Sprintf(" (%s)%s", strings.Join(columns, ", "), optionDefinition)
+		ddl += fmt.Sprintf(" (%s)%s%s", strings.Join(columns, ", "), includeDefinition, optionDefinition)
 		return ddl
+	case GeneratorModePostgres:
+		if index.constraint && !index.primary {
+			return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s UNIQUE (%s)%s%s", g.escapeTableName(table), g.escapeSQLName(index.name), strings.Join(columns, ", "), includeDefinition, optionDefinition)
+		}
+		// ALTER TABLE can't specify an access method, so it needs CREATE INDEX.
+		if index.using != "" {
+			var concurrentlyOption string
+			if g.indexConcurrently {
+				concurrentlyOption = " CONCURRENTLY"
+			}
+			ddl := fmt.Sprintf(
+				"CREATE%s INDEX%s %s%s ON %s USING %s (%s)%s%s",
+				uniqueOption,
+				concurrentlyOption,
+				g.ifIndexNotExists(),
+				g.escapeSQLName(index.name),
+				g.escapeTableName(table),
+				index.using,
+				strings.Join(columns, ", "),
+				includeDefinition,
+				optionDefinition,
+			)
+			if index.where != "" {
+				ddl += fmt.Sprintf(" WHERE %s", index.where)
+			}
+			if g.indexConcurrently {
+				return g.nonTransactional(ddl)
+			}
+			return ddl
+		}
+		fallthrough
 	default:
 		ddl := fmt.Sprintf(
 			"ALTER TABLE %s ADD %s",
@@ -824,22 +2884,78 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 		if !index.primary {
 			ddl += fmt.Sprintf(" %s", g.escapeSQLName(index.name))
 		}
-		ddl += fmt.Sprintf(" (%s)%s", strings.Join(columns, ", "), optionDefinition)
+		ddl += fmt.Sprintf(" (%s)%s%s", strings.Join(columns, ", "), includeDefinition, optionDefinition)
 		return ddl
 	}
 }
 
+func (g *Generator) generateIndexColumns(index Index) []string {
+	columns := []string{}
+	for _, indexColumn := range index.columns {
+		var column string
+		if indexColumn.expression != "" {
+			column = fmt.Sprintf("(%s)", indexColumn.expression)
+		} else {
+			column = g.escapeSQLName(indexColumn.column)
+			if indexColumn.length != nil {
+				column += fmt.Sprintf("(%d)", *indexColumn.length)
+			}
+		}
+		if indexColumn.collate != "" {
+			column += fmt.Sprintf(" COLLATE %s", g.generateCollate(indexColumn.collate))
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func (g *Generator) generateIncludeDefinition(index Index) string {
+	if len(index.includeColumns) == 0 || (g.mode != GeneratorModePostgres && g.mode != GeneratorModeMssql) {
+		return ""
+	}
+	includeColumns := []string{}
+	for _, column := range index.includeColumns {
+		includeColumns = append(includeColumns, g.escapeSQLName(column))
+	}
+	return fmt.Sprintf(" INCLUDE (%s)", strings.Join(includeColumns, ", "))
+}
+
+// An index in CREATE TABLE. A primary key and a unique constraint are named only where constraints have names.
+func (g *Generator) generateIndexDefinition(index Index) string {
+	columns := strings.Join(g.generateIndexColumns(index), ", ")
+	includeDefinition := g.generateIncludeDefinition(index)
+	switch {
+	case index.primary:
+		definition := fmt.Sprintf("PRIMARY KEY (%s)%s", columns, includeDefinition)
+		if index.name != "PRIMARY" && (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql) {
+			definition = fmt.Sprintf("CONSTRAINT %s %s", g.escapeSQLName(index.name), definition)
+		}
+		return definition
+	case g.mode == GeneratorModeMysql:
+		return fmt.Sprintf("%s %s (%s)%s", index.indexType, g.escapeSQLName(index.name), columns, g.generateIndexOptionDefinition(index.options))
+	case index.unique:
+		return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)%s", g.escapeSQLName(index.name), columns, includeDefinition)
+	default:
+		return fmt.Sprintf("INDEX %s (%s)%s", g.escapeSQLName(index.name), columns, includeDefinition)
+	}
+}
+
 func (g *Generator) generateIndexOptionDefinition(indexOptions []IndexOption) string {
 	var optionDefinition string
 	if len(indexOptions) > 0 {
 		switch g.mode {
 		case GeneratorModeMysql:
-			indexOption := indexOptions[0]
-			if indexOption.optionName == "parser" {
-				indexOption.optionName = "WITH " + indexOption.optionName
+			for _, indexOption := range indexOptions {
+				optionValue := string(indexOption.value.raw)
+				switch indexOption.optionName {
+				case "parser":
+					indexOption.optionName = "WITH " + indexOption.optionName
+				case "comment":
+					optionValue = fmt.Sprintf("'%s'", strings.ReplaceAll(indexOption.value.strVal, "'", "''"))
+				}
+				optionDefinition += fmt.Sprintf(" %s %s", indexOption.optionName, optionValue)
 			}
-			optionDefinition = fmt.Sprintf(" %s %s", indexOption.optionName, string(indexOption.value.raw))
-		case GeneratorModeMssql:
+		case GeneratorModePostgres, GeneratorModeMssql:
 			options := []string{}
 			for _, indexOption := range indexOptions {
 				var optionValue string
@@ -862,6 +2978,16 @@ func (g *Generator) generateIndexOptionDefinition(indexOptions []IndexOption) st
 	return optionDefinition
 }
 
+// Postgres collation names are case-sensitive identifiers like "C" or "en_US", so they need to be quoted.
+func (g *Generator) generateCollate(collate string) string {
+	switch g.mode {
+	case GeneratorModePostgres:
+		return g.escapeSQLName(collate)
+	default:
+		return collate
+	}
+}
+
 func (g *Generator) generateForeignKeyDefinition(foreignKey ForeignKey) string {
 	// TODO: make string concatenation faster?
 
@@ -882,7 +3008,7 @@ func (g *Generator) generateForeignKeyDefinition(foreignKey ForeignKey) string {
 
 	definition += fmt.Sprintf(
 		"(%s) REFERENCES %s (%s) ",
-		strings.Join(indexColumns, ","), g.escapeSQLName(foreignKey.referenceName),
+		strings.Join(indexColumns, ","), g.escapeTableName(foreignKey.referenceName),
 		strings.Join(referenceColumns, ","),
 	)
 
@@ -892,23 +3018,113 @@ func (g *Generator) generateForeignKeyDefinition(foreignKey ForeignKey) string {
 	if len(foreignKey.onUpdate) > 0 {
 		definition += fmt.Sprintf("ON UPDATE %s ", foreignKey.onUpdate)
 	}
+	if g.mode == GeneratorModePostgres {
+		if foreignKey.deferrable {
+			definition += "DEFERRABLE "
+		}
+		if foreignKey.initiallyDeferred {
+			definition += "INITIALLY DEFERRED "
+		}
+	}
 
 	return strings.TrimSuffix(definition, " ")
 }
 
-func (g *Generator) generateDropIndex(tableName string, indexName string) string {
+func (g *Generator) generateCheckDefinition(check CheckDefinition) string {
+	definition := fmt.Sprintf("CHECK (%s)", check.definition)
+	if check.constraintName != "" {
+		definition = fmt.Sprintf("CONSTRAINT %s %s", g.escapeSQLName(check.constraintName), definition)
+	}
+	if check.noInherit {
+		definition += " NO INHERIT"
+	}
+	return definition
+}
+
+func (g *Generator) generateExclusionDefinition(exclusion Exclusion) string {
+	elements := []string{}
+	for _, element := range exclusion.elements {
+		elements = append(elements, fmt.Sprintf("%s WITH %s", g.escapeSQLName(element.column), element.operator))
+	}
+	definition := fmt.Sprintf("CONSTRAINT %s EXCLUDE USING %s (%s)", g.escapeSQLName(exclusion.constraintName), exclusion.indexType, strings.Join(elements, ", "))
+	if exclusion.where != "" {
+		definition += fmt.Sprintf(" WHERE (%s)", exclusion.where)
+	}
+	return definition
+}
+
+func (g *Generator) generateDropIndex(tableName string, index Index) string {
 	switch g.mode {
 	case GeneratorModeMysql:
-		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s", g.escapeTableName(tableName), g.escapeSQLName(indexName))
+		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s", g.escapeTableName(tableName), g.escapeSQLName(index.name))
 	case GeneratorModePostgres:
-		return fmt.Sprintf("DROP INDEX %s", g.escapeSQLName(indexName))
+		// The index of a unique constraint is dropped with the constraint
+		if index.constraint && !index.primary {
+			return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(tableName), g.ifConstraintExists(), g.escapeSQLName(index.name))
+		}
+		if g.indexConcurrently {
+			return g.nonTransactional(fmt.Sprintf("DROP INDEX CONCURRENTLY %s%s", g.ifExists(), g.escapeSQLName(index.name)))
+		}
+		return fmt.Sprintf("DROP INDEX %s%s", g.ifExists(), g.escapeSQLName(index.name))
 	case GeneratorModeMssql:
-		return fmt.Sprintf("DROP INDEX %s ON %s", g.escapeSQLName(indexName), g.escapeTableName(tableName))
+		if index.constraint {
+			return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(tableName), g.ifConstraintExists(), g.escapeSQLName(index.name))
+		}
+		return fmt.Sprintf("DROP INDEX %s%s ON %s", g.ifExists(), g.escapeSQLName(index.name), g.escapeTableName(tableName))
 	default:
 		return ""
 	}
 }
 
+func (g *Generator) generateDropView(view View) string {
+	if view.materialized {
+		return fmt.Sprintf("DROP MATERIALIZED VIEW %s%s", g.ifExists(), g.escapeTableName(view.name))
+	}
+	return fmt.Sprintf("DROP VIEW %s%s", g.ifExists(), g.escapeTableName(view.name))
+}
+
+// SET NOT NULL of a Postgres column. With `GeneratorOptions.NotNullViaCheck`, a validated NOT VALID check proves the column
+// has no NULL, so that Postgres 12+ skips the full scan of SET NOT NULL, and VALIDATE CONSTRAINT doesn't block writes.
+// Each of them is run in its own transaction, which would hold the lock of ADD CONSTRAINT during the validation otherwise.
+// They're skipped together, so that the check isn't left behind when DROP CONSTRAINT is skipped.
+func (g *Generator) generateSetNotNull(tableName string, columnName string) []string {
+	setNotNull := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", g.escapeTableName(tableName), g.escapeSQLName(columnName))
+	if !g.notNullViaCheck {
+		return []string{setNotNull}
+	}
+	constraintName := g.escapeSQLName(fmt.Sprintf("%s_%s_not_null_check", unqualifiedName(tableName), columnName))
+	return g.atomic([]string{
+		g.nonTransactional(fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s IS NOT NULL) NOT VALID", g.escapeTableName(tableName), constraintName, g.escapeSQLName(columnName))),
+		g.nonTransactional(fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", g.escapeTableName(tableName), constraintName)),
+		g.nonTransactional(setNotNull),
+		g.nonTransactional(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s%s", g.escapeTableName(tableName), g.ifConstraintExists(), constraintName)),
+	})
+}
+
+// `IF EXISTS ` of DROP statements with `GeneratorOptions.UseIfExists`. All databases support it for tables and views.
+func (g *Generator) ifExists() string {
+	if g.useIfExists {
+		return "IF EXISTS "
+	}
+	return ""
+}
+
+// `IF EXISTS ` of DROP CONSTRAINT with `GeneratorOptions.UseIfExists`, which only Postgres and MSSQL support
+func (g *Generator) ifConstraintExists() string {
+	if g.useIfExists && (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql) {
+		return "IF EXISTS "
+	}
+	return ""
+}
+
+// `IF NOT EXISTS ` of CREATE INDEX with `GeneratorOptions.UseIfExists`, which MySQL and MSSQL don't support
+func (g *Generator) ifIndexNotExists() string {
+	if g.useIfExists && (g.mode == GeneratorModePostgres || g.mode == GeneratorModeSQLite3) {
+		return "IF NOT EXISTS "
+	}
+	return ""
+}
+
 func (g *Generator) escapeTableName(name string) string {
 	switch g.mode {
 	case GeneratorModePostgres, GeneratorModeMssql:
@@ -932,6 +3148,9 @@ func (g *Generator) escapeTableName(name string) string {
 }
 
 func (g *Generator) escapeSQLName(name string) string {
+	if g.identifierQuoting == QuoteWhenNecessary && !needsQuote(g.mode, name) {
+		return name
+	}
 	switch g.mode {
 	case GeneratorModePostgres:
 		return fmt.Sprintf("\"%s\"", name)
@@ -946,7 +3165,12 @@ func (g *Generator) notNull(column Column) bool {
 	if column.notNull == nil {
 		switch g.mode {
 		case GeneratorModePostgres:
-			return column.typeName == "serial" || column.typeName == "bigserial"
+			switch g.normalizeDataType(column.typeName) {
+			case "smallserial", "serial", "bigserial":
+				return true
+			default:
+				return false
+			}
 		default:
 			return false
 		}
@@ -969,97 +3193,634 @@ func isPrimaryKey(column Column, table Table) bool {
 			}
 		}
 	}
-	return false
+	return false
+}
+
+func hasPrimaryKeyIndex(table Table) bool {
+	for _, index := range table.indexes {
+		if index.primary {
+			return true
+		}
+	}
+	return false
+}
+
+// Destructively modify table to have the primary key added by ALTER TABLE
+func addPrimaryKey(table *Table, index Index) {
+	newColumns := []Column{}
+	for _, column := range table.columns {
+		for _, indexColumn := range index.columns {
+			if column.name == indexColumn.column {
+				column.keyOption = ColumnKeyPrimary
+			}
+		}
+		newColumns = append(newColumns, column)
+	}
+	table.columns = newColumns
+
+	// Keep the order of a multi-column primary key, which may differ from the order of columns.
+	if len(index.columns) > 1 && !hasPrimaryKeyIndex(*table) {
+		index.primary = true
+		index.unique = true
+		table.indexes = append(append([]Index{}, table.indexes...), index)
+	}
+}
+
+// The desired table with the primary key added by ALTER TABLE, to be compared with the current one
+func withAddedPrimaryKeys(table Table, ddls []DDL) Table {
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*AddPrimaryKey); ok && stmt.tableName == table.name {
+			addPrimaryKey(&table, stmt.index)
+		}
+	}
+	return table
+}
+
+// Destructively modify table1 to have table2 columns/indexes
+func mergeTable(table1 *Table, table2 Table) {
+	for _, column := range table2.columns {
+		if containsString(convertColumnsToColumnNames(table1.columns), column.name) {
+			table1.columns = append(table1.columns, column)
+		}
+	}
+
+	for _, index := range table2.indexes {
+		if containsString(convertIndexesToIndexNames(table1.indexes), index.name) {
+			table1.indexes = append(table1.indexes, index)
+		}
+	}
+}
+
+func convertDDLsToTables(mode GeneratorMode, ddls []DDL, views []*View) ([]*Table, error) {
+	tables := []*Table{}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			table := stmt.table // copy table
+			tables = append(tables, &table)
+		case *CreateIndex:
+			if findViewByTableName(mode, views, stmt.tableName) != nil {
+				continue // attached to the view by convertDDLsToViews
+			}
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			// TODO: check duplicated creation
+			table.indexes = append(table.indexes, stmt.index)
+		case *AddPrimaryKey:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			addPrimaryKey(table, stmt.index)
+		case *AddForeignKey:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			table.foreignKeys = append(table.foreignKeys, stmt.foreignKey)
+		case *AddPolicy:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			table.policies = append(table.policies, stmt.policy)
+		case *CommentOnColumn:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("COMMENT ON COLUMN is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			setColumnComment(table, stmt.columnName, stmt.comment)
+		case *CommentOnTable:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("COMMENT ON TABLE is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			table.comment = stmt.comment
+		case *ClusterOn:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, fmt.Errorf("CLUSTER ON is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			table.clusterIndex = stmt.indexName
+		case *View, *CreateType, *CreateDomain, *Function, *Trigger:
+			// do nothing
+		default:
+			return nil, fmt.Errorf("unexpected ddl type in convertDDLsToTables: %v", stmt)
+		}
+	}
+	return tables, nil
+}
+
+// Reorder DDLs so that an object is created after the objects it refers to.
+// The given order is kept as much as possible. DDLs in or after circular dependencies are returned separately, kept as is.
+func (g *Generator) sortDDLsByDependency(ddls []DDL) ([]DDL, []DDL) {
+	// Dependencies on objects that are not created by `ddls` are not examined.
+	defined := map[string]bool{}
+	for _, ddl := range ddls {
+		if name := g.definedObjectName(ddl); name != "" {
+			defined[name] = true
+		}
+	}
+
+	sorted := []DDL{}
+	created := map[string]bool{}
+	remaining := ddls
+	for len(remaining) > 0 {
+		pending := []DDL{}
+		for _, ddl := range remaining {
+			if g.hasUnresolvedDependency(ddl, defined, created) {
+				pending = append(pending, ddl)
+				continue
+			}
+			sorted = append(sorted, ddl)
+			if name := g.definedObjectName(ddl); name != "" {
+				created[name] = true
+			}
+		}
+		if len(pending) == len(remaining) { // circular dependency
+			// Existing tables can be referenced before they're altered, which breaks the cycle.
+			brokeCycle := false
+			for _, table := range g.currentTables {
+				if defined[table.name] && !created[table.name] {
+					created[table.name] = true
+					brokeCycle = true
+				}
+			}
+			if !brokeCycle {
+				return sorted, pending
+			}
+		}
+		remaining = pending
+	}
+	return sorted, nil
+}
+
+// Names of tables which are created by `ddls` before the tables they reference are created
+func (g *Generator) findPrematureTables(ddls []DDL) []string {
+	defined := map[string]bool{}
+	for _, ddl := range ddls {
+		if createTable, ok := ddl.(*CreateTable); ok && findTableByName(g.currentTables, createTable.table.name) == nil {
+			defined[createTable.table.name] = true
+		}
+	}
+
+	tables := []string{}
+	created := map[string]bool{}
+	for _, ddl := range ddls {
+		if createTable, ok := ddl.(*CreateTable); ok && defined[createTable.table.name] {
+			if g.hasUnresolvedDependency(ddl, defined, created) {
+				tables = append(tables, createTable.table.name)
+			}
+			created[createTable.table.name] = true
+		}
+	}
+	return tables
+}
+
+// Name of a table, a view, a type or a function created by the DDL
+func (g *Generator) definedObjectName(ddl DDL) string {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		return stmt.table.name
+	case *View:
+		return g.normalizeObjectName(stmt.name)
+	case *CreateType:
+		return stmt.name
+	case *CreateDomain:
+		return stmt.name
+	case *Function:
+		return stmt.name
+	default:
+		return ""
+	}
+}
+
+func (g *Generator) hasUnresolvedDependency(ddl DDL, defined map[string]bool, created map[string]bool) bool {
+	name := g.definedObjectName(ddl)
+	for _, dependency := range g.objectDependencies(ddl) {
+		if dependency != name && defined[dependency] && !created[dependency] {
+			return true
+		}
+	}
+	return false
+}
+
+// Names of tables, views, types and functions that need to exist before the DDL is executed
+func (g *Generator) objectDependencies(ddl DDL) []string {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		dependencies := []string{}
+		for _, column := range stmt.table.columns {
+			if column.references != "" {
+				dependencies = append(dependencies, g.normalizeObjectName(column.references))
+			}
+			if g.mode == GeneratorModePostgres {
+				dependencies = append(dependencies, g.normalizeObjectName(column.typeName)) // may be created by CREATE TYPE or CREATE DOMAIN
+				dependencies = append(dependencies, g.columnFunctionDependencies(column)...)
+			}
+		}
+		for _, foreignKey := range stmt.table.foreignKeys {
+			dependencies = append(dependencies, g.normalizeObjectName(foreignKey.referenceName))
+		}
+		if g.mode == GeneratorModePostgres {
+			for _, check := range stmt.table.checks {
+				dependencies = append(dependencies, calledFunctionNames(check.definition)...)
+			}
+		}
+		return append(dependencies, stmt.table.inherits...)
+	case *CreateIndex:
+		return []string{stmt.tableName}
+	case *AddIndex:
+		return []string{stmt.tableName}
+	case *AddPrimaryKey:
+		return []string{stmt.tableName}
+	case *AddForeignKey:
+		return []string{stmt.tableName, g.normalizeObjectName(stmt.foreignKey.referenceName)}
+	case *AddPolicy:
+		return []string{stmt.tableName}
+	case *CommentOnColumn:
+		return []string{stmt.tableName}
+	case *CommentOnTable:
+		return []string{stmt.tableName}
+	case *ClusterOn:
+		return []string{stmt.tableName}
+	case *View:
+		if g.mode == GeneratorModePostgres {
+			return append(calledFunctionNames(stmt.definition), stmt.dependencies...)
+		}
+		return stmt.dependencies
+	case *CreateDomain:
+		dependencies := g.columnFunctionDependencies(stmt.definition)
+		for _, check := range stmt.checks {
+			dependencies = append(dependencies, calledFunctionNames(check.definition)...)
+		}
+		return dependencies
+	case *Function:
+		// Types of arguments and a returned type, which may be created by CREATE TYPE, CREATE DOMAIN or CREATE TABLE
+		dependencies := []string{}
+		for _, text := range append([]string{stmt.returns}, stmt.arguments...) {
+			text = functionArgumentDefault.ReplaceAllString(text, "")
+			for _, word := range strings.Fields(strings.TrimPrefix(text, "setof ")) {
+				dependencies = append(dependencies, normalizePostgresObjectName(strings.TrimSuffix(word, "[]")))
+			}
+		}
+		return dependencies
+	case *Trigger:
+		if g.mode == GeneratorModePostgres {
+			return append(calledFunctionNames(stmt.body), stmt.tableName)
+		}
+		return []string{stmt.tableName}
+	default:
+		return nil
+	}
+}
+
+// Functions called by the default, the check or the generated expression of a column
+func (g *Generator) columnFunctionDependencies(column Column) []string {
+	dependencies := []string{}
+	if column.defaultDef != nil {
+		dependencies = append(dependencies, calledFunctionNames(column.defaultDef.expression)...)
+	}
+	if column.check != nil {
+		dependencies = append(dependencies, calledFunctionNames(column.check.definition)...)
+	}
+	return append(dependencies, calledFunctionNames(column.generatedExpr)...)
+}
+
+// Names of functions called like `f(...)` in an expression, which is kept as a string
+func calledFunctionNames(expr string) []string {
+	names := []string{}
+	for _, match := range functionCall.FindAllStringSubmatch(expr, -1) {
+		names = append(names, normalizePostgresObjectName(match[1]))
+	}
+	return names
+}
+
+// Qualify an unqualified name like `Table.name`
+func (g *Generator) normalizeObjectName(name string) string {
+	if g.mode == GeneratorModePostgres && !strings.Contains(name, ".") {
+		return "public." + name
+	}
+	return name
+}
+
+// Compile regexps of table names, each of which must match a whole name
+func compileTablePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexps := []*regexp.Regexp{}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of table names '%s': %w", pattern, err)
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// Whether the table is managed under `GeneratorOptions.TargetTables` and `GeneratorOptions.SkipTables`.
+// A pattern matches either a schema-qualified name or a bare one.
+func (g *Generator) isTargetTable(name string) bool {
+	if matchesTablePatterns(g.skipTables, name) {
+		return false
+	}
+	return len(g.targetTables) == 0 || matchesTablePatterns(g.targetTables, name)
+}
+
+func matchesTablePatterns(regexps []*regexp.Regexp, name string) bool {
+	bareName := name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		bareName = name[i+1:]
+	}
+	for _, re := range regexps {
+		if re.MatchString(name) || re.MatchString(bareName) {
+			return true
+		}
+	}
+	return false
+}
+
+// Statements of tables which are not managed are ignored. Ones not belonging to a table, like views, are always managed.
+func (g *Generator) isTargetDDL(ddl DDL) bool {
+	name := ddlTableName(ddl)
+	if name == "" {
+		return true
+	}
+	if g.isViewName(name) {
+		return true // an index of a materialized view, or a trigger of a view
+	}
+	return g.isTargetTable(name)
+}
+
+func (g *Generator) isViewName(name string) bool {
+	return findViewByTableName(g.mode, g.desiredViews, name) != nil || findViewByTableName(g.mode, g.currentViews, name) != nil
+}
+
+// The sequence which a Postgres column takes its default from: `schema.table_column_seq` of serial, or the one of `DEFAULT nextval('seq'::regclass)`.
+// Postgres stores serial as the latter, so both forms are the same if they use the same sequence.
+func (g *Generator) sequenceOfDefault(tableName string, column Column) string {
+	if column.defaultDef != nil {
+		if match := nextvalDefault.FindStringSubmatch(column.defaultDef.expression); match != nil {
+			return normalizePostgresObjectName(match[1])
+		}
+		return ""
+	}
+	if !isSerialType(g.normalizeDataType(column.typeName)) || column.array {
+		return ""
+	}
+	schemaName, name := "public", tableName
+	if i := strings.LastIndex(tableName, "."); i >= 0 {
+		schemaName, name = tableName[:i], tableName[i+1:]
+	}
+	return schemaName + "." + name + "_" + column.name + "_seq"
+}
+
+func isSerialType(typeName string) bool {
+	return typeName == "smallserial" || typeName == "serial" || typeName == "bigserial"
+}
+
+// The name sqldef gives to a MSSQL column check without a name
+func mssqlCheckConstraintName(tableName string, columnName string) string {
+	return fmt.Sprintf("%s_%s_check", unqualifiedName(tableName), columnName)
+}
+
+// Name of the table which the DDL creates or modifies
+func ddlTableName(ddl DDL) string {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		return stmt.table.name
+	case *CreateIndex:
+		return stmt.tableName
+	case *AddIndex:
+		return stmt.tableName
+	case *AddPrimaryKey:
+		return stmt.tableName
+	case *AddForeignKey:
+		return stmt.tableName
+	case *AddPolicy:
+		return stmt.tableName
+	case *CommentOnColumn:
+		return stmt.tableName
+	case *CommentOnTable:
+		return stmt.tableName
+	case *ClusterOn:
+		return stmt.tableName
+	case *Trigger:
+		return stmt.tableName
+	default:
+		return ""
+	}
+}
+
+func convertDDLsToViews(mode GeneratorMode, ddls []DDL) []*View {
+	var views []*View
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *View:
+			views = append(views, stmt)
+		case *CreateIndex:
+			if view := findViewByTableName(mode, views, stmt.tableName); view != nil && view.materialized {
+				view.indexes = append(view.indexes, stmt.index)
+			}
+		}
+	}
+	return views
+}
+
+func convertDDLsToTypes(ddls []DDL) []*CreateType {
+	var types []*CreateType
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateType); ok {
+			types = append(types, stmt)
+		}
+	}
+	return types
+}
+
+func convertDDLsToFunctions(ddls []DDL) []*Function {
+	var functions []*Function
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*Function); ok {
+			functions = append(functions, stmt)
+		}
+	}
+	return functions
+}
+
+func convertDDLsToTriggers(ddls []DDL) []*Trigger {
+	var triggers []*Trigger
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*Trigger); ok {
+			triggers = append(triggers, stmt)
+		}
+	}
+	return triggers
+}
+
+func convertDDLsToDomains(ddls []DDL) []*CreateDomain {
+	var domains []*CreateDomain
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateDomain); ok {
+			domains = append(domains, stmt)
+		}
+	}
+	return domains
+}
+
+func findTableByName(tables []*Table, name string) *Table {
+	for _, table := range tables {
+		if table.name == name {
+			return table
+		}
+	}
+	return nil
+}
+
+// A desired check is identified by its name, or by its definition if it has no name.
+func findCheckByDesiredCheck(currentChecks []CheckDefinition, desiredCheck CheckDefinition) *CheckDefinition {
+	for _, currentCheck := range currentChecks {
+		if isSameCheck(currentCheck, desiredCheck) {
+			return &currentCheck
+		}
+	}
+	return nil
+}
+
+func findDesiredCheckByCurrentCheck(desiredChecks []CheckDefinition, currentCheck CheckDefinition) *CheckDefinition {
+	for _, desiredCheck := range desiredChecks {
+		if isSameCheck(currentCheck, desiredCheck) {
+			return &desiredCheck
+		}
+	}
+	return nil
+}
+
+// Find a current check which has the same definition as a desired check of another name, and which is not desired anymore.
+func findRenamedCheck(currentChecks []CheckDefinition, desiredChecks []CheckDefinition, desiredCheck CheckDefinition) *CheckDefinition {
+	if desiredCheck.constraintName == "" {
+		return nil
+	}
+	for _, currentCheck := range currentChecks {
+		if areSameTableChecks(currentCheck, desiredCheck) && findDesiredCheckByCurrentCheck(desiredChecks, currentCheck) == nil {
+			return &currentCheck
+		}
+	}
+	return nil
 }
 
-// Destructively modify table1 to have table2 columns/indexes
-func mergeTable(table1 *Table, table2 Table) {
-	for _, column := range table2.columns {
-		if containsString(convertColumnsToColumnNames(table1.columns), column.name) {
-			table1.columns = append(table1.columns, column)
+func isCheckRenamed(currentChecks []CheckDefinition, desiredChecks []CheckDefinition, currentCheck CheckDefinition) bool {
+	for _, desiredCheck := range desiredChecks {
+		if findCheckByDesiredCheck(currentChecks, desiredCheck) != nil {
+			continue
+		}
+		if renamedCheck := findRenamedCheck(currentChecks, desiredChecks, desiredCheck); renamedCheck != nil && renamedCheck.constraintName == currentCheck.constraintName {
+			return true
 		}
 	}
+	return false
+}
 
-	for _, index := range table2.indexes {
-		if containsString(convertIndexesToIndexNames(table1.indexes), index.name) {
-			table1.indexes = append(table1.indexes, index)
+func isSameCheck(currentCheck CheckDefinition, desiredCheck CheckDefinition) bool {
+	if desiredCheck.constraintName != "" {
+		return currentCheck.constraintName == desiredCheck.constraintName
+	}
+	return currentCheck.definition == desiredCheck.definition
+}
+
+func findColumnByName(columns []Column, name string) *Column {
+	for _, column := range columns {
+		if column.name == name {
+			return &column
 		}
 	}
+	return nil
 }
 
-func convertDDLsToTables(ddls []DDL) ([]*Table, error) {
-	tables := []*Table{}
-	for _, ddl := range ddls {
-		switch stmt := ddl.(type) {
-		case *CreateTable:
-			table := stmt.table // copy table
-			tables = append(tables, &table)
-		case *CreateIndex:
-			table := findTableByName(tables, stmt.tableName)
-			if table == nil {
-				return nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+// Simulate renaming a column, which also renames it in the indexes and foreign keys of the table
+func renameColumn(table *Table, oldName string, newName string) {
+	for i := range table.columns {
+		if table.columns[i].name == oldName {
+			table.columns[i].name = newName
+		}
+	}
+	for i := range table.indexes {
+		for j := range table.indexes[i].columns {
+			if table.indexes[i].columns[j].column == oldName {
+				table.indexes[i].columns[j].column = newName
 			}
-			// TODO: check duplicated creation
-			table.indexes = append(table.indexes, stmt.index)
-		case *AddPrimaryKey:
-			table := findTableByName(tables, stmt.tableName)
-			if table == nil {
-				return nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
+		}
+	}
+	for i := range table.foreignKeys {
+		for j := range table.foreignKeys[i].indexColumns {
+			if table.foreignKeys[i].indexColumns[j] == oldName {
+				table.foreignKeys[i].indexColumns[j] = newName
 			}
+		}
+	}
+}
 
-			newColumns := []Column{}
-			for _, column := range table.columns {
-				if column.name == stmt.index.columns[0].column { // TODO: multi-column primary key?
-					column.keyOption = ColumnKeyPrimary
-				}
-				newColumns = append(newColumns, column)
-			}
-			table.columns = newColumns
-		case *AddForeignKey:
-			table := findTableByName(tables, stmt.tableName)
-			if table == nil {
-				return nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
-			}
+// Returns false if the column is not found
+func setColumnComment(table *Table, columnName string, comment *Value) bool {
+	for i := range table.columns {
+		if table.columns[i].name == columnName {
+			table.columns[i].comment = comment
+			return true
+		}
+	}
+	return false
+}
 
-			table.foreignKeys = append(table.foreignKeys, stmt.foreignKey)
-		case *AddPolicy:
-			table := findTableByName(tables, stmt.tableName)
-			if table == nil {
-				return nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
+// Whether `name` is right after `previous` in `columnNames`, or the first one if `previous` is empty
+func isColumnPlacedAfter(columnNames []string, name string, previous string) bool {
+	for i, columnName := range columnNames {
+		if columnName == name {
+			if i == 0 {
+				return previous == ""
 			}
-
-			table.policies = append(table.policies, stmt.policy)
-		case *View:
-			// do nothing
-		default:
-			return nil, fmt.Errorf("unexpected ddl type in convertDDLsToTables: %v", stmt)
+			return columnNames[i-1] == previous
 		}
 	}
-	return tables, nil
+	return false
 }
 
-func convertDDLsToViews(ddls []DDL) []*View {
-	var views []*View
-	for _, ddl := range ddls {
-		if view, ok := ddl.(*View); ok {
-			views = append(views, view)
+// Insert `name` right after `previous` in `columnNames`, or at first if `previous` is empty
+func placeColumnAfter(columnNames []string, name string, previous string) []string {
+	position := 0
+	for i, columnName := range columnNames {
+		if columnName == previous {
+			position = i + 1
+			break
 		}
 	}
-	return views
+	result := append([]string{}, columnNames[:position]...)
+	result = append(result, name)
+	return append(result, columnNames[position:]...)
 }
 
-func findTableByName(tables []*Table, name string) *Table {
-	for _, table := range tables {
-		if table.name == name {
-			return table
-		}
+// Find a current column for a desired column, following `@renamed` annotation.
+func findCurrentColumn(currentColumns []Column, desiredColumn Column) *Column {
+	if column := findColumnByName(currentColumns, desiredColumn.name); column != nil {
+		return column
+	}
+	if desiredColumn.renamedFrom != "" {
+		return findColumnByName(currentColumns, desiredColumn.renamedFrom)
 	}
 	return nil
 }
 
-func findColumnByName(columns []Column, name string) *Column {
-	for _, column := range columns {
-		if column.name == name {
+// Find a desired column for a current column, which may be renamed by `@renamed` annotation.
+func findDesiredColumn(desiredColumns []Column, currentColumn Column) *Column {
+	if column := findColumnByName(desiredColumns, currentColumn.name); column != nil {
+		return column
+	}
+	for _, column := range desiredColumns {
+		if column.renamedFrom == currentColumn.name {
 			return &column
 		}
 	}
@@ -1075,6 +3836,15 @@ func findIndexByName(indexes []Index, name string) *Index {
 	return nil
 }
 
+func findExclusionByName(exclusions []Exclusion, constraintName string) *Exclusion {
+	for _, exclusion := range exclusions {
+		if exclusion.constraintName == constraintName {
+			return &exclusion
+		}
+	}
+	return nil
+}
+
 func findIndexOptionByName(options []IndexOption, name string) *IndexOption {
 	for _, option := range options {
 		if option.optionName == name {
@@ -1111,6 +3881,85 @@ func findPolicyByName(policies []Policy, name string) *Policy {
 	return nil
 }
 
+func findTypeByName(types []*CreateType, name string) *CreateType {
+	for _, createType := range types {
+		if createType.name == name {
+			return createType
+		}
+	}
+	return nil
+}
+
+// Postgres trigger names are unique in a table, and the others' are unique in a schema
+func (g *Generator) findTriggerByName(triggers []*Trigger, name string, tableName string) *Trigger {
+	for _, trigger := range triggers {
+		if trigger.name == name && (g.mode != GeneratorModePostgres || trigger.tableName == tableName) {
+			return trigger
+		}
+	}
+	return nil
+}
+
+func (g *Generator) isDesiredTableOrView(name string) bool {
+	if findTableByName(g.desiredTables, name) != nil {
+		return true
+	}
+	for _, view := range g.desiredViews {
+		if g.normalizeObjectName(view.name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func findFunctionBySignature(functions []*Function, signature string) *Function {
+	for _, function := range functions {
+		if function.signature() == signature {
+			return function
+		}
+	}
+	return nil
+}
+
+// Give unnamed checks of a domain the names PostgreSQL chooses for them
+func nameDomainChecks(domainName string, checks []CheckDefinition) []CheckDefinition {
+	defaultName := domainName
+	if i := strings.LastIndex(domainName, "."); i >= 0 {
+		defaultName = domainName[i+1:]
+	}
+	defaultName += "_check"
+
+	named := []CheckDefinition{}
+	for _, check := range checks {
+		if check.constraintName == "" {
+			check.constraintName = defaultName
+			for suffix := 1; findCheckByName(checks, check.constraintName) != nil || findCheckByName(named, check.constraintName) != nil; suffix++ {
+				check.constraintName = fmt.Sprintf("%s%d", defaultName, suffix)
+			}
+		}
+		named = append(named, check)
+	}
+	return named
+}
+
+func findCheckByName(checks []CheckDefinition, name string) *CheckDefinition {
+	for i := range checks {
+		if checks[i].constraintName == name {
+			return &checks[i]
+		}
+	}
+	return nil
+}
+
+func findDomainByName(domains []*CreateDomain, name string) *CreateDomain {
+	for _, domain := range domains {
+		if domain.name == name {
+			return domain
+		}
+	}
+	return nil
+}
+
 func findViewByName(views []*View, name string) *View {
 	for _, view := range views {
 		if view.name == name {
@@ -1119,23 +3968,92 @@ func findViewByName(views []*View, name string) *View {
 	}
 	return nil
 }
+
+// Find a view by a table name of CREATE INDEX, which is schema-qualified in Postgres
+func findViewByTableName(mode GeneratorMode, views []*View, tableName string) *View {
+	for _, view := range views {
+		name := view.name
+		if mode == GeneratorModePostgres && !strings.Contains(name, ".") {
+			name = "public." + name
+		}
+		if name == tableName {
+			return view
+		}
+	}
+	return nil
+}
 func (g *Generator) haveSameColumnDefinition(current Column, desired Column) bool {
-	// Not examining AUTO_INCREMENT and UNIQUE KEY because it'll be added in a later stage
+	// Not examining AUTO_INCREMENT, UNIQUE KEY and CHECK because it'll be added in a later stage
 	return g.haveSameDataType(current, desired) &&
 		(current.unsigned == desired.unsigned) &&
 		((current.notNull != nil && *current.notNull) == ((desired.notNull != nil && *desired.notNull) || desired.keyOption == ColumnKeyPrimary)) && // `PRIMARY KEY` implies `NOT NULL`
 		(current.timezone == desired.timezone) &&
-		(current.check == desired.check) &&
-		(desired.charset == "" || current.charset == desired.charset) && // detect change column only when set explicitly. TODO: can we calculate implicit charset?
-		(desired.collate == "" || current.collate == desired.collate) && // detect change column only when set explicitly. TODO: can we calculate implicit collate?
-		reflect.DeepEqual(current.onUpdate, desired.onUpdate)
+		(desired.charset == "" || strings.EqualFold(normalizeUtf8(current.charset), normalizeUtf8(desired.charset))) && // detect change column only when it's known. See resolveColumnCharsets.
+		(desired.collate == "" || strings.EqualFold(normalizeUtf8(current.collate), normalizeUtf8(desired.collate))) && // same as charset
+		areSameOnUpdateValue(current.onUpdate, desired.onUpdate) &&
+		areSameValue(current.comment, desired.comment) &&
+		areSameGeneratedColumn(current, desired)
 }
 
 func (g *Generator) haveSameDataType(current Column, desired Column) bool {
-	return g.normalizeDataType(current.typeName) == g.normalizeDataType(desired.typeName) &&
+	currentType, desiredType := g.normalizeDataType(current.typeName), g.normalizeDataType(desired.typeName)
+	if g.mode == GeneratorModePostgres {
+		// serial is an integer column with a sequence default, which is compared separately. See sequenceOfDefault.
+		if baseType, ok := serialBaseTypes[currentType]; ok {
+			currentType = baseType
+		}
+		if baseType, ok := serialBaseTypes[desiredType]; ok {
+			desiredType = baseType
+		}
+	}
+	return currentType == desiredType &&
 		(current.length == nil || desired.length == nil || current.length.intVal == desired.length.intVal) && // detect change column only when both are set explicitly. TODO: maybe `current.length == nil` case needs another care
-		current.array == desired.array
-	// TODO: scale
+		(current.scale == nil || desired.scale == nil || current.scale.intVal == desired.scale.intVal) && // same as length
+		current.array == desired.array &&
+		areSameEnumValues(current.enumValues, desired.enumValues)
+}
+
+// Values of ENUM and SET are compared in order since it changes how they're sorted and stored
+func areSameEnumValues(currentValues []string, desiredValues []string) bool {
+	if len(currentValues) != len(desiredValues) {
+		return false
+	}
+	for i, value := range currentValues {
+		if value != desiredValues[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Postgres 13+ turns a generated column into a regular one keeping its values by DROP EXPRESSION
+func (g *Generator) canDropGeneration(current Column, desired Column) bool {
+	return g.mode == GeneratorModePostgres && current.generatedExpr != "" && desired.generatedExpr == ""
+}
+
+func areSameGeneratedColumn(current Column, desired Column) bool {
+	return normalizeGeneratedExpr(current.generatedExpr) == normalizeGeneratedExpr(desired.generatedExpr) &&
+		current.generatedKind == desired.generatedKind
+}
+
+func normalizeGeneratedExpr(expr string) string {
+	return strings.Join(strings.Fields(expr), " ")
+}
+
+func areSameTableChecks(checkA CheckDefinition, checkB CheckDefinition) bool {
+	return checkA.definition == checkB.definition && checkA.noInherit == checkB.noInherit
+}
+
+func areSameExclusions(exclusionA Exclusion, exclusionB Exclusion) bool {
+	if exclusionA.indexType != exclusionB.indexType || exclusionA.where != exclusionB.where || len(exclusionA.elements) != len(exclusionB.elements) {
+		return false
+	}
+	for i := range exclusionA.elements {
+		if exclusionA.elements[i] != exclusionB.elements[i] {
+			return false
+		}
+	}
+	return true
 }
 
 func areSameCheckDefinition(checkA *CheckDefinition, checkB *CheckDefinition) bool {
@@ -1149,6 +4067,17 @@ func areSameCheckDefinition(checkA *CheckDefinition, checkB *CheckDefinition) bo
 }
 
 func areSameDefaultValue(currentDefault *DefaultDefinition, desiredDefault *DefaultDefinition) bool {
+	var currentExpr, desiredExpr string
+	if currentDefault != nil {
+		currentExpr = currentDefault.comparable
+	}
+	if desiredDefault != nil {
+		desiredExpr = desiredDefault.comparable
+	}
+	if currentExpr != desiredExpr {
+		return false
+	}
+
 	var current *Value
 	var desired *Value
 	if currentDefault != nil && !isNullValue(currentDefault.value) {
@@ -1169,19 +4098,63 @@ func areSameValue(current, desired *Value) bool {
 		return false
 	}
 
+	// A default of a BIT column is given like 1, '1' or b'1', and MySQL shows it like b'1'
+	if current.valueType == ValueTypeBit || desired.valueType == ValueTypeBit {
+		currentBits, currentOk := parseBitValue(current)
+		desiredBits, desiredOk := parseBitValue(desired)
+		if currentOk && desiredOk {
+			return currentBits == desiredBits
+		}
+	}
+
 	// NOTE: -1 can be changed to '-1' in show create table and valueType is not reliable
-	currentRaw := string(current.raw)
-	desiredRaw := string(desired.raw)
-	if desired.valueType == ValueTypeFloat && len(currentRaw) > len(desiredRaw) {
-		// Round "0.00" to "0.0" for comparison with desired.
-		// Ideally we should do this seeing precision in a data type.
-		currentRaw = currentRaw[0:len(desiredRaw)]
+	currentRaw := normalizeValueRaw(current)
+	desiredRaw := normalizeValueRaw(desired)
+	if desired.valueType == ValueTypeInt || desired.valueType == ValueTypeFloat {
+		// Compare numbers by value, e.g. "-2.00" of a decimal column is the same as -2.
+		currentNum, currentErr := strconv.ParseFloat(currentRaw, 64)
+		desiredNum, desiredErr := strconv.ParseFloat(desiredRaw, 64)
+		if currentErr == nil && desiredErr == nil {
+			return currentNum == desiredNum
+		}
 	}
 	return currentRaw == desiredRaw
 }
 
+// The number a value means as a default of a BIT column
+func parseBitValue(value *Value) (uint64, bool) {
+	base := 10
+	if value.valueType == ValueTypeBit {
+		base = 2
+	}
+	bits, err := strconv.ParseUint(string(value.raw), base, 64)
+	return bits, err == nil
+}
+
+// `ON UPDATE CURRENT_TIMESTAMP(0)` is shown as `ON UPDATE CURRENT_TIMESTAMP` by MySQL
+func areSameOnUpdateValue(current, desired *Value) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return normalizeOnUpdateValue(current) == normalizeOnUpdateValue(desired)
+}
+
+func normalizeOnUpdateValue(value *Value) string {
+	return defaultTimestampPrecision.ReplaceAllString(strings.ToLower(string(value.raw)), "")
+}
+
+// Keywords like CURRENT_TIMESTAMP, NULL and TRUE are case-insensitive
+func normalizeValueRaw(value *Value) string {
+	switch value.valueType {
+	case ValueTypeValArg, ValueTypeBool:
+		return strings.ToLower(string(value.raw))
+	default:
+		return string(value.raw)
+	}
+}
+
 func isNullValue(value *Value) bool {
-	return value != nil && value.valueType == ValueTypeValArg && string(value.raw) == "null"
+	return value != nil && value.valueType == ValueTypeValArg && normalizeValueRaw(value) == "null"
 }
 
 func (g *Generator) normalizeDataType(dataType string) string {
@@ -1195,9 +4168,30 @@ func (g *Generator) normalizeDataType(dataType string) string {
 			dataType = alias
 		}
 	}
+	if g.mode == GeneratorModeSQLite3 {
+		dataType = sqlite3TypeAffinity(dataType)
+	}
 	return dataType
 }
 
+// SQLite stores a value by the affinity of the declared type, so types of the same affinity are not changed.
+// https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func sqlite3TypeAffinity(dataType string) string {
+	upper := strings.ToUpper(dataType)
+	switch {
+	case strings.Contains(upper, "INT"):
+		return "integer"
+	case strings.Contains(upper, "CHAR"), strings.Contains(upper, "CLOB"), strings.Contains(upper, "TEXT"):
+		return "text"
+	case strings.Contains(upper, "BLOB"), upper == "":
+		return "blob"
+	case strings.Contains(upper, "REAL"), strings.Contains(upper, "FLOA"), strings.Contains(upper, "DOUB"):
+		return "real"
+	default:
+		return "numeric"
+	}
+}
+
 func areSamePrimaryKeys(primaryKeyA *Index, primaryKeyB *Index) bool {
 	if primaryKeyA != nil && primaryKeyB != nil {
 		return areSameIndexes(*primaryKeyA, *primaryKeyB)
@@ -1218,13 +4212,27 @@ func areSameIndexes(indexA Index, indexB Index) bool {
 	}
 	for i, indexAColumn := range indexA.columns {
 		// TODO: check length?
-		if indexAColumn.column != indexB.columns[i].column {
+		if indexAColumn.column != indexB.columns[i].column || indexAColumn.expression != indexB.columns[i].expression || indexAColumn.collate != indexB.columns[i].collate {
+			return false
+		}
+	}
+	if len(indexA.includeColumns) != len(indexB.includeColumns) {
+		return false
+	}
+	for i, includeColumn := range indexA.includeColumns {
+		if includeColumn != indexB.includeColumns[i] {
 			return false
 		}
 	}
+	if indexA.using != indexB.using {
+		return false
+	}
 	if indexA.where != indexB.where {
 		return false
 	}
+	if indexA.constraint != indexB.constraint {
+		return false
+	}
 
 	for _, optionB := range indexB.options {
 		if optionA := findIndexOptionByName(indexA.options, optionB.optionName); optionA != nil {
@@ -1246,10 +4254,68 @@ func (g *Generator) areSameForeignKeys(foreignKeyA ForeignKey, foreignKeyB Forei
 	if g.normalizeOnDelete(foreignKeyA.onDelete) != g.normalizeOnDelete(foreignKeyB.onDelete) {
 		return false
 	}
-	// TODO: check index, reference
+	if foreignKeyA.deferrable != foreignKeyB.deferrable || foreignKeyA.initiallyDeferred != foreignKeyB.initiallyDeferred {
+		return false
+	}
+	if !areSameForeignKeyColumns(foreignKeyA, foreignKeyB) {
+		return false
+	}
+	if g.normalizeReferenceName(foreignKeyA.referenceName) != g.normalizeReferenceName(foreignKeyB.referenceName) {
+		return false
+	}
+	// The index name is not compared because MySQL doesn't show it in the foreign key definition.
+	return true
+}
+
+// Qualify a referenced table name with the default schema, since a dumped one may be qualified or not.
+func (g *Generator) normalizeReferenceName(name string) string {
+	switch g.mode {
+	case GeneratorModePostgres:
+		return g.normalizeObjectName(name)
+	case GeneratorModeMssql:
+		if !strings.Contains(name, ".") {
+			return "dbo." + name
+		}
+		return name
+	default:
+		return name
+	}
+}
+
+// Compare the pairs of a local column and its referenced column. The order of the pairs does not matter,
+// so `(a, b) REFERENCES t (x, y)` is the same as `(b, a) REFERENCES t (y, x)`.
+func areSameForeignKeyColumns(foreignKeyA ForeignKey, foreignKeyB ForeignKey) bool {
+	pairsA := foreignKeyColumnPairs(foreignKeyA)
+	pairsB := foreignKeyColumnPairs(foreignKeyB)
+	if len(pairsA) != len(pairsB) {
+		return false
+	}
+	for i := range pairsA {
+		if pairsA[i] != pairsB[i] {
+			return false
+		}
+	}
 	return true
 }
 
+func foreignKeyColumnPairs(foreignKey ForeignKey) [][2]string {
+	var pairs [][2]string
+	for i, column := range foreignKey.indexColumns {
+		var referenceColumn string
+		if i < len(foreignKey.referenceColumns) {
+			referenceColumn = foreignKey.referenceColumns[i]
+		}
+		pairs = append(pairs, [2]string{column, referenceColumn})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i][0] != pairs[j][0] {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][1] < pairs[j][1]
+	})
+	return pairs
+}
+
 func areSamePolicies(policyA, policyB Policy) bool {
 	if strings.ToLower(policyA.scope) != strings.ToLower(policyB.scope) {
 		return false
@@ -1281,19 +4347,28 @@ func areSamePolicies(policyA, policyB Policy) bool {
 }
 
 func (g *Generator) normalizeOnUpdate(onUpdate string) string {
-	if (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql) && onUpdate == "" {
-		return "NO ACTION"
-	} else {
-		return onUpdate
-	}
+	return g.normalizeReferenceOption(onUpdate)
 }
 
 func (g *Generator) normalizeOnDelete(onDelete string) string {
-	if (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql) && onDelete == "" {
-		return "NO ACTION"
-	} else {
-		return onDelete
+	return g.normalizeReferenceOption(onDelete)
+}
+
+// Omitted ON DELETE / ON UPDATE means NO ACTION. MySQL also treats RESTRICT as NO ACTION,
+// while PostgreSQL distinguishes them since only NO ACTION can be deferred.
+func (g *Generator) normalizeReferenceOption(option string) string {
+	option = strings.ToUpper(option)
+	switch g.mode {
+	case GeneratorModeMysql:
+		if option == "" || option == "RESTRICT" {
+			return "NO ACTION"
+		}
+	case GeneratorModePostgres, GeneratorModeMssql:
+		if option == "" {
+			return "NO ACTION"
+		}
 	}
+	return option
 }
 
 // TODO: Use interface to avoid defining following functions?
@@ -1367,6 +4442,35 @@ func containsString(strs []string, str string) bool {
 	return false
 }
 
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func removeString(strs []string, str string) []string {
+	ret := []string{}
+	for _, s := range strs {
+		if s != str {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+func removeIndexByName(indexes []Index, name string) []Index {
+	ret := []Index{}
+	for _, index := range indexes {
+		if index.name != name {
+			ret = append(ret, index)
+		}
+	}
+	return ret
+}
+
 func removeTableByName(tables []*Table, name string) []*Table {
 	removed := false
 	ret := []*Table{}
@@ -1421,20 +4525,20 @@ func generateSequenceClause(sequence *Sequence) string {
 	return strings.TrimSpace(ddl)
 }
 
-func generateDefaultDefinition(defaultVal Value) (string, error) {
+func generateDefaultDefinition(defaultDef DefaultDefinition) (string, error) {
+	if defaultDef.expression != "" {
+		return fmt.Sprintf("DEFAULT (%s)", defaultDef.expression), nil
+	}
+	defaultVal := *defaultDef.value
 	switch defaultVal.valueType {
 	case ValueTypeStr:
-		return fmt.Sprintf("DEFAULT '%s'", defaultVal.strVal), nil
+		return fmt.Sprintf("DEFAULT '%s'", strings.ReplaceAll(defaultVal.strVal, "'", "''")), nil
 	case ValueTypeInt:
 		return fmt.Sprintf("DEFAULT %d", defaultVal.intVal), nil
 	case ValueTypeFloat:
-		return fmt.Sprintf("DEFAULT %f", defaultVal.floatVal), nil
+		return fmt.Sprintf("DEFAULT %s", strconv.FormatFloat(defaultVal.floatVal, 'f', -1, 64)), nil
 	case ValueTypeBit:
-		if defaultVal.bitVal {
-			return "DEFAULT b'1'", nil
-		} else {
-			return "DEFAULT b'0'", nil
-		}
+		return fmt.Sprintf("DEFAULT b'%s'", string(defaultVal.raw)), nil
 	case ValueTypeValArg: // NULL, CURRENT_TIMESTAMP, ...
 		return fmt.Sprintf("DEFAULT %s", string(defaultVal.raw)), nil
 	default:
@@ -0,0 +1,204 @@
+package schema
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestResultSchemaWithSkippedDDLs(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres, `
+		CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text NOT NULL);
+		CREATE INDEX index_name ON users (name);
+	`, `
+		CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text, age integer);
+		CREATE INDEX index_age ON users (age);
+		CREATE TABLE posts (id bigint NOT NULL PRIMARY KEY);
+	`, GeneratorOptions{DropTablesEnabled: true, SkipDrop: true, AllowUnsafe: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The column, the index and the table are not dropped
+	assertEqual(t, fmt.Sprintf("%q", result.Schema), fmt.Sprintf("%q", []string{
+		"CREATE TABLE \"public\".\"users\" (\n" +
+			"  \"id\" bigint NOT NULL,\n" +
+			"  \"name\" text NOT NULL,\n" +
+			"  \"age\" integer,\n" +
+			"  PRIMARY KEY (\"id\")\n" +
+			")",
+		"CREATE INDEX index_name ON users (name)",
+		"CREATE INDEX index_age ON users (age)",
+		"CREATE TABLE posts (id bigint NOT NULL PRIMARY KEY)",
+	}))
+}
+
+func TestResultSchemaWithSkippedConstraint(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres, `
+		CREATE TABLE users (id bigint NOT NULL, name text, CONSTRAINT users_pkey PRIMARY KEY (id, name));
+	`, `
+		CREATE TABLE users (id bigint NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id), CONSTRAINT legacy_pkey CHECK (id > 0));
+	`, GeneratorOptions{SkipDrop: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The primary key and the check are kept since their drops are skipped, but the column is added.
+	// The check is not taken for the primary key by its name.
+	assertEqual(t, fmt.Sprintf("%q", result.Schema), fmt.Sprintf("%q", []string{
+		"CREATE TABLE \"public\".\"users\" (\n" +
+			"  \"id\" bigint NOT NULL,\n" +
+			"  \"name\" text,\n" +
+			"  CONSTRAINT \"users_pkey\" PRIMARY KEY (\"id\"),\n" +
+			"  CONSTRAINT \"legacy_pkey\" CHECK (id > 0)\n" +
+			")",
+	}))
+}
+
+func TestSetNotNullViaCheckOfQualifiedTable(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres,
+		"CREATE TABLE app.users (id bigint NOT NULL, email text NOT NULL);",
+		"CREATE TABLE app.users (id bigint NOT NULL, email text);",
+		GeneratorOptions{NotNullViaCheck: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The check is named after the table without its schema, like the other constraints
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		`ALTER TABLE "app"."users" ADD CONSTRAINT "users_email_not_null_check" CHECK ("email" IS NOT NULL) NOT VALID`,
+		`ALTER TABLE "app"."users" VALIDATE CONSTRAINT "users_email_not_null_check"`,
+		`ALTER TABLE "app"."users" ALTER COLUMN "email" SET NOT NULL`,
+		`ALTER TABLE "app"."users" DROP CONSTRAINT "users_email_not_null_check"`,
+	}))
+}
+
+func TestMssqlCheckConstraintName(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModeMssql,
+		"CREATE TABLE orders (id int NOT NULL, amount int CHECK (amount > 1));",
+		"CREATE TABLE orders (id int NOT NULL, amount int CONSTRAINT [orders_amount_check] CHECK (amount > 0));",
+		GeneratorOptions{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The names are quoted not to be taken for qualified names
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		"ALTER TABLE [dbo].[orders] DROP CONSTRAINT [orders_amount_check]",
+		"ALTER TABLE [dbo].[orders] ADD CONSTRAINT [orders_amount_check] CHECK (amount > 1)",
+	}))
+
+	// A check is named after the table without its schema
+	assertEqual(t, mssqlCheckConstraintName("sales.orders", "amount"), "orders_amount_check")
+}
+
+func TestForeignKeyReferenceOfAnotherSchema(t *testing.T) {
+	current := `
+		CREATE TABLE users (id bigint PRIMARY KEY);
+		CREATE TABLE posts (user_id bigint, CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES users (id));
+	`
+	desired := `
+		CREATE TABLE users (id bigint PRIMARY KEY);
+		CREATE TABLE other.users (id bigint PRIMARY KEY);
+		CREATE TABLE posts (user_id bigint, CONSTRAINT posts_user_fk FOREIGN KEY (user_id) REFERENCES other.users (id));
+	`
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres, desired, current, GeneratorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The referenced table keeps its schema
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		`CREATE SCHEMA IF NOT EXISTS "other"`,
+		"CREATE TABLE other.users (id bigint PRIMARY KEY)",
+		`ALTER TABLE "public"."posts" DROP CONSTRAINT "posts_user_fk"`,
+		`ALTER TABLE "public"."posts" ADD CONSTRAINT "posts_user_fk" FOREIGN KEY ("user_id") REFERENCES "other"."users" ("id")`,
+	}))
+
+	result, err = GenerateIdempotentDDLsWithResult(GeneratorModePostgres, desired, desired, GeneratorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{}))
+}
+
+func TestAlterTableAddCompositePrimaryKey(t *testing.T) {
+	desired := "CREATE TABLE friends (user_id bigint, friend_id bigint);\nALTER TABLE friends ADD PRIMARY KEY (friend_id, user_id);"
+	for _, test := range []struct {
+		mode    GeneratorMode
+		current string
+	}{
+		{GeneratorModeMysql, "CREATE TABLE `friends` (\n  `user_id` bigint NOT NULL,\n  `friend_id` bigint NOT NULL,\n  PRIMARY KEY (`friend_id`,`user_id`)\n);"},
+		{GeneratorModePostgres, "CREATE TABLE public.friends (\n    \"user_id\" bigint NOT NULL,\n    \"friend_id\" bigint NOT NULL,\n    CONSTRAINT friends_pkey PRIMARY KEY (\"friend_id\", \"user_id\")\n);"},
+		{GeneratorModeMssql, "CREATE TABLE dbo.friends (\n    user_id bigint NOT NULL,\n    friend_id bigint NOT NULL,\n    CONSTRAINT [PK__friends__1] PRIMARY KEY CLUSTERED ([friend_id], [user_id])\n);"},
+	} {
+		// The primary key is added after the table is created
+		result, err := GenerateIdempotentDDLsWithResult(test.mode, desired, "", GeneratorOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+			"CREATE TABLE friends (user_id bigint, friend_id bigint)",
+			"ALTER TABLE friends ADD PRIMARY KEY (friend_id, user_id)",
+		}))
+
+		// The NOT NULL columns of the primary key in the declared order are the same
+		result, err = GenerateIdempotentDDLsWithResult(test.mode, desired, test.current, GeneratorOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{}))
+	}
+}
+
+func TestTriggersWithTargetTables(t *testing.T) {
+	desired := `
+		CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text);
+		CREATE TABLE logs (id bigint NOT NULL PRIMARY KEY);
+		CREATE VIEW user_names AS SELECT id, name FROM users;
+		CREATE FUNCTION f() RETURNS trigger AS $$ BEGIN RETURN NEW; END; $$ LANGUAGE plpgsql;
+		CREATE TRIGGER logs_insert AFTER INSERT ON logs FOR EACH ROW EXECUTE FUNCTION f();
+		CREATE TRIGGER user_names_insert INSTEAD OF INSERT ON user_names FOR EACH ROW EXECUTE FUNCTION f();
+	`
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres, desired, "", GeneratorOptions{TargetTables: []string{"users"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The trigger of the table out of the targets is ignored, while the one of the view is managed like the view
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		"CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name text)",
+		"CREATE VIEW user_names AS SELECT id, name FROM users",
+		"CREATE FUNCTION f() RETURNS trigger AS $$ BEGIN RETURN NEW; END; $$ LANGUAGE plpgsql",
+		"CREATE TRIGGER user_names_insert INSTEAD OF INSERT ON user_names FOR EACH ROW EXECUTE FUNCTION f()",
+	}))
+	assertEqual(t, fmt.Sprintf("%q", result.Schema), fmt.Sprintf("%q", result.DDLs))
+}
+
+func TestChangePostgresColumnCheck(t *testing.T) {
+	result, err := GenerateIdempotentDDLsWithResult(GeneratorModePostgres,
+		"CREATE TABLE users (id integer PRIMARY KEY, age integer CONSTRAINT age_positive CHECK (age > 1));",
+		"CREATE TABLE users (id integer PRIMARY KEY, age integer CONSTRAINT age_positive CHECK (age > 0));",
+		GeneratorOptions{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The name is quoted once
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		`ALTER TABLE "public"."users" DROP CONSTRAINT "age_positive"`,
+		`ALTER TABLE "public"."users" ADD CONSTRAINT "age_positive" CHECK (age > 1)`,
+	}))
+
+	// So is the default name, which doesn't have the schema
+	result, err = GenerateIdempotentDDLsWithResult(GeneratorModePostgres,
+		"CREATE TABLE app.users (id integer PRIMARY KEY, age integer CHECK (age > 1));",
+		"CREATE TABLE app.users (id integer PRIMARY KEY, age integer CHECK (age > 0));",
+		GeneratorOptions{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, fmt.Sprintf("%q", result.DDLs), fmt.Sprintf("%q", []string{
+		`ALTER TABLE "app"."users" DROP CONSTRAINT "users_age_check"`,
+		`ALTER TABLE "app"."users" ADD CONSTRAINT "users_age_check" CHECK (age > 1)`,
+	}))
+}
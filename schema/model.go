@@ -0,0 +1,73 @@
+package schema
+
+import "encoding/json"
+
+// Parsed schema whose tables have the indexes and foreign keys given by separate DDLs too. It's serializable to JSON.
+type Model struct {
+	Tables []*Table `json:"tables"`
+	Views  []*View  `json:"views"`
+}
+
+// Parse `;`-concatenated DDLs into `Model`
+func ParseModel(mode GeneratorMode, sql string) (*Model, error) {
+	ddls, err := parseDDLs(mode, sql)
+	if err != nil {
+		return nil, err
+	}
+	views := convertDDLsToViews(mode, ddls)
+	tables, err := convertDDLsToTables(mode, ddls, views)
+	if err != nil {
+		return nil, err
+	}
+	return &Model{Tables: append([]*Table{}, tables...), Views: append([]*View{}, views...)}, nil
+}
+
+// JSON of the parsed schema is given by the accessors
+
+func (t *Table) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name        string       `json:"name"`
+		Columns     []Column     `json:"columns"`
+		Indexes     []Index      `json:"indexes"`
+		ForeignKeys []ForeignKey `json:"foreign_keys"`
+	}{t.Name(), t.Columns(), t.Indexes(), t.ForeignKeys()})
+}
+
+func (c Column) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name       string `json:"name"`
+		Type       string `json:"type"`
+		NotNull    bool   `json:"not_null"`
+		Default    string `json:"default,omitempty"`
+		References string `json:"references,omitempty"`
+	}{c.Name(), c.DataType(), c.NotNull(), c.Default(), c.References()})
+}
+
+func (i Index) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string   `json:"name"`
+		Columns []string `json:"columns"`
+		Primary bool     `json:"primary"`
+		Unique  bool     `json:"unique"`
+		Where   string   `json:"where,omitempty"`
+	}{i.Name(), i.Columns(), i.Primary(), i.Unique(), i.Where()})
+}
+
+func (f ForeignKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name             string   `json:"name"`
+		Columns          []string `json:"columns"`
+		ReferenceTable   string   `json:"reference_table"`
+		ReferenceColumns []string `json:"reference_columns"`
+		OnDelete         string   `json:"on_delete,omitempty"`
+		OnUpdate         string   `json:"on_update,omitempty"`
+	}{f.Name(), f.Columns(), f.ReferenceTable(), f.ReferenceColumns(), f.OnDelete(), f.OnUpdate()})
+}
+
+func (v *View) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name         string `json:"name"`
+		Definition   string `json:"definition"`
+		Materialized bool   `json:"materialized"`
+	}{v.Name(), v.Definition(), v.Materialized()})
+}
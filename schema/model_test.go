@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	ddls, err := Parse(GeneratorModePostgres, `
+		CREATE TABLE users (
+		  id bigint NOT NULL PRIMARY KEY,
+		  name varchar(40) NOT NULL DEFAULT 'none',
+		  tags text[],
+		  group_id bigint,
+		  CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE CASCADE
+		);
+		CREATE INDEX index_name ON users (lower(name)) WHERE name IS NOT NULL;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ddls) != 2 {
+		t.Fatalf("expected 2 DDLs, but got %d", len(ddls))
+	}
+
+	table := ddls[0].(*CreateTable).Table()
+	columns := []string{}
+	for _, column := range table.Columns() {
+		columns = append(columns, fmt.Sprintf("%s %s %s %t %q", column.Name(), column.TypeName(), column.DataType(), column.NotNull(), column.Default()))
+	}
+	assertEqual(t, fmt.Sprintf("%v", columns), `[id bigint bigint true "" name varchar varchar(40) true "'none'" tags text text[] false "" group_id bigint bigint false ""]`)
+
+	index := ddls[1].(*CreateIndex)
+	assertEqual(t, index.TableName(), "public.users")
+	assertEqual(t, fmt.Sprintf("%s %v %t %t %s", index.Index().Name(), index.Index().Columns(), index.Index().Primary(), index.Index().Unique(), index.Index().Where()),
+		"index_name [lower(name)] false false name is not null")
+
+	foreignKey := table.ForeignKeys()[0]
+	assertEqual(t, fmt.Sprintf("%s %v %s %v %s", foreignKey.Name(), foreignKey.Columns(), foreignKey.ReferenceTable(), foreignKey.ReferenceColumns(), foreignKey.OnDelete()),
+		"users_group_id_fkey [group_id] groups [id] CASCADE")
+}
+
+func TestParseAccessorsCopySlices(t *testing.T) {
+	ddls, err := Parse(GeneratorModeMysql, "CREATE TABLE users (id bigint PRIMARY KEY, name varchar(40), CONSTRAINT users_name_fk FOREIGN KEY (name) REFERENCES names (name));")
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := ddls[0].(*CreateTable).Table()
+
+	table.Columns()[0] = Column{name: "modified"}
+	table.Indexes()[0] = Index{name: "modified"}
+	table.ForeignKeys()[0] = ForeignKey{constraintName: "modified"}
+	foreignKey := table.ForeignKeys()[0]
+	foreignKey.Columns()[0] = "modified"
+	foreignKey.ReferenceColumns()[0] = "modified"
+
+	assertEqual(t, table.Columns()[0].Name(), "id")
+	assertEqual(t, table.Indexes()[0].Name(), "PRIMARY")
+	assertEqual(t, fmt.Sprintf("%v %v", foreignKey.Columns(), foreignKey.ReferenceColumns()), "[name] [name]")
+}
+
+func TestParseModel(t *testing.T) {
+	model, err := ParseModel(GeneratorModeSQLite3, `
+		CREATE TABLE users (id integer NOT NULL PRIMARY KEY, name text DEFAULT '');
+		CREATE UNIQUE INDEX index_name ON users (name);
+		CREATE VIEW names AS SELECT name FROM users;
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Indexes given by separate DDLs are merged into the table
+	out, err := json.Marshal(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(out), `{"tables":[{"name":"users",`+
+		`"columns":[{"name":"id","type":"integer","not_null":true},{"name":"name","type":"text","not_null":false,"default":"''"}],`+
+		`"indexes":[{"name":"PRIMARY","columns":["id"],"primary":true,"unique":true},{"name":"index_name","columns":["name"],"primary":false,"unique":true}],`+
+		`"foreign_keys":[]}],`+
+		`"views":[{"name":"names","definition":"select name from users","materialized":false}]}`)
+}
+
+func assertEqual(t *testing.T, actual string, expected string) {
+	t.Helper()
+	if actual != expected {
+		t.Errorf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
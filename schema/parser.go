@@ -3,8 +3,10 @@
 package schema
 
 import (
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -65,17 +67,20 @@ func parseValue(val *sqlparser.SQLVal) *Value {
 	case ValueTypeFloat:
 		floatVal, _ := strconv.ParseFloat(string(val.Val), 64) // TODO: handle error
 		ret.floatVal = floatVal
-	case ValueTypeBit:
-		if string(val.Val) == "1" {
-			ret.bitVal = true
-		} else {
-			ret.bitVal = false
-		}
 	}
 
 	return &ret
 }
 
+// An empty comment is the same as no comment, which is how both MySQL and PostgreSQL drop a comment
+func parseComment(val *sqlparser.SQLVal) *Value {
+	comment := parseValue(val)
+	if comment != nil && comment.valueType == ValueTypeStr && comment.strVal == "" {
+		return nil
+	}
+	return comment
+}
+
 // Assume an integer length. Maybe useful only for index lengths.
 // TODO: Change IndexColumn.Length in parser.y to integer in the first place
 func parseLength(val *sqlparser.SQLVal) (*int, error) {
@@ -92,12 +97,58 @@ func parseLength(val *sqlparser.SQLVal) (*int, error) {
 	return &intVal, nil
 }
 
+func parseIndexColumns(mode GeneratorMode, columns []sqlparser.IndexColumn) ([]IndexColumn, error) {
+	indexColumns := []IndexColumn{}
+	for _, column := range columns {
+		if column.Expression != nil {
+			expr := column.Expression
+			// Postgres reports an expression like `lower(email)` as `lower((email)::text)`. Its DDL is given
+			// as is by CREATE INDEX, so the expression is just for comparison. MySQL needs casts as written.
+			if mode == GeneratorModePostgres {
+				expr = normalizePredicate(expr)
+			}
+			indexColumns = append(indexColumns, IndexColumn{expression: sqlparser.String(unwrapParen(expr)), collate: column.Collate})
+			continue
+		}
+
+		length, err := parseLength(column.Length)
+		if err != nil {
+			return nil, err
+		}
+		indexColumns = append(
+			indexColumns,
+			IndexColumn{
+				column:  column.Column.String(),
+				length:  length,
+				collate: column.Collate,
+			},
+		)
+	}
+	return indexColumns, nil
+}
+
+// Non-key columns given by `INCLUDE (...)`, which are stored in the index but not used to search it
+func parseIncludeColumns(columns []sqlparser.ColIdent) []string {
+	includeColumns := []string{}
+	for _, column := range columns {
+		includeColumns = append(includeColumns, column.String())
+	}
+	return includeColumns
+}
+
 func parseTable(mode GeneratorMode, stmt *sqlparser.DDL) (Table, error) {
 	columns := []Column{}
 	indexes := []Index{}
 	foreignKeys := []ForeignKey{}
 
 	for i, parsedCol := range stmt.TableSpec.Columns {
+		// An inline reference is a foreign key of the column, which can't match multiple columns
+		if len(parsedCol.Type.ReferenceNames) > 1 {
+			return Table{}, fmt.Errorf(
+				"column '%s' of table '%s' references multiple columns of '%s', but a multi-column foreign key must be a table constraint like FOREIGN KEY (...) REFERENCES %s (...)",
+				parsedCol.Name.String(), normalizedTableName(mode, stmt.NewName), parsedCol.Type.References, parsedCol.Type.References,
+			)
+		}
 		column := Column{
 			name:          parsedCol.Name.String(),
 			position:      i,
@@ -106,7 +157,7 @@ func parseTable(mode GeneratorMode, stmt *sqlparser.DDL) (Table, error) {
 			notNull:       castBoolPtr(parsedCol.Type.NotNull),
 			autoIncrement: castBool(parsedCol.Type.Autoincrement),
 			array:         castBool(parsedCol.Type.Array),
-			defaultDef:    parseDefaultDefinition(parsedCol.Type.Default),
+			defaultDef:    parseDefaultDefinition(mode, parsedCol.Type.Default),
 			length:        parseValue(parsedCol.Type.Length),
 			scale:         parseValue(parsedCol.Type.Scale),
 			charset:       parsedCol.Type.Charset,
@@ -114,56 +165,64 @@ func parseTable(mode GeneratorMode, stmt *sqlparser.DDL) (Table, error) {
 			timezone:      castBool(parsedCol.Type.Timezone),
 			keyOption:     ColumnKeyOption(parsedCol.Type.KeyOpt), // FIXME: tight coupling in enum order
 			onUpdate:      parseValue(parsedCol.Type.OnUpdate),
+			comment:       parseComment(parsedCol.Type.Comment),
 			enumValues:    parsedCol.Type.EnumValues,
 			references:    parsedCol.Type.References,
 			identity:      parseIdentity(parsedCol.Type.Identity),
 			sequence:      parseIdentitySequence(parsedCol.Type.Identity),
 		}
 		if parsedCol.Type.Check != nil {
+			// A dumped check may have redundant parentheses like `CHECK ((id > 0)) NO INHERIT`
 			column.check = &CheckDefinition{
-				definition:     sqlparser.String(parsedCol.Type.Check.Where.Expr),
+				definition:     sqlparser.String(unwrapParen(parsedCol.Type.Check.Where.Expr)),
 				constraintName: sqlparser.String(parsedCol.Type.Check.ConstraintName),
 			}
 		}
 		column.checkNoInherit = castBool(parsedCol.Type.CheckNoInherit)
+		if parsedCol.Type.Generated != nil {
+			column.generatedExpr = parseGeneratedExpr(parsedCol.Type.Generated.Expr)
+			column.generatedKind = parseGeneratedKind(mode, parsedCol.Type.Generated.Type)
+		}
+		if mode == GeneratorModePostgres {
+			normalizeByteaDefault(&column)
+		}
 		columns = append(columns, column)
 	}
 
 	for _, indexDef := range stmt.TableSpec.Indexes {
-		indexColumns := []IndexColumn{}
-		for _, column := range indexDef.Columns {
-			length, err := parseLength(column.Length)
-			if err != nil {
-				return Table{}, err
-			}
-			indexColumns = append(
-				indexColumns,
-				IndexColumn{
-					column: column.Column.String(),
-					length: length,
-				},
-			)
+		indexColumns, err := parseIndexColumns(mode, indexDef.Columns)
+		if err != nil {
+			return Table{}, err
 		}
 
 		indexOptions := []IndexOption{}
 		for _, option := range indexDef.Options {
+			value := parseValue(option.Value)
+			if option.Using != "" {
+				// MySQL shows an index type like `USING BTREE` in upper case
+				value = &Value{valueType: ValueTypeStr, raw: []byte(strings.ToUpper(option.Using)), strVal: strings.ToUpper(option.Using)}
+			}
 			indexOptions = append(
 				indexOptions,
 				IndexOption{
 					optionName: option.Name,
-					value:      parseValue(option.Value),
+					value:      value,
 				},
 			)
 		}
 
+		// MySQL and SQLite3 don't distinguish unique constraints from unique indexes
+		constraint := indexDef.Info.Constraint && (mode == GeneratorModePostgres || mode == GeneratorModeMssql)
 		index := Index{
-			name:      indexDef.Info.Name.String(),
-			indexType: indexDef.Info.Type,
-			columns:   indexColumns,
-			primary:   indexDef.Info.Primary,
-			unique:    indexDef.Info.Unique,
-			clustered: bool(indexDef.Info.Clustered),
-			options:   indexOptions,
+			name:           indexDef.Info.Name.String(),
+			indexType:      indexDef.Info.Type,
+			columns:        indexColumns,
+			includeColumns: parseIncludeColumns(indexDef.Include),
+			primary:        indexDef.Info.Primary,
+			unique:         indexDef.Info.Unique,
+			clustered:      bool(indexDef.Info.Clustered),
+			constraint:     constraint,
+			options:        indexOptions,
 		}
 		indexes = append(indexes, index)
 	}
@@ -180,43 +239,114 @@ func parseTable(mode GeneratorMode, stmt *sqlparser.DDL) (Table, error) {
 		}
 
 		foreignKey := ForeignKey{
-			constraintName:   foreignKeyDef.ConstraintName.String(),
-			indexName:        foreignKeyDef.IndexName.String(),
-			indexColumns:     indexColumns,
-			referenceName:    foreignKeyDef.ReferenceName.String(),
-			referenceColumns: referenceColumns,
-			onDelete:         foreignKeyDef.OnDelete.String(),
-			onUpdate:         foreignKeyDef.OnUpdate.String(),
+			constraintName:    foreignKeyDef.ConstraintName.String(),
+			indexName:         foreignKeyDef.IndexName.String(),
+			indexColumns:      indexColumns,
+			referenceName:     referenceTableName(mode, foreignKeyDef.ReferenceName.String()),
+			referenceColumns:  referenceColumns,
+			onDelete:          foreignKeyDef.OnDelete.String(),
+			onUpdate:          foreignKeyDef.OnUpdate.String(),
+			deferrable:        castBool(foreignKeyDef.Deferrable) || castBool(foreignKeyDef.InitiallyDeferred), // INITIALLY DEFERRED implies DEFERRABLE
+			initiallyDeferred: castBool(foreignKeyDef.InitiallyDeferred),
 		}
 		foreignKeys = append(foreignKeys, foreignKey)
 	}
 
-	return Table{
-		name:        normalizedTableName(mode, stmt.NewName),
+	tableName := normalizedTableName(mode, stmt.NewName)
+	checks := []CheckDefinition{}
+	for _, checkDef := range stmt.TableSpec.Checks {
+		checkColumns := parseCheckColumns(checkDef.Where.Expr)
+
+		// PostgreSQL and MySQL don't tell a table-level check from a column-level one. Make it column-level if possible.
+		if (mode == GeneratorModePostgres || mode == GeneratorModeMysql) && len(checkColumns) == 1 {
+			if i := findColumnIndex(columns, checkColumns[0]); i >= 0 && columns[i].check == nil {
+				columns[i].check = &CheckDefinition{
+					definition:     sqlparser.String(unwrapParen(checkDef.Where.Expr)),
+					constraintName: checkDef.ConstraintName.String(),
+				}
+				columns[i].checkNoInherit = castBool(checkDef.NoInherit)
+				continue
+			}
+		}
+
+		constraintName := checkDef.ConstraintName.String()
+		if constraintName == "" && mode == GeneratorModePostgres {
+			constraintName = defaultCheckConstraintName(tableName, checkColumns)
+		}
+		checks = append(checks, CheckDefinition{
+			definition:     sqlparser.String(normalizePredicate(checkDef.Where.Expr)),
+			constraintName: constraintName,
+			noInherit:      castBool(checkDef.NoInherit),
+		})
+	}
+
+	if mode == GeneratorModePostgres {
+		indexes = normalizeUniqueConstraints(tableName, columns, indexes)
+	}
+
+	exclusions := []Exclusion{}
+	for _, exclusionDef := range stmt.TableSpec.Exclusions {
+		exclusions = append(exclusions, parseExclusion(tableName, exclusionDef))
+	}
+
+	table := Table{
+		name:        tableName,
 		columns:     columns,
 		indexes:     indexes,
 		foreignKeys: foreignKeys,
-	}, nil
+		checks:      checks,
+		exclusions:  exclusions,
+	}
+	if stmt.TableSpec.PartitionBy != nil {
+		table.partitionDef = sqlparser.String(stmt.TableSpec.PartitionBy)
+	}
+	for _, parent := range stmt.TableSpec.Inherits {
+		table.inherits = append(table.inherits, normalizedTableName(mode, parent))
+	}
+	if mode == GeneratorModeMssql {
+		table.schema = stmt.NewName.Qualifier.String()
+	}
+	if mode == GeneratorModeMysql {
+		table.options = parseTableOptions(stmt.TableSpec.Options)
+	}
+	if mode == GeneratorModeSQLite3 {
+		table.withoutRowID = withoutRowID.MatchString(stmt.TableSpec.Options)
+	}
+	if mode == GeneratorModePostgres {
+		table.storageParameters = parseStorageParameters(stmt.TableSpec.StorageParameters)
+		if match := tablespaceOption.FindStringSubmatch(stmt.TableSpec.Options); match != nil && !strings.EqualFold(match[1], "pg_default") {
+			table.tablespace = match[1]
+		}
+	}
+	if err := validatePrimaryKeyNotNull(table); err != nil {
+		return Table{}, err
+	}
+	return table, nil
+}
+
+// PRIMARY KEY implies NOT NULL. Reject an explicit NULL instead of silently generating NOT NULL.
+func validatePrimaryKeyNotNull(table Table) error {
+	primaryKey := table.PrimaryKey()
+	if primaryKey == nil {
+		return nil
+	}
+	for _, indexColumn := range primaryKey.columns {
+		column := findColumnByName(table.columns, indexColumn.column)
+		if column != nil && column.notNull != nil && !*column.notNull {
+			return fmt.Errorf("primary key column '%s' of table '%s' is declared as NULL, but primary key columns must be NOT NULL", column.name, table.name)
+		}
+	}
+	return nil
 }
 
-func parseIndex(stmt *sqlparser.DDL) (Index, error) {
+func parseIndex(mode GeneratorMode, stmt *sqlparser.DDL) (Index, error) {
 	if stmt.IndexSpec == nil {
 		return Index{}, fmt.Errorf("stmt.IndexSpec was null on parseIndex: %#v", stmt)
 	}
 
-	indexColumns := []IndexColumn{}
-	for _, column := range stmt.IndexCols {
-		length, err := parseLength(column.Length)
-		if err != nil {
-			return Index{}, err
-		}
-		indexColumns = append(
-			indexColumns,
-			IndexColumn{
-				column: column.Column.String(),
-				length: length,
-			},
-		)
+	indexColumns, err := parseIndexColumns(mode, stmt.IndexCols)
+	if err != nil {
+		return Index{}, err
 	}
 
 	where := ""
@@ -226,22 +356,183 @@ func parseIndex(stmt *sqlparser.DDL) (Index, error) {
 		if parenExpr, ok := expr.(*sqlparser.ParenExpr); ok {
 			expr = parenExpr.Expr
 		}
-		where = sqlparser.String(expr)
+		where = sqlparser.String(normalizePredicate(expr))
+	}
+
+	using := ""
+	if mode == GeneratorModePostgres {
+		// Postgres reports `USING btree` even when it's not specified
+		if method := strings.ToLower(stmt.IndexSpec.Type.String()); method != "btree" {
+			using = method
+		}
 	}
 
 	return Index{
-		name:      stmt.IndexSpec.Name.String(),
-		indexType: "", // not supported in parser yet
-		columns:   indexColumns,
-		primary:   false, // not supported in parser yet
-		unique:    stmt.IndexSpec.Unique,
-		where:     where,
+		name:           stmt.IndexSpec.Name.String(),
+		indexType:      "", // not supported in parser yet
+		columns:        indexColumns,
+		includeColumns: parseIncludeColumns(stmt.IndexSpec.Include),
+		using:          using,
+		primary:        false, // not supported in parser yet
+		unique:         stmt.IndexSpec.Unique,
+		where:          where,
 	}, nil
 }
 
+// PostgreSQL reports a predicate like `state = 'active' AND id > 10` as `(((state)::text = 'active'::text) AND (id > 10))`,
+// casting literals and columns to the type of the column. Remove such casts and parentheses for comparison.
+func normalizePredicate(expr sqlparser.Expr) sqlparser.Expr {
+	var casts []*sqlparser.ConvertExpr
+	var parens []*sqlparser.ParenExpr
+	var comparisons []*sqlparser.ComparisonExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ConvertExpr:
+			casts = append(casts, node)
+		case *sqlparser.ParenExpr:
+			parens = append(parens, node)
+		case *sqlparser.ComparisonExpr:
+			comparisons = append(comparisons, node)
+		}
+		return true, nil
+	}, expr)
+
+	// Arithmetic binds tighter than comparison
+	for _, comparison := range comparisons {
+		for _, operand := range []*sqlparser.Expr{&comparison.Left, &comparison.Right} {
+			if paren, ok := (*operand).(*sqlparser.ParenExpr); ok {
+				if _, ok := paren.Expr.(*sqlparser.BinaryExpr); ok {
+					*operand = paren.Expr
+				}
+			}
+		}
+	}
+
+	for _, cast := range casts {
+		if inner := unwrapParen(cast.Expr); isSimpleExpr(inner) {
+			expr = sqlparser.ReplaceExpr(expr, cast, inner)
+		}
+	}
+	for _, paren := range parens {
+		switch inner := paren.Expr.(type) {
+		case *sqlparser.ComparisonExpr, *sqlparser.IsExpr, *sqlparser.NotExpr, *sqlparser.SQLVal, *sqlparser.ColName:
+			expr = sqlparser.ReplaceExpr(expr, paren, inner)
+		}
+	}
+	return expr
+}
+
+func findColumnIndex(columns []Column, name string) int {
+	for i, column := range columns {
+		if column.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Names of columns referenced by a check, in the order of appearance
+func parseCheckColumns(expr sqlparser.Expr) []string {
+	columns := []string{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if colName, ok := node.(*sqlparser.ColName); ok && !containsString(columns, colName.Name.String()) {
+			columns = append(columns, colName.Name.String())
+		}
+		return true, nil
+	}, expr)
+	return columns
+}
+
+// The name of a table without its schema, which names of constraints are derived from
+func unqualifiedName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// The name PostgreSQL gives to a check without a name: `<table>_<column>_check` if it refers to one column, otherwise `<table>_check`.
+func defaultCheckConstraintName(tableName string, columns []string) string {
+	tableName = unqualifiedName(tableName)
+	if len(columns) == 1 {
+		return fmt.Sprintf("%s_%s_check", tableName, columns[0])
+	}
+	return fmt.Sprintf("%s_check", tableName)
+}
+
+// PostgreSQL doesn't tell a column-level UNIQUE from a unique constraint of the column named like `<table>_<column>_key`.
+// Make such a constraint column-level, and keep the others as constraints.
+func normalizeUniqueConstraints(tableName string, columns []Column, indexes []Index) []Index {
+	tableName = unqualifiedName(tableName)
+	normalized := []Index{}
+	for _, index := range indexes {
+		if index.constraint && index.unique && !index.primary && len(index.columns) == 1 && len(index.includeColumns) == 0 &&
+			index.name == fmt.Sprintf("%s_%s_key", tableName, index.columns[0].column) {
+			if i := findColumnIndex(columns, index.columns[0].column); i >= 0 && columns[i].keyOption == ColumnKeyNone {
+				columns[i].keyOption = ColumnKeyUnique
+				continue
+			}
+		}
+		normalized = append(normalized, index)
+	}
+	return normalized
+}
+
+// PostgreSQL shows an EXCLUDE constraint with its index type even if it's omitted, e.g. `EXCLUDE USING btree (room WITH =)`
+func parseExclusion(tableName string, exclusionDef *sqlparser.ExclusionDefinition) Exclusion {
+	exclusion := Exclusion{
+		constraintName: exclusionDef.ConstraintName.String(),
+		indexType:      strings.ToLower(exclusionDef.IndexType),
+	}
+	if exclusion.indexType == "" {
+		exclusion.indexType = "btree"
+	}
+	columns := []string{}
+	for _, element := range exclusionDef.Elements {
+		exclusion.elements = append(exclusion.elements, ExclusionElement{column: element.Column.String(), operator: element.Operator})
+		columns = append(columns, element.Column.String())
+	}
+	if exclusion.constraintName == "" {
+		exclusion.constraintName = defaultExclusionConstraintName(tableName, columns)
+	}
+	if exclusionDef.Where != nil {
+		exclusion.where = sqlparser.String(normalizePredicate(unwrapParen(exclusionDef.Where)))
+	}
+	return exclusion
+}
+
+// The name PostgreSQL gives to an EXCLUDE constraint without a name: `<table>_<columns>_excl`
+func defaultExclusionConstraintName(tableName string, columns []string) string {
+	return fmt.Sprintf("%s_%s_excl", unqualifiedName(tableName), strings.Join(columns, "_"))
+}
+
+func unwrapParen(expr sqlparser.Expr) sqlparser.Expr {
+	if paren, ok := expr.(*sqlparser.ParenExpr); ok {
+		return paren.Expr
+	}
+	return expr
+}
+
+func isSimpleExpr(expr sqlparser.Expr) bool {
+	switch expr.(type) {
+	case *sqlparser.SQLVal, *sqlparser.ColName:
+		return true
+	default:
+		return false
+	}
+}
+
 // Parse DDL like `CREATE TABLE` or `ALTER TABLE`.
 // This doesn't support destructive DDL like `DROP TABLE`.
 func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
+	// The parser can't handle procedural languages in function bodies
+	if mode == GeneratorModePostgres && createFunction.MatchString(ddl) {
+		return parseFunction(ddl)
+	}
+	if createTrigger.MatchString(ddl) {
+		return parseTrigger(mode, ddl)
+	}
+
 	var parserMode sqlparser.ParserMode
 	switch mode {
 	case GeneratorModeMysql:
@@ -269,12 +560,13 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 			if err != nil {
 				return nil, err
 			}
+			parseRenameAnnotations(&table, ddl)
 			return &CreateTable{
 				statement: ddl,
 				table:     table,
 			}, nil
 		} else if stmt.Action == "create index" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
@@ -284,7 +576,7 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 				index:     index,
 			}, nil
 		} else if stmt.Action == "add index" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
@@ -294,10 +586,14 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 				index:     index,
 			}, nil
 		} else if stmt.Action == "add primary key" {
-			index, err := parseIndex(stmt)
+			index, err := parseIndex(mode, stmt)
 			if err != nil {
 				return nil, err
 			}
+			index.indexType = "primary key"
+			index.primary = true
+			index.unique = true
+			index.clustered = bool(stmt.IndexSpec.Clustered)
 			return &AddPrimaryKey{
 				statement: ddl,
 				tableName: normalizedTableName(mode, stmt.Table),
@@ -317,13 +613,15 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 				statement: ddl,
 				tableName: normalizedTableName(mode, stmt.Table),
 				foreignKey: ForeignKey{
-					constraintName:   stmt.ForeignKey.ConstraintName.String(),
-					indexName:        stmt.ForeignKey.IndexName.String(),
-					indexColumns:     indexColumns,
-					referenceName:    stmt.ForeignKey.ReferenceName.String(),
-					referenceColumns: referenceColumns,
-					onDelete:         stmt.ForeignKey.OnDelete.String(),
-					onUpdate:         stmt.ForeignKey.OnUpdate.String(),
+					constraintName:    stmt.ForeignKey.ConstraintName.String(),
+					indexName:         stmt.ForeignKey.IndexName.String(),
+					indexColumns:      indexColumns,
+					referenceName:     referenceTableName(mode, stmt.ForeignKey.ReferenceName.String()),
+					referenceColumns:  referenceColumns,
+					onDelete:          stmt.ForeignKey.OnDelete.String(),
+					onUpdate:          stmt.ForeignKey.OnUpdate.String(),
+					deferrable:        castBool(stmt.ForeignKey.Deferrable) || castBool(stmt.ForeignKey.InitiallyDeferred), // INITIALLY DEFERRED implies DEFERRABLE
+					initiallyDeferred: castBool(stmt.ForeignKey.InitiallyDeferred),
 				},
 			}, nil
 		} else if stmt.Action == "create policy" {
@@ -350,11 +648,68 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 					withCheck:  withCheck,
 				},
 			}, nil
+		} else if stmt.Action == "comment" && stmt.TableComment != nil {
+			return &CommentOnTable{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table),
+				comment:   parseComment(stmt.TableComment.Comment),
+			}, nil
+		} else if stmt.Action == "cluster on" {
+			return &ClusterOn{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table),
+				indexName: stmt.IndexSpec.Name.String(),
+			}, nil
+		} else if stmt.Action == "comment" {
+			return &CommentOnColumn{
+				statement:  ddl,
+				tableName:  normalizedTableName(mode, stmt.Table),
+				columnName: stmt.ColumnComment.Column.String(),
+				comment:    parseComment(stmt.ColumnComment.Comment),
+			}, nil
 		} else if stmt.Action == "create view" {
 			return &View{
+				statement:    ddl,
+				name:         stmt.View.Name.Name.String(),
+				definition:   sqlparser.String(stmt.View.Definition),
+				dependencies: parseTableReferences(mode, stmt.View.Definition),
+				materialized: stmt.View.Materialized,
+			}, nil
+		} else if stmt.Action == "create type" {
+			return &CreateType{
 				statement:  ddl,
-				name:       stmt.View.Name.Name.String(),
-				definition: sqlparser.String(stmt.View.Definition),
+				name:       normalizedTableName(mode, stmt.Type.Name),
+				enumValues: stmt.Type.EnumValues,
+			}, nil
+		} else if stmt.Action == "create domain" {
+			definition := Column{
+				typeName:   stmt.Domain.Type.Type,
+				length:     parseValue(stmt.Domain.Type.Length),
+				scale:      parseValue(stmt.Domain.Type.Scale),
+				array:      castBool(stmt.Domain.Type.Array),
+				notNull:    castBoolPtr(stmt.Domain.Type.NotNull),
+				defaultDef: parseDefaultDefinition(mode, stmt.Domain.Type.Default),
+			}
+			var checks []CheckDefinition
+			for _, check := range stmt.Domain.Type.Checks {
+				// PostgreSQL shows a check of a domain like `CHECK ((VALUE ~ '@'::text))`
+				expr := normalizePredicate(unwrapParen(check.Where.Expr))
+				_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+					if colName, ok := node.(*sqlparser.ColName); ok && colName.Name.Lowered() == "value" {
+						colName.Name = sqlparser.NewColIdent("VALUE")
+					}
+					return true, nil
+				}, expr)
+				checks = append(checks, CheckDefinition{
+					definition:     sqlparser.String(expr),
+					constraintName: check.ConstraintName.String(),
+				})
+			}
+			return &CreateDomain{
+				statement:  ddl,
+				name:       normalizedTableName(mode, stmt.Domain.Name),
+				definition: definition,
+				checks:     checks,
 			}, nil
 		} else {
 			return nil, fmt.Errorf(
@@ -367,13 +722,19 @@ func parseDDL(mode GeneratorMode, ddl string) (DDL, error) {
 	}
 }
 
+// Parse `;`-concatenated DDLs of a schema without generating anything from them.
+// The parsed structure can be inspected like `ddl.(*CreateTable).Table().Columns()`.
+func Parse(mode GeneratorMode, sql string) ([]DDL, error) {
+	return parseDDLs(mode, sql)
+}
+
 // Parse `ddls`, which is expected to `;`-concatenated DDLs
 // and not to include destructive DDL.
 func parseDDLs(mode GeneratorMode, str string) ([]DDL, error) {
 	re := regexp.MustCompilePOSIX("^--.*")
 	str = re.ReplaceAllString(str, "")
 
-	ddls := strings.Split(str, ";")
+	ddls := splitDDLs(mode, str)
 	result := []DDL{}
 
 	for _, ddl := range ddls {
@@ -391,6 +752,282 @@ func parseDDLs(mode GeneratorMode, str string) ([]DDL, error) {
 	return result, nil
 }
 
+// Split `;`-concatenated DDLs. `;` is not a delimiter in a dollar-quoted string like a Postgres function body,
+// or in `BEGIN ... END` of a MySQL or SQLite trigger body.
+func splitDDLs(mode GeneratorMode, str string) []string {
+	ddls := []string{}
+	start := 0
+	inTrigger := mode != GeneratorModePostgres && createTrigger.MatchString(str)
+	depth := 0 // nesting of BEGIN ... END in a trigger body
+	for i := 0; i < len(str); i++ {
+		switch {
+		case str[i] == ';' && depth == 0:
+			ddls = append(ddls, str[start:i])
+			start = i + 1
+			inTrigger = mode != GeneratorModePostgres && createTrigger.MatchString(str[start:])
+		case str[i] == '$' && mode == GeneratorModePostgres:
+			if tag := dollarQuoteTag.FindString(str[i:]); tag != "" {
+				if end := strings.Index(str[i+len(tag):], tag); end >= 0 {
+					i += len(tag) + end + len(tag) - 1
+				}
+			}
+		case inTrigger && isWordChar(str[i]) && (i == 0 || !isWordChar(str[i-1])):
+			end := i
+			for end < len(str) && isWordChar(str[end]) {
+				end++
+			}
+			switch strings.ToUpper(str[i:end]) {
+			case "BEGIN", "CASE":
+				depth++
+			case "END":
+				// `END IF`, `END LOOP` and so on of MySQL close blocks without BEGIN
+				nextWord := strings.ToUpper(strings.TrimLeft(str[end:], " \t\r\n"))
+				if depth > 0 && !endOfNonBeginBlock.MatchString(nextWord) {
+					depth--
+				}
+			}
+			i = end - 1
+		}
+	}
+	return append(ddls, str[start:])
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+var (
+	createTrigger      = regexp.MustCompile(`(?is)^\s*CREATE\s+(OR\s+REPLACE\s+)?(DEFINER\s*=\s*\S+\s+)?(TEMP\s+|TEMPORARY\s+)?(CONSTRAINT\s+)?TRIGGER\s`)
+	triggerDefinition  = regexp.MustCompile(`(?is)^\s*CREATE\s+.*?TRIGGER\s+(IF\s+NOT\s+EXISTS\s+)?(\S+)\s+((BEFORE|AFTER|INSTEAD\s+OF)\s+)?((INSERT|UPDATE|DELETE|TRUNCATE)\b.*?)\s+ON\s+(\S+)\s+(.*)$`)
+	endOfNonBeginBlock = regexp.MustCompile(`^(IF|LOOP|WHILE|REPEAT)\b`)
+	executeProcedure   = regexp.MustCompile(`(?i)\bEXECUTE\s+PROCEDURE\b`)
+	createFunction     = regexp.MustCompile(`(?is)^\s*CREATE\s+(OR\s+REPLACE\s+)?FUNCTION\s+([^(\s]+)\s*\(`)
+	dollarQuoteTag     = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+	functionBody       = regexp.MustCompile(`(?is)\bAS\s+(\$([A-Za-z_][A-Za-z0-9_]*)?\$)`)
+	functionReturns    = regexp.MustCompile(`(?is)\bRETURNS\s+(SETOF\s+\S+|TABLE\s*\([^)]*\)|[^\s(]+(\s*\([^)]*\))?(\s*\[\])?(\s+(VARYING|PRECISION|(WITH|WITHOUT)\s+TIME\s+ZONE))?)`)
+	functionLanguage   = regexp.MustCompile(`(?is)\bLANGUAGE\s+'?([A-Za-z0-9_]+)'?`)
+
+	// Attributes which are the default, or are shown differently, by PostgreSQL
+	functionAttributeAliases = map[string]string{
+		"VOLATILE":                   "",
+		"CALLED ON NULL INPUT":       "",
+		"SECURITY INVOKER":           "",
+		"EXTERNAL SECURITY INVOKER":  "",
+		"NOT LEAKPROOF":              "",
+		"PARALLEL UNSAFE":            "",
+		"RETURNS NULL ON NULL INPUT": "STRICT",
+		"EXTERNAL SECURITY DEFINER":  "SECURITY DEFINER",
+	}
+	// The first words of types with spaces, which are not argument names
+	multiWordTypePrefixes = map[string]bool{
+		"bit":       true,
+		"character": true,
+		"double":    true,
+		"interval":  true,
+		"time":      true,
+		"timestamp": true,
+	}
+	functionAttribute = regexp.MustCompile(`(?i)\b(IMMUTABLE|STABLE|VOLATILE|STRICT|CALLED ON NULL INPUT|RETURNS NULL ON NULL INPUT|(EXTERNAL )?SECURITY (INVOKER|DEFINER)|(NOT )?LEAKPROOF|PARALLEL (SAFE|RESTRICTED|UNSAFE)|WINDOW)\b`)
+)
+
+// Parse `CREATE FUNCTION` of Postgres without the parser. Its body is compared as is.
+func parseFunction(ddl string) (*Function, error) {
+	match := createFunction.FindStringSubmatchIndex(ddl)
+	name := normalizePostgresObjectName(ddl[match[4]:match[5]])
+
+	// Find the parenthesis closing the arguments
+	argsStart := match[1]
+	argsEnd := -1
+	depth := 1
+	inString := false
+	for i := argsStart; i < len(ddl) && argsEnd < 0; i++ {
+		switch {
+		case ddl[i] == '\'':
+			inString = !inString
+		case inString:
+		case ddl[i] == '(':
+			depth++
+		case ddl[i] == ')':
+			depth--
+			if depth == 0 {
+				argsEnd = i
+			}
+		}
+	}
+	if argsEnd < 0 {
+		return nil, fmt.Errorf("unterminated arguments of function '%s': %s", name, ddl)
+	}
+	arguments := []string{}
+	for _, argument := range splitTopLevel(ddl[argsStart:argsEnd]) {
+		if argument = normalizeFunctionText(argument); argument != "" {
+			arguments = append(arguments, argument)
+		}
+	}
+
+	// The body is given as a dollar-quoted string
+	options := ddl[argsEnd+1:]
+	bodyMatch := functionBody.FindStringSubmatchIndex(options)
+	if bodyMatch == nil {
+		return nil, fmt.Errorf("a function body must be given as a dollar-quoted string like `AS $$ ... $$`: %s", ddl)
+	}
+	tag := options[bodyMatch[2]:bodyMatch[3]]
+	bodyEnd := strings.Index(options[bodyMatch[3]:], tag)
+	if bodyEnd < 0 {
+		return nil, fmt.Errorf("unterminated body of function '%s': %s", name, ddl)
+	}
+	body := options[bodyMatch[3] : bodyMatch[3]+bodyEnd]
+	options = options[:bodyMatch[0]] + " " + options[bodyMatch[3]+bodyEnd+len(tag):]
+
+	var returns, language string
+	if returnsMatch := functionReturns.FindStringSubmatch(options); returnsMatch != nil {
+		returns = normalizeFunctionText(returnsMatch[1])
+	}
+	if languageMatch := functionLanguage.FindStringSubmatch(options); languageMatch != nil {
+		language = strings.ToLower(languageMatch[1])
+	}
+	attributes := []string{}
+	for _, attribute := range functionAttribute.FindAllString(options, -1) {
+		attribute = strings.ToUpper(strings.Join(strings.Fields(attribute), " "))
+		if alias, ok := functionAttributeAliases[attribute]; ok {
+			attribute = alias
+		}
+		if attribute != "" && !containsString(attributes, attribute) {
+			attributes = append(attributes, attribute)
+		}
+	}
+	sort.Strings(attributes)
+
+	return &Function{
+		statement:  ddl,
+		name:       name,
+		arguments:  arguments,
+		returns:    returns,
+		language:   language,
+		attributes: attributes,
+		body:       strings.TrimSpace(body),
+	}, nil
+}
+
+// Parse `CREATE TRIGGER` without the parser, which doesn't support their bodies. The body is compared as is.
+func parseTrigger(mode GeneratorMode, ddl string) (*Trigger, error) {
+	match := triggerDefinition.FindStringSubmatch(ddl)
+	if match == nil {
+		return nil, fmt.Errorf("unsupported trigger definition: %s", ddl)
+	}
+
+	name, tableName := match[2], match[7]
+	if mode == GeneratorModePostgres {
+		// Triggers belong to the schema of their tables
+		name = strings.TrimPrefix(normalizePostgresObjectName(name), "public.")
+		tableName = normalizePostgresObjectName(tableName)
+	} else {
+		name = unquoteIdentifier(name)
+		tableName = unquoteIdentifier(tableName)
+	}
+
+	timing := strings.ToUpper(strings.Join(strings.Fields(match[4]), " "))
+	if timing == "" {
+		timing = "BEFORE" // SQLite
+	}
+	body := strings.Join(strings.Fields(match[8]), " ")
+	if mode == GeneratorModePostgres {
+		// EXECUTE PROCEDURE is a deprecated alias and FOR EACH STATEMENT is the default
+		body = executeProcedure.ReplaceAllString(body, "EXECUTE FUNCTION")
+		if !strings.HasPrefix(strings.ToUpper(body), "FOR EACH ") {
+			body = "FOR EACH STATEMENT " + body
+		}
+	}
+
+	return &Trigger{
+		statement: ddl,
+		name:      name,
+		tableName: tableName,
+		timing:    timing,
+		events:    strings.ToUpper(strings.Join(strings.Fields(match[5]), " ")),
+		body:      body,
+	}, nil
+}
+
+// Remove quotes of a MySQL, SQLite or MSSQL identifier
+func unquoteIdentifier(name string) string {
+	if len(name) >= 2 && (name[0] == '`' || name[0] == '"' || name[0] == '[') {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+// Qualify a function or table name with `public`, folding unquoted identifiers to lower case like PostgreSQL
+func normalizePostgresObjectName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if strings.HasPrefix(part, `"`) && strings.HasSuffix(part, `"`) {
+			parts[i] = strings.Trim(part, `"`)
+		} else {
+			parts[i] = strings.ToLower(part)
+		}
+	}
+	if len(parts) == 1 {
+		parts = append([]string{"public"}, parts...)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Normalize an argument or a return type: collapse spaces, and lower identifiers and type names outside string literals
+func normalizeFunctionText(text string) string {
+	var builder strings.Builder
+	for i, part := range strings.Split(text, "'") {
+		if i%2 == 1 {
+			builder.WriteString("'" + part + "'")
+			continue
+		}
+		words := strings.Fields(strings.ToLower(part))
+		for j, word := range words {
+			if alias, ok := dataTypeAliases[word]; ok {
+				words[j] = alias
+			}
+		}
+		builder.WriteString(strings.Join(words, " "))
+	}
+	return strings.TrimSpace(builder.String())
+}
+
+// Split `text` with commas out of parentheses and string literals
+func splitTopLevel(text string) []string {
+	parts := []string{}
+	start := 0
+	depth := 0
+	inString := false
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\'':
+			inString = !inString
+		case inString:
+		case text[i] == '(':
+			depth++
+		case text[i] == ')':
+			depth--
+		case text[i] == ',' && depth == 0:
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, text[start:])
+}
+
+var renameAnnotation = regexp.MustCompile("(?m)^\\s*[`\"\\[]?([^`\"\\]\\s]+)[`\"\\]]?\\s[^\n]*--\\s*@renamed(?:\\s+from=|_from\\s+)[`\"\\[]?([^`\"\\]\\s,]+)")
+
+// Comments are dropped by the parser, so find `-- @renamed from=old_name` (or `-- @renamed_from old_name`) annotations
+// on column definition lines from the raw DDL.
+func parseRenameAnnotations(table *Table, ddl string) {
+	for _, match := range renameAnnotation.FindAllStringSubmatch(ddl, -1) {
+		for i, column := range table.columns {
+			if column.name == match[1] {
+				table.columns[i].renamedFrom = match[2]
+			}
+		}
+	}
+}
+
 // Replace pseudo collation "binary" with "{charset}_bin"
 func normalizeCollate(collate string, table sqlparser.TableSpec) string {
 	if collate == "binary" {
@@ -413,6 +1050,101 @@ func normalizedTableName(mode GeneratorMode, tableName sqlparser.TableName) stri
 	return table
 }
 
+// Keep the schema of a referenced table only for the modes whose table names can be qualified.
+func referenceTableName(mode GeneratorMode, name string) string {
+	switch mode {
+	case GeneratorModePostgres, GeneratorModeMssql:
+		return name
+	default:
+		return unqualifiedName(name)
+	}
+}
+
+// Collect names of tables (or views) in FROM clauses of `stmt`
+func parseTableReferences(mode GeneratorMode, stmt sqlparser.SQLNode) []string {
+	tableNames := []string{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if aliasedTableExpr, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if tableName, ok := aliasedTableExpr.Expr.(sqlparser.TableName); ok {
+				tableNames = append(tableNames, normalizedTableName(mode, tableName))
+			}
+		}
+		return true, nil
+	}, stmt)
+	return tableNames
+}
+
+// SQLite table options are given like ` WITHOUT ROWID, STRICT`
+var withoutRowID = regexp.MustCompile(`(?i)\bwithout\s+rowid\b`)
+
+// Postgres `TABLESPACE name` given after storage parameters
+var tablespaceOption = regexp.MustCompile(`(?i)\btablespace\s+(\S+)`)
+
+// Postgres stores storage parameters as given, so booleans are normalized like `autovacuum_enabled=off` is to `false`
+func parseStorageParameters(options []*sqlparser.IndexOption) map[string]string {
+	params := map[string]string{}
+	for _, option := range options {
+		value := string(option.Value.Val)
+		if option.Value.Type == sqlparser.ValBool {
+			value = strings.ToLower(value)
+		}
+		params[strings.ToLower(option.Name)] = value
+	}
+	return params
+}
+
+// Parse MySQL table options like ` ENGINE=InnoDB default charset=utf8mb4` or ` default character set utf8mb4`
+func parseTableOptions(options string) map[string]string {
+	// Split options by spaces and commas out of string literals
+	tokens := []string{}
+	token := ""
+	inString := false
+	for _, c := range options {
+		switch {
+		case c == '\'':
+			inString = !inString
+			token += string(c)
+		case !inString && (c == ' ' || c == ','):
+			if token != "" {
+				tokens = append(tokens, token)
+			}
+			token = ""
+		default:
+			token += string(c)
+		}
+	}
+	if token != "" {
+		tokens = append(tokens, token)
+	}
+
+	result := map[string]string{}
+	words := []string{} // words of an option name before its value
+	for _, token := range tokens {
+		if i := strings.Index(token, "="); i >= 0 && !strings.HasPrefix(token, "'") {
+			result[normalizeTableOptionName(append(words, token[:i]))] = token[i+1:]
+			words = []string{}
+		} else if name := normalizeTableOptionName(words); len(words) > 0 && tableOptionsWithoutEqual[name] {
+			result[name] = token
+			words = []string{}
+		} else {
+			words = append(words, token)
+		}
+	}
+	return result
+}
+
+var tableOptionsWithoutEqual = map[string]bool{"CHARSET": true, "COLLATE": true, "ENGINE": true, "AUTO_INCREMENT": true, "COMMENT": true}
+
+// `DEFAULT CHARACTER SET` is normalized to `CHARSET`
+func normalizeTableOptionName(words []string) string {
+	name := strings.ToUpper(strings.Join(words, " "))
+	name = strings.TrimPrefix(name, "DEFAULT ")
+	if name == "CHARACTER SET" {
+		return "CHARSET"
+	}
+	return name
+}
+
 // TODO: parse charset in parser.y instead of "detecting" it
 func detectCharset(table sqlparser.TableSpec) string {
 	for _, option := range strings.Split(table.Options, " ") {
@@ -424,6 +1156,53 @@ func detectCharset(table sqlparser.TableSpec) string {
 	return ""
 }
 
+// Postgres may wrap the dumped generation expression with extra parentheses
+func parseGeneratedExpr(expr sqlparser.Expr) string {
+	for {
+		parenExpr, ok := expr.(*sqlparser.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = parenExpr.Expr
+	}
+	return sqlparser.String(expr)
+}
+
+// MSSQL `PERSISTED` is normalized to "STORED". Postgres supports only stored generated columns.
+func parseGeneratedKind(mode GeneratorMode, kind string) string {
+	switch strings.ToLower(kind) {
+	case "stored", "persisted":
+		return "STORED"
+	case "virtual":
+		return "VIRTUAL"
+	default:
+		if mode == GeneratorModePostgres {
+			return "STORED"
+		}
+		return "VIRTUAL"
+	}
+}
+
+// Postgres shows a bytea default in the lower-case hex format, e.g. `'\xabcd'::bytea` for `'\xABCD'` and `'\x616263'::bytea` for `'abc'`.
+// Normalize the hex format and the escape format without backslashes to it.
+func normalizeByteaDefault(column *Column) {
+	if strings.ToLower(column.typeName) != "bytea" || column.array || column.defaultDef == nil {
+		return
+	}
+	value := column.defaultDef.value
+	if value == nil || value.valueType != ValueTypeStr {
+		return
+	}
+	if strings.HasPrefix(value.strVal, "\\x") {
+		value.strVal = strings.ToLower(value.strVal)
+	} else if !strings.Contains(value.strVal, "\\") {
+		value.strVal = "\\x" + hex.EncodeToString([]byte(value.strVal))
+	} else {
+		return
+	}
+	value.raw = []byte(value.strVal)
+}
+
 func parseIdentity(opt *sqlparser.IdentityOpt) string {
 	if opt == nil {
 		return ""
@@ -431,7 +1210,22 @@ func parseIdentity(opt *sqlparser.IdentityOpt) string {
 	return strings.ToUpper(opt.Behavior)
 }
 
-func parseDefaultDefinition(opt *sqlparser.DefaultDefinition) *DefaultDefinition {
+func parseDefaultDefinition(mode GeneratorMode, opt *sqlparser.DefaultDefinition) *DefaultDefinition {
+	// Only MySQL distinguishes `DEFAULT (1)` from `DEFAULT 1`. Other databases report it as a literal.
+	if opt != nil && opt.Expr != nil && mode != GeneratorModeMysql {
+		if val, ok := unwrapParen(opt.Expr).(*sqlparser.SQLVal); ok {
+			opt = &sqlparser.DefaultDefinition{Value: val, ConstraintName: opt.ConstraintName}
+		}
+	}
+	if opt != nil && opt.Expr != nil {
+		expression := parseDefaultExpr(opt.Expr)
+		comparable := expression
+		// PostgreSQL reports `concat('a', 'b')` as `concat('a'::text, 'b'::text)`. Keep the expression as written for DDLs.
+		if mode == GeneratorModePostgres {
+			comparable = parseGeneratedExpr(normalizePredicate(opt.Expr))
+		}
+		return &DefaultDefinition{expression: expression, comparable: comparable}
+	}
 	if opt == nil || opt.Value == nil {
 		return nil
 	}
@@ -444,6 +1238,17 @@ func parseDefaultDefinition(opt *sqlparser.DefaultDefinition) *DefaultDefinition
 	return &DefaultDefinition{constraintName: constraintName, value: defaultVal}
 }
 
+// MySQL shows function names of an expression default in lower case, e.g. `DEFAULT (json_array())`
+func parseDefaultExpr(expr sqlparser.Expr) string {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if funcExpr, ok := node.(*sqlparser.FuncExpr); ok {
+			funcExpr.Name = sqlparser.NewColIdent(funcExpr.Name.Lowered())
+		}
+		return true, nil
+	}, expr)
+	return parseGeneratedExpr(expr)
+}
+
 func parseIdentitySequence(opt *sqlparser.IdentityOpt) *Sequence {
 	if opt == nil || opt.Sequence == nil {
 		return nil
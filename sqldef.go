@@ -1,32 +1,73 @@
 package sqldef
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/k0kubun/sqldef/adapter"
 	"github.com/k0kubun/sqldef/schema"
 )
 
 type Options struct {
-	SqlFile  string
-	DryRun   bool
-	Export   bool
-	SkipDrop bool
+	SqlFile              string
+	DryRun               bool
+	Export               bool
+	DumpModel            string
+	Output               string
+	PrintResult          bool
+	SkipDrop             bool
+	AllowUnsafe          bool
+	EnableDropTable      bool
+	UseIfExists          bool
+	NotNullViaCheck      bool
+	TargetTables         []string
+	SkipTables           []string
+	WarnColumnOrder      bool
+	QuoteIdentifiers     string
+	OnlineIndex          bool
+	IndexConcurrently    bool
+	MergeAlterTable      bool
+	IncludeAutoIncrement bool
+	Timeout              time.Duration
 }
 
+// MySQL shows the next value of AUTO_INCREMENT as a table option, which changes with inserted rows
+var autoIncrementOption = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+
 // Main function shared by `mysqldef` and `psqldef`
 func Run(generatorMode schema.GeneratorMode, db adapter.Database, options *Options) {
+	if options.Output == "json" && !options.Export && !options.DryRun {
+		log.Fatal("--output=json is supported only with --dry-run or --export")
+	}
+	if options.Output == "json" && options.PrintResult {
+		log.Fatal("--output=json can't be used with --print-result")
+	}
+
 	currentDDLs, err := adapter.DumpDDLs(db)
 	if err != nil {
 		log.Fatal(fmt.Sprintf("Error on DumpDDLs: %s", err))
 	}
 
 	if options.Export {
-		if currentDDLs == "" {
+		if generatorMode == schema.GeneratorModeMysql && !options.IncludeAutoIncrement {
+			currentDDLs = autoIncrementOption.ReplaceAllString(currentDDLs, "")
+		}
+		if options.Output == "json" {
+			// Statements creating the schema from nothing, whose objects are told by the generator
+			changes, err := schema.GenerateDiff(generatorMode, currentDDLs, "")
+			if err != nil {
+				log.Fatal(err)
+			}
+			showJSONChanges(changes, map[string]bool{})
+		} else if currentDDLs == "" {
 			fmt.Printf("-- No table exists --\n")
 		} else {
 			fmt.Printf("%s;\n", currentDDLs)
@@ -34,46 +75,130 @@ func Run(generatorMode schema.GeneratorMode, db adapter.Database, options *Optio
 		return
 	}
 
+	if options.DumpModel != "" {
+		model, err := schema.ParseModel(generatorMode, currentDDLs)
+		if err != nil {
+			log.Fatal(err)
+		}
+		out, err := json.MarshalIndent(model, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s\n", out)
+		return
+	}
+
 	sql, err := readFile(options.SqlFile)
 	if err != nil {
 		log.Fatalf("Failed to read '%s': %s", options.SqlFile, err)
 	}
 	desiredDDLs := string(sql)
 
-	ddls, err := schema.GenerateIdempotentDDLs(generatorMode, desiredDDLs, currentDDLs)
+	foreignKeysEnabled := false
+	if foreignKeysDatabase, ok := db.(adapter.ForeignKeysDatabase); ok {
+		foreignKeysEnabled, err = foreignKeysDatabase.ForeignKeysEnabled()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	result, err := schema.GenerateIdempotentDDLsWithResult(generatorMode, desiredDDLs, currentDDLs, schema.GeneratorOptions{
+		DropTablesEnabled:  options.EnableDropTable,
+		IdentifierQuoting:  identifierQuoting(options.QuoteIdentifiers),
+		OnlineIndex:        options.OnlineIndex,
+		IndexConcurrently:  options.IndexConcurrently,
+		MergeAlterTable:    options.MergeAlterTable,
+		UseIfExists:        options.UseIfExists,
+		NotNullViaCheck:    options.NotNullViaCheck,
+		SkipDrop:           options.SkipDrop,
+		AllowUnsafe:        options.AllowUnsafe,
+		ForeignKeysEnabled: foreignKeysEnabled,
+		TargetTables:       options.TargetTables,
+		SkipTables:         options.SkipTables,
+	})
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
+	// Keep JSON on stdout parseable
+	messages := os.Stdout
+	if options.Output == "json" {
+		messages = os.Stderr
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(messages, "-- Warning: %s --\n", warning)
+	}
+	if options.WarnColumnOrder {
+		for _, warning := range result.ColumnOrderWarnings {
+			fmt.Fprintf(messages, "-- Warning: %s --\n", warning)
+		}
+	}
+	for _, table := range result.SkippedDropTables {
+		fmt.Fprintf(messages, "-- Skipped drop of table %s\n", table)
+	}
+	for _, domain := range result.SkippedDropDomains {
+		fmt.Fprintf(messages, "-- Skipped drop of domain %s\n", domain)
+	}
+	if options.PrintResult {
+		fmt.Println("-- result --")
+		if len(result.Schema) > 0 {
+			fmt.Printf("%s;\n", strings.Join(result.Schema, ";\n\n"))
+		}
+		return
+	}
+
+	ddls := result.DDLs
+	skipped := func(ddl string) bool {
+		return result.SkippedDDLs[ddl]
+	}
+
+	if options.Output == "json" {
+		showJSONChanges(result.ChangeList(), result.SkippedDDLs)
+		return
+	}
+
 	if len(ddls) == 0 {
 		fmt.Println("-- Nothing is modified --")
 		return
 	}
 
 	if options.DryRun {
-		showDDLs(ddls, options.SkipDrop)
+		showDDLs(ddls, skipped)
 		return
 	}
 
-	err = adapter.RunDDLs(db, ddls, options.SkipDrop)
+	ctx := context.Background()
+	if options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, options.Timeout)
+		defer cancel()
+	}
+
+	nonTransactional := func(ddl string) bool {
+		return result.NonTransactionalDDLs[ddl]
+	}
+
+	err = adapter.RunDDLs(ctx, db, ddls, skipped, nonTransactional)
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
-func readFile(filepath string) (string, error) {
+func readFile(filename string) (string, error) {
 	var err error
 	var buf []byte
 
-	if filepath == "-" {
+	if filename == "-" {
 		stat, _ := os.Stdin.Stat()
 		if (stat.Mode() & os.ModeCharDevice) != 0 {
 			return "", fmt.Errorf("stdin is not piped")
 		}
 
 		buf, err = ioutil.ReadAll(os.Stdin)
+	} else if stat, statErr := os.Stat(filename); statErr == nil && stat.IsDir() {
+		return readDirectory(filename)
 	} else {
-		buf, err = ioutil.ReadFile(filepath)
+		buf, err = ioutil.ReadFile(filename)
 	}
 
 	if err != nil {
@@ -82,13 +207,64 @@ func readFile(filepath string) (string, error) {
 	return string(buf), nil
 }
 
-func showDDLs(ddls []string, skipDrop bool) {
+// Concatenate *.sql files in the directory in lexicographic order of their names
+func readDirectory(dir string) (string, error) {
+	files, err := ioutil.ReadDir(dir) // sorted by name
+	if err != nil {
+		return "", err
+	}
+	sqls := []string{}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".sql" {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return "", err
+		}
+		sqls = append(sqls, string(buf))
+	}
+	if len(sqls) == 0 {
+		return "", fmt.Errorf("no *.sql file is found in the directory")
+	}
+	// Terminate the last statement of each file, which may lack `;` or end with a comment
+	return strings.Join(sqls, "\n;\n"), nil
+}
+
+func showDDLs(ddls []string, skipped func(string) bool) {
 	fmt.Println("-- dry run --")
 	for _, ddl := range ddls {
-		if skipDrop && strings.Contains(ddl, "DROP") {
+		if skipped(ddl) {
 			fmt.Printf("-- Skipped: %s;\n", ddl)
 			continue
 		}
 		fmt.Printf("%s;\n", ddl)
 	}
 }
+
+// A statement of `--output=json`
+type jsonChange struct {
+	schema.Change
+	Skipped bool `json:"skipped,omitempty"` // not run without --allow-unsafe, or with --skip-drop
+}
+
+func showJSONChanges(changes []schema.Change, skippedDDLs map[string]bool) {
+	jsonChanges := []jsonChange{}
+	for _, change := range changes {
+		jsonChanges = append(jsonChanges, jsonChange{Change: change, Skipped: skippedDDLs[change.Statement]})
+	}
+	out, err := json.MarshalIndent(jsonChanges, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", out)
+}
+
+func identifierQuoting(policy string) schema.IdentifierQuoting {
+	switch policy {
+	case "necessary":
+		return schema.QuoteWhenNecessary
+	default:
+		return schema.QuoteAlways
+	}
+}
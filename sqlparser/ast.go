@@ -670,6 +670,10 @@ type DDL struct {
 	ForeignKey    *ForeignKeyDefinition
 	Policy        *Policy
 	View          *View
+	Type          *Type
+	Domain        *Domain
+	ColumnComment *ColumnComment
+	TableComment  *TableComment
 }
 
 // DDL strings.
@@ -688,6 +692,10 @@ const (
 	AddForeignKeyStr = "add foreign key"
 	CreatePolicyStr  = "create policy"
 	CreateViewStr    = "create view"
+	CreateTypeStr    = "create type"
+	CreateDomainStr  = "create domain"
+	CommentStr       = "comment"
+	ClusterOnStr     = "cluster on"
 
 	// Vindex DDL param to specify the owner of a vindex
 	VindexOwnerStr = "owner"
@@ -719,7 +727,15 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 	case CreateVindexStr:
 		buf.Myprintf("%s %v %v", node.Action, node.VindexSpec.Name, node.VindexSpec)
 	case CreateViewStr:
-		buf.Myprintf("%s %v as %v", node.Action, node.View.Name, node.View.Definition)
+		if node.View.Materialized {
+			buf.Myprintf("create materialized view %v as %v", node.View.Name, node.View.Definition)
+		} else {
+			buf.Myprintf("%s %v as %v", node.Action, node.View.Name, node.View.Definition)
+		}
+	case CreateTypeStr:
+		buf.Myprintf("%s %v as enum (%s)", node.Action, node.Type.Name, strings.Join(node.Type.EnumValues, ", "))
+	case CreateDomainStr:
+		buf.Myprintf("%s %v as %v", node.Action, node.Domain.Name, &node.Domain.Type)
 	case AddColVindexStr:
 		buf.Myprintf("alter table %v %s %v (", node.Table, node.Action, node.VindexSpec.Name)
 		for i, col := range node.VindexCols {
@@ -735,6 +751,20 @@ func (node *DDL) Format(buf *TrackedBuffer) {
 		}
 	case DropColVindexStr:
 		buf.Myprintf("alter table %v %s %v", node.Table, node.Action, node.VindexSpec.Name)
+	case ClusterOnStr:
+		buf.Myprintf("alter table %v %s %v", node.Table, node.Action, node.IndexSpec.Name)
+	case CommentStr:
+		if node.TableComment != nil {
+			if node.TableComment.Comment == nil {
+				buf.Myprintf("%s on table %v is null", node.Action, node.Table)
+			} else {
+				buf.Myprintf("%s on table %v is %v", node.Action, node.Table, node.TableComment.Comment)
+			}
+		} else if node.ColumnComment.Comment == nil {
+			buf.Myprintf("%s on column %v.%v is null", node.Action, node.Table, node.ColumnComment.Column)
+		} else {
+			buf.Myprintf("%s on column %v.%v is %v", node.Action, node.Table, node.ColumnComment.Column, node.ColumnComment.Comment)
+		}
 	default:
 		buf.Myprintf("%s table %v", node.Action, node.Table)
 	}
@@ -823,10 +853,15 @@ func (node *PartitionDefinition) walkSubtree(visit Visit) error {
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns     []*ColumnDefinition
-	Indexes     []*IndexDefinition
-	ForeignKeys []*ForeignKeyDefinition
-	Options     string
+	Columns           []*ColumnDefinition
+	Indexes           []*IndexDefinition
+	ForeignKeys       []*ForeignKeyDefinition
+	Checks            []*CheckDefinition
+	Exclusions        []*ExclusionDefinition
+	Options           string
+	PartitionBy       *PartitionBy
+	Inherits          TableNames
+	StorageParameters []*IndexOption // PostgreSQL `WITH (...)`
 }
 
 // Format formats the node.
@@ -842,8 +877,49 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	for _, idx := range ts.Indexes {
 		buf.Myprintf(",\n\t%v", idx)
 	}
+	for _, check := range ts.Checks {
+		buf.Myprintf(",\n\t%v", check)
+	}
+	for _, exclusion := range ts.Exclusions {
+		buf.Myprintf(",\n\t%v", exclusion)
+	}
 
-	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	buf.Myprintf("\n)")
+	if len(ts.StorageParameters) > 0 {
+		buf.Myprintf(" with (")
+		for i, param := range ts.StorageParameters {
+			if i > 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%s = %v", param.Name, param.Value)
+		}
+		buf.Myprintf(")")
+	}
+	buf.Myprintf("%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
+	if ts.PartitionBy != nil {
+		buf.Myprintf(" %v", ts.PartitionBy)
+	}
+	if len(ts.Inherits) > 0 {
+		buf.Myprintf(" inherits (%v)", ts.Inherits)
+	}
+}
+
+// PartitionBy represents a PARTITION BY clause of CREATE TABLE in PostgreSQL
+type PartitionBy struct {
+	Type  string // range, list or hash
+	Exprs Exprs
+}
+
+// Format formats the node.
+func (pb *PartitionBy) Format(buf *TrackedBuffer) {
+	buf.Myprintf("partition by %s (%v)", pb.Type, pb.Exprs)
+}
+
+func (pb *PartitionBy) walkSubtree(visit Visit) error {
+	if pb == nil {
+		return nil
+	}
+	return Walk(visit, pb.Exprs)
 }
 
 // AddColumn appends the given column to the list in the spec
@@ -860,6 +936,16 @@ func (ts *TableSpec) AddForeignKey(foreignKey *ForeignKeyDefinition) {
 	ts.ForeignKeys = append(ts.ForeignKeys, foreignKey)
 }
 
+// AddCheck appends the given table-level check constraint to the list in the spec
+func (ts *TableSpec) AddCheck(check *CheckDefinition) {
+	ts.Checks = append(ts.Checks, check)
+}
+
+// AddExclusion appends the given exclusion constraint to the list in the spec
+func (ts *TableSpec) AddExclusion(exclusion *ExclusionDefinition) {
+	ts.Exclusions = append(ts.Exclusions, exclusion)
+}
+
 func (ts *TableSpec) walkSubtree(visit Visit) error {
 	if ts == nil {
 		return nil
@@ -877,7 +963,19 @@ func (ts *TableSpec) walkSubtree(visit Visit) error {
 		}
 	}
 
-	return nil
+	for _, n := range ts.Checks {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	for _, n := range ts.Exclusions {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
+	return Walk(visit, ts.PartitionBy)
 }
 
 // ColumnDefinition describes a column in a CREATE TABLE statement
@@ -942,6 +1040,7 @@ type ColumnType struct {
 	OnUpdate       *SQLVal
 	Comment        *SQLVal
 	Check          *CheckDefinition
+	Checks         []*CheckDefinition // every CHECK in order, which a domain may have several of
 	CheckNoInherit BoolVal
 	Array          BoolVal
 
@@ -970,16 +1069,87 @@ type ColumnType struct {
 
 	// GENERATED AS IDENTITY
 	Identity *IdentityOpt
+
+	// GENERATED ALWAYS AS (expr) [STORED | VIRTUAL], or AS (expr) [PERSISTED] for SQL Server
+	Generated *GeneratedColumn
+}
+
+type GeneratedColumn struct {
+	Expr Expr
+	Type string // "stored", "virtual", "persisted" or empty
 }
 
 type DefaultDefinition struct {
 	Value          *SQLVal
+	Expr           Expr     // for MySQL's expression default like `DEFAULT (JSON_ARRAY())`
 	ConstraintName ColIdent // only for MSSQL
 }
 
 type CheckDefinition struct {
 	Where          Where
 	ConstraintName ColIdent
+	NoInherit      BoolVal // only for a table-level check. A column-level one has ColumnType.CheckNoInherit.
+}
+
+// Format formats the node as a table-level check constraint.
+func (cd *CheckDefinition) Format(buf *TrackedBuffer) {
+	if !cd.ConstraintName.IsEmpty() {
+		buf.Myprintf("constraint %v ", cd.ConstraintName)
+	}
+	buf.Myprintf("check (%v)", cd.Where.Expr)
+	if cd.NoInherit {
+		buf.Myprintf(" no inherit")
+	}
+}
+
+func (cd *CheckDefinition) walkSubtree(visit Visit) error {
+	if cd == nil {
+		return nil
+	}
+	return Walk(visit, cd.Where.Expr)
+}
+
+// ExclusionDefinition represents an EXCLUDE constraint of PostgreSQL
+type ExclusionDefinition struct {
+	ConstraintName ColIdent
+	IndexType      string
+	Elements       []ExclusionElement
+	Where          Expr
+}
+
+// ExclusionElement represents `column WITH operator` of an EXCLUDE constraint
+type ExclusionElement struct {
+	Column   ColIdent
+	Operator string
+}
+
+// Format formats the node.
+func (ed *ExclusionDefinition) Format(buf *TrackedBuffer) {
+	if !ed.ConstraintName.IsEmpty() {
+		buf.Myprintf("constraint %v ", ed.ConstraintName)
+	}
+	buf.Myprintf("exclude ")
+	if ed.IndexType != "" {
+		buf.Myprintf("using %s ", ed.IndexType)
+	}
+	buf.Myprintf("(")
+	for i, element := range ed.Elements {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v with %s", element.Column, element.Operator)
+	}
+	buf.Myprintf(")")
+	if ed.Where != nil {
+		buf.Myprintf(" where %v", ed.Where)
+	}
+}
+
+func (ed *ExclusionDefinition) walkSubtree(visit Visit) error {
+	if ed == nil {
+		return nil
+	}
+	return Walk(visit, ed.Where)
 }
 
 // Format returns a canonical string representation of the type and all relevant options
@@ -1016,7 +1186,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.NotNull != nil && *ct.NotNull {
 		opts = append(opts, keywordStrings[NOT], keywordStrings[NULL])
 	}
-	if ct.Default != nil {
+	if ct.Default != nil && ct.Default.Expr != nil {
+		opts = append(opts, keywordStrings[DEFAULT], "("+String(ct.Default.Expr)+")")
+	} else if ct.Default != nil {
 		opts = append(opts, keywordStrings[DEFAULT], String(ct.Default.Value))
 	}
 	if ct.OnUpdate != nil {
@@ -1028,12 +1200,22 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Comment != nil {
 		opts = append(opts, keywordStrings[COMMENT_KEYWORD], String(ct.Comment))
 	}
-	if ct.Check != nil {
+	if len(ct.Checks) > 0 {
+		for _, check := range ct.Checks {
+			opts = append(opts, keywordStrings[CHECK], String(&check.Where))
+		}
+	} else if ct.Check != nil {
 		opts = append(opts, keywordStrings[CHECK], String(&ct.Check.Where))
 	}
 	if ct.CheckNoInherit {
 		opts = append(opts, keywordStrings[NO], keywordStrings[INHERIT])
 	}
+	if ct.Generated != nil {
+		opts = append(opts, keywordStrings[GENERATED], keywordStrings[ALWAYS], keywordStrings[AS], "("+String(ct.Generated.Expr)+")")
+		if ct.Generated.Type != "" {
+			opts = append(opts, ct.Generated.Type)
+		}
+	}
 	if ct.KeyOpt == colKeyPrimary {
 		opts = append(opts, keywordStrings[PRIMARY], keywordStrings[KEY])
 	}
@@ -1188,6 +1370,7 @@ func (ct *ColumnType) walkSubtree(visit Visit) error {
 type IndexDefinition struct {
 	Info    *IndexInfo
 	Columns []IndexColumn
+	Include []ColIdent
 	Options []*IndexOption
 }
 
@@ -1196,16 +1379,33 @@ func (idx *IndexDefinition) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v (", idx.Info)
 	for i, col := range idx.Columns {
 		if i != 0 {
-			buf.Myprintf(", %v", col.Column)
+			buf.Myprintf(", ")
+		}
+		if col.Expression != nil {
+			buf.Myprintf("(%v)", col.Expression)
 		} else {
 			buf.Myprintf("%v", col.Column)
+			if col.Length != nil {
+				buf.Myprintf("(%v)", col.Length)
+			}
 		}
-		if col.Length != nil {
-			buf.Myprintf("(%v)", col.Length)
+		if col.Collate != "" {
+			buf.Myprintf(" collate %s", col.Collate)
 		}
 	}
 	buf.Myprintf(")")
 
+	if len(idx.Include) > 0 {
+		buf.Myprintf(" include (")
+		for i, col := range idx.Include {
+			if i != 0 {
+				buf.Myprintf(", ")
+			}
+			buf.Myprintf("%v", col)
+		}
+		buf.Myprintf(")")
+	}
+
 	for _, opt := range idx.Options {
 		buf.Myprintf(" %s", opt.Name)
 		if opt.Using != "" {
@@ -1232,18 +1432,21 @@ func (idx *IndexDefinition) walkSubtree(visit Visit) error {
 
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
-	Type      string
-	Name      ColIdent
-	Primary   bool
-	Spatial   bool
-	Unique    bool
-	Fulltext  bool
-	Clustered BoolVal
+	Type       string
+	Name       ColIdent
+	Primary    bool
+	Spatial    bool
+	Unique     bool
+	Fulltext   bool
+	Clustered  BoolVal
+	Constraint bool // for SQL Server `CONSTRAINT name UNIQUE`
 }
 
 // Format formats the node.
 func (ii *IndexInfo) Format(buf *TrackedBuffer) {
-	if ii.Primary {
+	if ii.Constraint {
+		buf.Myprintf("constraint %v %s", ii.Name, ii.Type)
+	} else if ii.Primary {
 		buf.Myprintf("%s", ii.Type)
 	} else {
 		buf.Myprintf("%s %v", ii.Type, ii.Name)
@@ -1256,8 +1459,23 @@ func (ii *IndexInfo) walkSubtree(visit Visit) error {
 
 // IndexColumn describes a column in an index definition with optional length
 type IndexColumn struct {
-	Column ColIdent
-	Length *SQLVal
+	Column     ColIdent
+	Length     *SQLVal
+	Expression Expr // for a functional index. Column is empty then.
+	Collate    string
+}
+
+// Make an IndexColumn from `name(...)`, which is a column with a prefix length if it has only an integer.
+// Otherwise, it's a function call of PostgreSQL's functional index.
+func newIndexColumn(expr Expr) IndexColumn {
+	if funcExpr, ok := expr.(*FuncExpr); ok && funcExpr.Qualifier.IsEmpty() && !funcExpr.Distinct && len(funcExpr.Exprs) == 1 {
+		if aliased, ok := funcExpr.Exprs[0].(*AliasedExpr); ok {
+			if val, ok := aliased.Expr.(*SQLVal); ok && val.Type == IntVal {
+				return IndexColumn{Column: funcExpr.Name, Length: val}
+			}
+		}
+	}
+	return IndexColumn{Expression: expr}
 }
 
 // LengthScaleOption is used for types that have an optional length
@@ -1288,11 +1506,13 @@ const (
 )
 
 type IndexSpec struct {
-	Name    ColIdent
-	Type    ColIdent
-	Unique  bool
-	Primary bool
-	Where   *Where
+	Name      ColIdent
+	Type      ColIdent
+	Unique    bool
+	Primary   bool
+	Clustered BoolVal // for SQL Server
+	Include   []ColIdent
+	Where     *Where
 }
 
 // VindexSpec defines a vindex for a CREATE VINDEX or DROP VINDEX statement
@@ -1372,13 +1592,15 @@ func (node VindexParam) walkSubtree(visit Visit) error {
 }
 
 type ForeignKeyDefinition struct {
-	ConstraintName   ColIdent
-	IndexName        ColIdent
-	IndexColumns     []ColIdent
-	ReferenceName    ColIdent
-	ReferenceColumns []ColIdent
-	OnDelete         ColIdent
-	OnUpdate         ColIdent
+	ConstraintName    ColIdent
+	IndexName         ColIdent
+	IndexColumns      []ColIdent
+	ReferenceName     ColIdent
+	ReferenceColumns  []ColIdent
+	OnDelete          ColIdent
+	OnUpdate          ColIdent
+	Deferrable        BoolVal
+	InitiallyDeferred BoolVal
 }
 
 type Policy struct {
@@ -1390,6 +1612,17 @@ type Policy struct {
 	WithCheck  *Where
 }
 
+// ColumnComment represents Postgres `COMMENT ON COLUMN`. Comment is nil for `IS NULL`.
+type ColumnComment struct {
+	Column  ColIdent
+	Comment *SQLVal
+}
+
+// TableComment represents Postgres `COMMENT ON TABLE`. Comment is nil for `IS NULL`.
+type TableComment struct {
+	Comment *SQLVal
+}
+
 type Permissive string
 
 const (
@@ -1572,9 +1805,22 @@ func (node Comments) walkSubtree(visit Visit) error {
 }
 
 type View struct {
-	Action     string
+	Action       string
+	Name         TableName
+	Definition   SelectStatement
+	Materialized bool // for Postgres `CREATE MATERIALIZED VIEW`
+}
+
+// Type represents a user-defined type of PostgreSQL. Only ENUM is supported.
+type Type struct {
 	Name       TableName
-	Definition SelectStatement
+	EnumValues []string
+}
+
+// Domain represents PostgreSQL's CREATE DOMAIN, whose constraints are given like a column's.
+type Domain struct {
+	Name TableName
+	Type ColumnType
 }
 
 // SelectExprs represents SELECT expressions.
@@ -1867,6 +2113,14 @@ func (node TableName) IsEmpty() bool {
 	return node.Name.IsEmpty()
 }
 
+// QualifiedName returns the name with its qualifier if any, like `schema.table`, without quoting.
+func (node TableName) QualifiedName() string {
+	if node.Qualifier.IsEmpty() {
+		return node.Name.String()
+	}
+	return node.Qualifier.String() + "." + node.Name.String()
+}
+
 // ToViewName returns a TableName acceptable for use as a VIEW. VIEW names are
 // always lowercase, so ToViewName lowercasese the name. Databases are case-sensitive
 // so Qualifier is left untouched.
@@ -2243,6 +2497,7 @@ const (
 	NotLikeStr           = "not like"
 	RegexpStr            = "regexp"
 	NotRegexpStr         = "not regexp"
+	PosixRegexpStr       = "~" // PostgreSQL
 	JSONExtractOp        = "->"
 	JSONUnquoteExtractOp = "->>"
 )
@@ -2403,8 +2658,9 @@ const (
 
 // SQLVal represents a single value.
 type SQLVal struct {
-	Type ValType
-	Val  []byte
+	Type               ValType
+	Val                []byte
+	NoBackslashEscapes bool // for PostgreSQL strings
 }
 
 // NewStrVal builds a new StrVal.
@@ -2459,8 +2715,12 @@ func NewValArgWithOpt(in []byte, opt *SQLVal) *SQLVal {
 func (node *SQLVal) Format(buf *TrackedBuffer) {
 	switch node.Type {
 	case StrVal:
-		sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
-	case IntVal, FloatVal, HexNum:
+		if node.NoBackslashEscapes {
+			buf.Myprintf("'%s'", bytes.ReplaceAll(node.Val, []byte("'"), []byte("''")))
+		} else {
+			sqltypes.MakeTrusted(sqltypes.VarBinary, node.Val).EncodeSQL(buf)
+		}
+	case IntVal, FloatVal, HexNum, ValBool:
 		buf.Myprintf("%s", []byte(node.Val))
 	case HexVal:
 		buf.Myprintf("X'%s'", []byte(node.Val))
@@ -2468,8 +2728,6 @@ func (node *SQLVal) Format(buf *TrackedBuffer) {
 		buf.Myprintf("B'%s'", []byte(node.Val))
 	case ValArg:
 		buf.WriteArg(string(node.Val))
-	case ValBool:
-		buf.Myprintf("%t", node.Val)
 	default:
 		panic("unexpected")
 	}
@@ -2955,12 +3213,17 @@ func (node *SubstrExpr) walkSubtree(visit Visit) error {
 // ConvertExpr represents a call to CONVERT(expr, type)
 // or it's equivalent CAST(expr AS type). Both are rewritten to the former.
 type ConvertExpr struct {
-	Expr Expr
-	Type *ConvertType
+	Expr     Expr
+	Type     *ConvertType
+	TypeCast bool // for PostgreSQL `expr::type`
 }
 
 // Format formats the node.
 func (node *ConvertExpr) Format(buf *TrackedBuffer) {
+	if node.TypeCast {
+		buf.Myprintf("%v::%v", node.Expr, node.Type)
+		return
+	}
 	buf.Myprintf("convert(%v, %v)", node.Expr, node.Type)
 }
 
@@ -317,6 +317,12 @@ var (
 		input: "select /* not like */ 1 from t where a not like b",
 	}, {
 		input: "select /* not like escape */ 1 from t where a not like b escape '$'",
+	}, {
+		input:  "select /* postgres like */ 1 from t where a ~~ b",
+		output: "select /* postgres like */ 1 from t where a like b",
+	}, {
+		input:  "select /* postgres not like */ 1 from t where a !~~ b",
+		output: "select /* postgres not like */ 1 from t where a not like b",
 	}, {
 		input: "select /* regexp */ 1 from t where a regexp b",
 	}, {
@@ -829,6 +835,8 @@ var (
 	}, {
 		input:  "alter table a partition by range (id) (partition p0 values less than (10), partition p1 values less than (maxvalue))",
 		output: "alter table a",
+	}, {
+		input: "alter table a cluster on idx",
 	}, {
 		input:  "alter table a add column id int",
 		output: "alter table a",
@@ -951,6 +959,17 @@ var (
 	}, {
 		input:  "create view a",
 		output: "create table a",
+	}, {
+		input: "create type mood as enum ('sad', 'ok', 'happy')",
+	}, {
+		input:  "CREATE TYPE public.mood AS ENUM ('sad')",
+		output: "create type public.mood as enum ('sad')",
+	}, {
+		input:  "CREATE DOMAIN email AS text NOT NULL CHECK (VALUE ~ '@')",
+		output: "create domain email as text not null check (value ~ '@')",
+	}, {
+		input:  "create domain positive_int as integer default 1 constraint positive check (value > 0)",
+		output: "create domain positive_int as integer default 1 check (value > 0)",
 	}, {
 		input:  "create or replace view a",
 		output: "create table a",
@@ -1613,6 +1632,12 @@ func TestConvert(t *testing.T) {
 	}, {
 		input:  "select convert('abc', decimal(4+9)) from t",
 		output: "syntax error at position 33",
+	}, {
+		input:  "create foo mood as enum ('a')",
+		output: "syntax error at position 30",
+	}, {
+		input:  "create type mood as integer",
+		output: "syntax error at position 28",
 	}}
 
 	for _, tcase := range invalidSQL {
@@ -1820,6 +1845,30 @@ func TestCreateTable(t *testing.T) {
 			"	key by_email (email(10), username)\n" +
 			")",
 
+		// functional indexes
+		"create table t (\n" +
+			"	id int auto_increment,\n" +
+			"	email varchar,\n" +
+			"	unique key by_lower_email ((lower(email))),\n" +
+			"	key by_email_id ((lower(email)), id)\n" +
+			")",
+
+		// collations of index columns
+		"create table t (\n" +
+			"	id int,\n" +
+			"	email varchar,\n" +
+			"	key by_email (email collate utf8mb4_bin, id),\n" +
+			"	key by_lower_email ((lower(email)) collate utf8mb4_bin)\n" +
+			")",
+
+		// covering indexes
+		"create table t (\n" +
+			"	id int,\n" +
+			"	email varchar,\n" +
+			"	name varchar,\n" +
+			"	index by_email (email) include (id, name)\n" +
+			")",
+
 		// table options
 		"create table t (\n" +
 			"	id int auto_increment\n" +
@@ -1850,6 +1899,21 @@ func TestCreateTable(t *testing.T) {
 			"  stats_sample_pages 1,\n" +
 			"  tablespace tablespace_name storage disk,\n" +
 			"  tablespace tablespace_name\n",
+
+		// inherited tables
+		"create table t (\n" +
+			"	id int\n" +
+			") inherits (parent, s.other)",
+
+		// storage parameters
+		"create table t (\n" +
+			"	id int\n" +
+			") with (fillfactor = 70, autovacuum_enabled = false, autovacuum_vacuum_scale_factor = 0.2) tablespace fast",
+
+		// expression defaults
+		"create table t (\n" +
+			"	doc json default (json_array())\n" +
+			")",
 	}
 	for _, sql := range validSQL {
 		sql = strings.TrimSpace(sql)
@@ -1909,6 +1973,51 @@ func TestCreateTable(t *testing.T) {
 	}
 }
 
+func TestCreateTablePostgres(t *testing.T) {
+	validSQL := []string{
+		// user-defined types
+		"create table t (\n" +
+			"	id int,\n" +
+			"	current_mood mood\n" +
+			")",
+
+		// exclusion constraints
+		"create table t (\n" +
+			"	room int,\n" +
+			"	during tsrange,\n" +
+			"	constraint t_excl exclude using gist (room with =, during with &&) where (room > 0)\n" +
+			")",
+
+		// backslashes aren't escaped in standard strings
+		"create table t (\n" +
+			"	code text,\n" +
+			"	name text,\n" +
+			"	check (code ~ '^\\d+$'),\n" +
+			"	check (name like 'a\\_%' and name != 'it''s')\n" +
+			")",
+	}
+	for _, sql := range validSQL {
+		sql = strings.TrimSpace(sql)
+		tree, err := ParseStrictDDLWithMode(sql, ParserModePostgres)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", sql, err)
+			continue
+		}
+		got := String(tree.(*DDL))
+
+		if sql != got {
+			t.Errorf("want:\n%s\ngot:\n%s", sql, got)
+		}
+	}
+
+	// User-defined types are only available in PostgreSQL
+	sql := "create table t (\n\tcurrent_mood mood\n)"
+	tree, err := ParseStrictDDL(sql)
+	if tree != nil || err == nil {
+		t.Errorf("ParseStrictDDL unexpectedly accepted input %s", sql)
+	}
+}
+
 func TestCreateTableEscaped(t *testing.T) {
 	testCases := []struct {
 		input  string
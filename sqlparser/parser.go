@@ -31,6 +31,13 @@ func decNesting(yylex interface{}) {
 	yylex.(*Tokenizer).nesting--
 }
 
+// PostgreSQL's standard string doesn't escape a backslash. Keep it as is when the string is formatted.
+func newStringVal(yylex interface{}, in []byte) *SQLVal {
+	val := NewStrVal(in)
+	val.NoBackslashEscapes = yylex.(*Tokenizer).mode == ParserModePostgres
+	return val
+}
+
 // forceEOF forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling forceEOF will make the lexer
 // return EOF early.
@@ -38,7 +45,7 @@ func forceEOF(yylex interface{}) {
 	yylex.(*Tokenizer).ForceEOF = true
 }
 
-//line sqlparser/parser.y:53
+//line sqlparser/parser.y:60
 type yySymType struct {
 	yys                  int
 	empty                struct{}
@@ -96,6 +103,10 @@ type yySymType struct {
 	indexColumn          IndexColumn
 	indexColumns         []IndexColumn
 	foreignKeyDefinition *ForeignKeyDefinition
+	checkDefinition      *CheckDefinition
+	exclusionDefinition  *ExclusionDefinition
+	exclusionElement     ExclusionElement
+	exclusionElements    []ExclusionElement
 	partDefs             []*PartitionDefinition
 	partDef              *PartitionDefinition
 	partSpec             *PartitionSpec
@@ -117,72 +128,72 @@ const WHERE = 57354
 const GROUP = 57355
 const HAVING = 57356
 const ORDER = 57357
-const BY = 57358
-const LIMIT = 57359
-const OFFSET = 57360
-const FOR = 57361
-const ALL = 57362
-const DISTINCT = 57363
-const AS = 57364
-const EXISTS = 57365
-const ASC = 57366
-const DESC = 57367
-const INTO = 57368
-const DUPLICATE = 57369
-const DEFAULT = 57370
-const SET = 57371
-const LOCK = 57372
-const KEYS = 57373
-const VALUES = 57374
-const LAST_INSERT_ID = 57375
-const NEXT = 57376
-const VALUE = 57377
-const SHARE = 57378
-const MODE = 57379
-const SQL_NO_CACHE = 57380
-const SQL_CACHE = 57381
-const JOIN = 57382
-const STRAIGHT_JOIN = 57383
-const LEFT = 57384
-const RIGHT = 57385
-const INNER = 57386
-const OUTER = 57387
-const CROSS = 57388
-const NATURAL = 57389
-const USE = 57390
-const FORCE = 57391
-const ON = 57392
-const USING = 57393
-const ID = 57394
-const HEX = 57395
-const STRING = 57396
-const INTEGRAL = 57397
-const FLOAT = 57398
-const HEXNUM = 57399
-const VALUE_ARG = 57400
-const LIST_ARG = 57401
-const COMMENT = 57402
-const COMMENT_KEYWORD = 57403
-const BIT_LITERAL = 57404
-const NULL = 57405
-const TRUE = 57406
-const FALSE = 57407
-const OFF = 57408
-const OR = 57409
-const AND = 57410
-const NOT = 57411
-const BETWEEN = 57412
-const CASE = 57413
-const WHEN = 57414
-const THEN = 57415
-const ELSE = 57416
-const END = 57417
-const LE = 57418
-const GE = 57419
-const NE = 57420
-const NULL_SAFE_EQUAL = 57421
-const IS = 57422
-const LIKE = 57423
+const LIMIT = 57358
+const OFFSET = 57359
+const FOR = 57360
+const ALL = 57361
+const DISTINCT = 57362
+const AS = 57363
+const EXISTS = 57364
+const ASC = 57365
+const DESC = 57366
+const INTO = 57367
+const DUPLICATE = 57368
+const DEFAULT = 57369
+const SET = 57370
+const LOCK = 57371
+const KEYS = 57372
+const VALUES = 57373
+const LAST_INSERT_ID = 57374
+const NEXT = 57375
+const VALUE = 57376
+const SHARE = 57377
+const MODE = 57378
+const SQL_NO_CACHE = 57379
+const SQL_CACHE = 57380
+const JOIN = 57381
+const STRAIGHT_JOIN = 57382
+const LEFT = 57383
+const RIGHT = 57384
+const INNER = 57385
+const OUTER = 57386
+const CROSS = 57387
+const NATURAL = 57388
+const USE = 57389
+const FORCE = 57390
+const ON = 57391
+const USING = 57392
+const ID = 57393
+const HEX = 57394
+const STRING = 57395
+const INTEGRAL = 57396
+const FLOAT = 57397
+const HEXNUM = 57398
+const VALUE_ARG = 57399
+const LIST_ARG = 57400
+const COMMENT = 57401
+const COMMENT_KEYWORD = 57402
+const BIT_LITERAL = 57403
+const NULL = 57404
+const TRUE = 57405
+const FALSE = 57406
+const OFF = 57407
+const OR = 57408
+const AND = 57409
+const NOT = 57410
+const BETWEEN = 57411
+const CASE = 57412
+const WHEN = 57413
+const THEN = 57414
+const ELSE = 57415
+const END = 57416
+const LE = 57417
+const GE = 57418
+const NE = 57419
+const NULL_SAFE_EQUAL = 57420
+const IS = 57421
+const LIKE = 57422
+const NOT_LIKE = 57423
 const REGEXP = 57424
 const IN = 57425
 const SHIFT_LEFT = 57426
@@ -229,167 +240,181 @@ const REPAIR = 57466
 const OPTIMIZE = 57467
 const TRUNCATE = 57468
 const MAXVALUE = 57469
-const PARTITION = 57470
-const REORGANIZE = 57471
-const LESS = 57472
-const THAN = 57473
-const PROCEDURE = 57474
-const TRIGGER = 57475
-const VINDEX = 57476
-const VINDEXES = 57477
-const STATUS = 57478
-const VARIABLES = 57479
-const RESTRICT = 57480
-const CASCADE = 57481
-const NO = 57482
-const ACTION = 57483
-const PERMISSIVE = 57484
-const RESTRICTIVE = 57485
-const PUBLIC = 57486
-const CURRENT_USER = 57487
-const SESSION_USER = 57488
-const PAD_INDEX = 57489
-const FILLFACTOR = 57490
-const IGNORE_DUP_KEY = 57491
-const STATISTICS_NORECOMPUTE = 57492
-const STATISTICS_INCREMENTAL = 57493
-const ALLOW_ROW_LOCKS = 57494
-const ALLOW_PAGE_LOCKS = 57495
-const BEGIN = 57496
-const START = 57497
-const TRANSACTION = 57498
-const COMMIT = 57499
-const ROLLBACK = 57500
-const BIT = 57501
-const TINYINT = 57502
-const SMALLINT = 57503
-const SMALLSERIAL = 57504
-const MEDIUMINT = 57505
-const INT = 57506
-const INTEGER = 57507
-const SERIAL = 57508
-const BIGINT = 57509
-const BIGSERIAL = 57510
-const INTNUM = 57511
-const REAL = 57512
-const DOUBLE = 57513
-const PRECISION = 57514
-const FLOAT_TYPE = 57515
-const DECIMAL = 57516
-const NUMERIC = 57517
-const SMALLMONEY = 57518
-const MONEY = 57519
-const TIME = 57520
-const TIMESTAMP = 57521
-const DATETIME = 57522
-const YEAR = 57523
-const DATETIMEOFFSET = 57524
-const DATETIME2 = 57525
-const SMALLDATETIME = 57526
-const CHAR = 57527
-const VARCHAR = 57528
-const VARYING = 57529
-const BOOL = 57530
-const CHARACTER = 57531
-const VARBINARY = 57532
-const NCHAR = 57533
-const NVARCHAR = 57534
-const NTEXT = 57535
-const UUID = 57536
-const TEXT = 57537
-const TINYTEXT = 57538
-const MEDIUMTEXT = 57539
-const LONGTEXT = 57540
-const CITEXT = 57541
-const BLOB = 57542
-const TINYBLOB = 57543
-const MEDIUMBLOB = 57544
-const LONGBLOB = 57545
-const JSON = 57546
-const JSONB = 57547
-const ENUM = 57548
-const GEOMETRY = 57549
-const POINT = 57550
-const LINESTRING = 57551
-const POLYGON = 57552
-const GEOMETRYCOLLECTION = 57553
-const MULTIPOINT = 57554
-const MULTILINESTRING = 57555
-const MULTIPOLYGON = 57556
-const ARRAY = 57557
-const NOW = 57558
-const BPCHAR = 57559
-const NULLX = 57560
-const AUTO_INCREMENT = 57561
-const APPROXNUM = 57562
-const SIGNED = 57563
-const UNSIGNED = 57564
-const ZEROFILL = 57565
-const ZONE = 57566
-const AUTOINCREMENT = 57567
-const DATABASES = 57568
-const TABLES = 57569
-const VITESS_KEYSPACES = 57570
-const VITESS_SHARDS = 57571
-const VITESS_TABLETS = 57572
-const VSCHEMA_TABLES = 57573
-const EXTENDED = 57574
-const FULL = 57575
-const PROCESSLIST = 57576
-const NAMES = 57577
-const CHARSET = 57578
-const GLOBAL = 57579
-const SESSION = 57580
-const ISOLATION = 57581
-const LEVEL = 57582
-const READ = 57583
-const WRITE = 57584
-const ONLY = 57585
-const REPEATABLE = 57586
-const COMMITTED = 57587
-const UNCOMMITTED = 57588
-const SERIALIZABLE = 57589
-const CURRENT_TIMESTAMP = 57590
-const DATABASE = 57591
-const CURRENT_DATE = 57592
-const CURRENT_TIME = 57593
-const LOCALTIME = 57594
-const LOCALTIMESTAMP = 57595
-const UTC_DATE = 57596
-const UTC_TIME = 57597
-const UTC_TIMESTAMP = 57598
-const REPLACE = 57599
-const CONVERT = 57600
-const CAST = 57601
-const SUBSTR = 57602
-const SUBSTRING = 57603
-const GROUP_CONCAT = 57604
-const SEPARATOR = 57605
-const INHERIT = 57606
-const MATCH = 57607
-const AGAINST = 57608
-const BOOLEAN = 57609
-const LANGUAGE = 57610
-const WITH = 57611
-const WITHOUT = 57612
-const PARSER = 57613
-const QUERY = 57614
-const EXPANSION = 57615
-const UNUSED = 57616
-const GENERATED = 57617
-const ALWAYS = 57618
-const IDENTITY = 57619
-const SEQUENCE = 57620
-const INCREMENT = 57621
-const MINVALUE = 57622
-const CACHE = 57623
-const CYCLE = 57624
-const OWNED = 57625
-const NONE = 57626
-const CLUSTERED = 57627
-const NONCLUSTERED = 57628
-const TYPECAST = 57629
-const CHECK = 57630
+const REORGANIZE = 57470
+const LESS = 57471
+const THAN = 57472
+const PROCEDURE = 57473
+const TRIGGER = 57474
+const PARTITION = 57475
+const BY = 57476
+const VINDEX = 57477
+const VINDEXES = 57478
+const STATUS = 57479
+const VARIABLES = 57480
+const RESTRICT = 57481
+const CASCADE = 57482
+const NO = 57483
+const ACTION = 57484
+const PERMISSIVE = 57485
+const RESTRICTIVE = 57486
+const PUBLIC = 57487
+const CURRENT_USER = 57488
+const SESSION_USER = 57489
+const PAD_INDEX = 57490
+const FILLFACTOR = 57491
+const IGNORE_DUP_KEY = 57492
+const STATISTICS_NORECOMPUTE = 57493
+const STATISTICS_INCREMENTAL = 57494
+const ALLOW_ROW_LOCKS = 57495
+const ALLOW_PAGE_LOCKS = 57496
+const BEGIN = 57497
+const START = 57498
+const TRANSACTION = 57499
+const COMMIT = 57500
+const ROLLBACK = 57501
+const BIT = 57502
+const TINYINT = 57503
+const SMALLINT = 57504
+const SMALLSERIAL = 57505
+const MEDIUMINT = 57506
+const INT = 57507
+const INTEGER = 57508
+const SERIAL = 57509
+const BIGINT = 57510
+const BIGSERIAL = 57511
+const INTNUM = 57512
+const REAL = 57513
+const DOUBLE = 57514
+const PRECISION = 57515
+const FLOAT_TYPE = 57516
+const DECIMAL = 57517
+const NUMERIC = 57518
+const SMALLMONEY = 57519
+const MONEY = 57520
+const TIME = 57521
+const TIMESTAMP = 57522
+const TIMESTAMPTZ = 57523
+const DATETIME = 57524
+const YEAR = 57525
+const DATETIMEOFFSET = 57526
+const DATETIME2 = 57527
+const SMALLDATETIME = 57528
+const CHAR = 57529
+const VARCHAR = 57530
+const VARYING = 57531
+const BOOL = 57532
+const CHARACTER = 57533
+const VARBINARY = 57534
+const NCHAR = 57535
+const NVARCHAR = 57536
+const NTEXT = 57537
+const UUID = 57538
+const TEXT = 57539
+const TINYTEXT = 57540
+const MEDIUMTEXT = 57541
+const LONGTEXT = 57542
+const CITEXT = 57543
+const BLOB = 57544
+const TINYBLOB = 57545
+const MEDIUMBLOB = 57546
+const LONGBLOB = 57547
+const JSON = 57548
+const JSONB = 57549
+const ENUM = 57550
+const GEOMETRY = 57551
+const POINT = 57552
+const LINESTRING = 57553
+const POLYGON = 57554
+const GEOMETRYCOLLECTION = 57555
+const MULTIPOINT = 57556
+const MULTILINESTRING = 57557
+const MULTIPOLYGON = 57558
+const ARRAY = 57559
+const NOW = 57560
+const BPCHAR = 57561
+const NULLX = 57562
+const AUTO_INCREMENT = 57563
+const APPROXNUM = 57564
+const SIGNED = 57565
+const UNSIGNED = 57566
+const ZEROFILL = 57567
+const ZONE = 57568
+const AUTOINCREMENT = 57569
+const DATABASES = 57570
+const TABLES = 57571
+const VITESS_KEYSPACES = 57572
+const VITESS_SHARDS = 57573
+const VITESS_TABLETS = 57574
+const VSCHEMA_TABLES = 57575
+const EXTENDED = 57576
+const FULL = 57577
+const PROCESSLIST = 57578
+const NAMES = 57579
+const CHARSET = 57580
+const GLOBAL = 57581
+const SESSION = 57582
+const ISOLATION = 57583
+const LEVEL = 57584
+const READ = 57585
+const WRITE = 57586
+const ONLY = 57587
+const REPEATABLE = 57588
+const COMMITTED = 57589
+const UNCOMMITTED = 57590
+const SERIALIZABLE = 57591
+const CURRENT_TIMESTAMP = 57592
+const DATABASE = 57593
+const CURRENT_DATE = 57594
+const CURRENT_TIME = 57595
+const LOCALTIME = 57596
+const LOCALTIMESTAMP = 57597
+const UTC_DATE = 57598
+const UTC_TIME = 57599
+const UTC_TIMESTAMP = 57600
+const REPLACE = 57601
+const CONVERT = 57602
+const CAST = 57603
+const SUBSTR = 57604
+const SUBSTRING = 57605
+const GROUP_CONCAT = 57606
+const SEPARATOR = 57607
+const INHERIT = 57608
+const INHERITS = 57609
+const DEFERRABLE = 57610
+const INITIALLY = 57611
+const DEFERRED = 57612
+const IMMEDIATE = 57613
+const INCLUDE = 57614
+const EXCLUDE = 57615
+const CLUSTER = 57616
+const MATCH = 57617
+const AGAINST = 57618
+const BOOLEAN = 57619
+const LANGUAGE = 57620
+const WITH = 57621
+const WITHOUT = 57622
+const PARSER = 57623
+const QUERY = 57624
+const EXPANSION = 57625
+const UNUSED = 57626
+const GENERATED = 57627
+const ALWAYS = 57628
+const IDENTITY = 57629
+const STORED = 57630
+const VIRTUAL = 57631
+const PERSISTED = 57632
+const MATERIALIZED = 57633
+const SEQUENCE = 57634
+const INCREMENT = 57635
+const MINVALUE = 57636
+const CACHE = 57637
+const CYCLE = 57638
+const OWNED = 57639
+const NONE = 57640
+const CLUSTERED = 57641
+const NONCLUSTERED = 57642
+const TYPECAST = 57643
+const CHECK = 57644
 
 var yyToknames = [...]string{
 	"$end",
@@ -407,7 +432,6 @@ var yyToknames = [...]string{
 	"GROUP",
 	"HAVING",
 	"ORDER",
-	"BY",
 	"LIMIT",
 	"OFFSET",
 	"FOR",
@@ -480,6 +504,7 @@ var yyToknames = [...]string{
 	"NULL_SAFE_EQUAL",
 	"IS",
 	"LIKE",
+	"NOT_LIKE",
 	"REGEXP",
 	"IN",
 	"'|'",
@@ -536,12 +561,13 @@ var yyToknames = [...]string{
 	"OPTIMIZE",
 	"TRUNCATE",
 	"MAXVALUE",
-	"PARTITION",
 	"REORGANIZE",
 	"LESS",
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"PARTITION",
+	"BY",
 	"VINDEX",
 	"VINDEXES",
 	"STATUS",
@@ -588,6 +614,7 @@ var yyToknames = [...]string{
 	"MONEY",
 	"TIME",
 	"TIMESTAMP",
+	"TIMESTAMPTZ",
 	"DATETIME",
 	"YEAR",
 	"DATETIMEOFFSET",
@@ -673,6 +700,14 @@ var yyToknames = [...]string{
 	"GROUP_CONCAT",
 	"SEPARATOR",
 	"INHERIT",
+	"INHERITS",
+	"DEFERRABLE",
+	"INITIALLY",
+	"DEFERRED",
+	"IMMEDIATE",
+	"INCLUDE",
+	"EXCLUDE",
+	"CLUSTER",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -686,6 +721,10 @@ var yyToknames = [...]string{
 	"GENERATED",
 	"ALWAYS",
 	"IDENTITY",
+	"STORED",
+	"VIRTUAL",
+	"PERSISTED",
+	"MATERIALIZED",
 	"SEQUENCE",
 	"INCREMENT",
 	"MINVALUE",
@@ -714,1525 +753,1616 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 27,
+	5, 28,
 	-2, 4,
-	-1, 30,
-	121, 92,
-	-2, 82,
-	-1, 36,
-	153, 394,
-	154, 394,
-	-2, 384,
-	-1, 269,
-	109, 725,
-	-2, 721,
-	-1, 270,
-	109, 726,
-	-2, 722,
-	-1, 340,
-	80, 912,
-	-2, 58,
-	-1, 341,
-	80, 864,
+	-1, 31,
+	121, 95,
+	-2, 85,
+	-1, 37,
+	154, 454,
+	155, 454,
+	-2, 444,
+	-1, 283,
+	109, 792,
+	-2, 788,
+	-1, 284,
+	109, 793,
+	-2, 789,
+	-1, 354,
+	79, 989,
 	-2, 59,
-	-1, 346,
-	80, 844,
-	-2, 692,
-	-1, 348,
-	80, 887,
-	-2, 694,
-	-1, 641,
-	51, 41,
-	53, 41,
-	-2, 43,
-	-1, 782,
-	109, 728,
-	-2, 724,
-	-1, 1020,
+	-1, 355,
+	79, 934,
+	-2, 60,
+	-1, 360,
+	79, 913,
+	-2, 759,
+	-1, 362,
+	79, 963,
+	-2, 761,
+	-1, 664,
+	50, 42,
+	52, 42,
+	-2, 44,
+	-1, 814,
+	109, 795,
+	-2, 791,
+	-1, 1071,
+	5, 29,
+	-2, 593,
+	-1, 1095,
+	5, 28,
+	-2, 733,
+	-1, 1204,
 	5, 28,
-	-2, 527,
-	-1, 1045,
-	5, 27,
-	-2, 666,
-	-1, 1140,
-	5, 27,
-	-2, 64,
-	-1, 1351,
+	-2, 66,
+	-1, 1205,
 	5, 28,
-	-2, 667,
-	-1, 1428,
-	5, 27,
-	-2, 669,
-	-1, 1537,
+	-2, 67,
+	-1, 1411,
+	51, 350,
+	-2, 1042,
+	-1, 1453,
+	5, 29,
+	-2, 734,
+	-1, 1571,
 	5, 28,
-	-2, 670,
+	-2, 736,
+	-1, 1706,
+	5, 29,
+	-2, 737,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 14474
+const yyLast = 15317
 
 var yyAct = [...]int{
-	270, 1527, 1539, 1474, 487, 960, 718, 1540, 883, 274,
-	846, 1543, 568, 1252, 1225, 1263, 1048, 1080, 1131, 1370,
-	1357, 1253, 567, 3, 864, 888, 299, 1142, 1226, 635,
-	954, 633, 248, 1222, 894, 88, 908, 887, 88, 1105,
-	53, 847, 1064, 345, 1198, 818, 1012, 815, 276, 807,
-	242, 66, 273, 903, 651, 1053, 834, 1128, 784, 500,
-	506, 650, 88, 88, 350, 949, 937, 339, 342, 350,
-	247, 267, 350, 454, 843, 637, 622, 88, 485, 88,
-	327, 817, 272, 326, 591, 88, 257, 512, 336, 334,
-	927, 994, 596, 325, 243, 244, 245, 246, 1112, 597,
-	52, 924, 1596, 520, 528, 261, 531, 1276, 534, 1382,
-	330, 544, 546, 547, 548, 549, 550, 551, 552, 544,
-	529, 530, 527, 533, 532, 542, 543, 535, 536, 537,
-	538, 539, 540, 541, 534, 582, 1622, 544, 1265, 1266,
-	1592, 1579, 1617, 1488, 533, 532, 542, 543, 535, 536,
-	537, 538, 539, 540, 541, 534, 1535, 1612, 544, 1264,
-	921, 1498, 1193, 1099, 533, 532, 542, 543, 535, 536,
-	537, 538, 539, 540, 541, 534, 923, 1604, 544, 1132,
-	1133, 961, 533, 532, 542, 543, 535, 536, 537, 538,
-	539, 540, 541, 534, 1497, 1568, 544, 1585, 1578, 1217,
-	1515, 1345, 1534, 464, 1341, 499, 298, 1109, 1247, 1111,
-	1110, 1248, 1249, 1013, 83, 79, 80, 81, 878, 879,
-	692, 88, 652, 495, 653, 350, 350, 350, 350, 877,
-	350, 537, 538, 539, 540, 541, 534, 350, 1114, 544,
-	57, 1396, 533, 532, 542, 543, 535, 536, 537, 538,
-	539, 540, 541, 534, 1395, 1072, 544, 926, 1071, 1465,
-	938, 1073, 838, 1295, 350, 59, 60, 61, 62, 63,
-	344, 1294, 1334, 1417, 1332, 458, 509, 928, 462, 542,
-	543, 535, 536, 537, 538, 539, 540, 541, 534, 749,
-	950, 544, 508, 1591, 241, 1593, 750, 677, 1461, 1306,
-	1307, 1454, 1616, 1385, 488, 489, 490, 1610, 493, 1528,
-	545, 491, 492, 1384, 1176, 497, 844, 1529, 545, 1387,
-	555, 1258, 1309, 1425, 1377, 88, 1603, 1265, 1266, 1376,
-	693, 1093, 88, 88, 88, 1092, 545, 1310, 350, 1489,
-	1082, 1386, 342, 904, 350, 469, 1173, 1318, 460, 559,
-	560, 561, 562, 563, 564, 565, 1479, 545, 905, 1259,
-	1373, 76, 82, 77, 77, 1404, 728, 599, 600, 601,
-	602, 603, 604, 605, 606, 607, 608, 545, 709, 710,
-	330, 711, 712, 713, 715, 714, 694, 695, 696, 700,
-	698, 697, 699, 671, 673, 545, 609, 672, 678, 674,
-	675, 676, 690, 679, 680, 681, 682, 683, 684, 685,
-	686, 687, 688, 689, 691, 701, 702, 703, 704, 705,
-	706, 707, 708, 642, 938, 1584, 648, 951, 457, 931,
-	1498, 344, 344, 344, 344, 1063, 344, 1268, 545, 1533,
-	1098, 1062, 1061, 344, 1174, 456, 1172, 465, 584, 585,
-	586, 587, 588, 589, 590, 545, 220, 350, 88, 1175,
-	78, 1338, 499, 1177, 88, 1615, 88, 350, 1493, 88,
-	522, 1354, 88, 557, 558, 610, 88, 1185, 350, 350,
-	350, 350, 350, 350, 350, 350, 1371, 1372, 1374, 1028,
-	545, 1006, 350, 350, 756, 510, 524, 88, 475, 533,
-	532, 542, 543, 535, 536, 537, 538, 539, 540, 541,
-	534, 791, 350, 544, 1087, 1085, 88, 865, 867, 737,
-	885, 884, 350, 1289, 1025, 789, 790, 788, 519, 781,
-	532, 542, 543, 535, 536, 537, 538, 539, 540, 541,
-	534, 761, 753, 544, 344, 727, 1509, 989, 669, 517,
-	656, 1181, 518, 517, 785, 665, 738, 739, 740, 741,
-	742, 743, 744, 745, 1508, 519, 350, 735, 782, 519,
-	746, 747, 518, 517, 1290, 1507, 786, 1506, 1505, 904,
-	904, 1504, 1219, 480, 1503, 827, 830, 759, 760, 519,
-	1501, 836, 866, 822, 905, 905, 1303, 763, 783, 1051,
-	654, 792, 793, 794, 795, 796, 797, 798, 799, 800,
-	801, 802, 803, 804, 805, 806, 780, 88, 835, 721,
-	88, 88, 88, 88, 88, 778, 990, 1180, 848, 835,
-	810, 1035, 88, 518, 517, 88, 1453, 1089, 1441, 88,
-	812, 813, 514, 1544, 88, 88, 50, 482, 350, 484,
-	519, 1443, 342, 823, 824, 468, 787, 822, 1586, 831,
-	840, 350, 1545, 716, 499, 889, 832, 1606, 330, 330,
-	330, 330, 330, 344, 1605, 872, 1590, 481, 483, 75,
-	518, 517, 1589, 330, 344, 344, 344, 344, 344, 344,
-	344, 344, 330, 839, 1588, 841, 842, 519, 344, 344,
-	1587, 850, 851, 849, 853, 1390, 852, 861, 459, 1115,
-	74, 875, 545, 1546, 869, 874, 870, 1441, 765, 1442,
-	1542, 350, 1464, 350, 88, 1398, 1556, 88, 522, 88,
-	1443, 344, 88, 350, 1397, 892, 1274, 471, 472, 473,
-	324, 1389, 545, 755, 956, 1115, 1137, 939, 940, 941,
-	942, 1444, 1445, 1446, 1447, 1448, 1449, 1450, 70, 72,
-	1135, 781, 929, 930, 932, 933, 934, 1115, 935, 936,
-	1502, 1424, 814, 71, 73, 1393, 952, 953, 754, 461,
-	1320, 463, 828, 828, 1129, 945, 946, 947, 828, 948,
-	808, 68, 809, 1095, 479, 518, 517, 21, 1442, 964,
-	782, 966, 1499, 535, 536, 537, 538, 539, 540, 541,
-	534, 987, 519, 544, 1262, 785, 1560, 332, 995, 1261,
-	1024, 996, 1023, 1522, 1627, 828, 1003, 1004, 1005, 1562,
-	1444, 1445, 1446, 1447, 1448, 1449, 1450, 786, 1260, 518,
-	517, 518, 517, 1088, 1557, 1581, 1624, 1008, 1221, 1074,
-	1002, 963, 85, 252, 344, 811, 519, 734, 519, 1367,
-	1611, 1009, 1010, 1011, 1367, 1583, 1045, 344, 733, 350,
-	1522, 1582, 88, 1496, 722, 774, 776, 777, 720, 921,
-	335, 775, 1581, 1580, 1574, 499, 499, 1066, 350, 1068,
-	1034, 477, 889, 470, 466, 455, 467, 1517, 1017, 350,
-	1470, 910, 474, 1067, 1469, 1523, 69, 1522, 1058, 1282,
-	350, 1367, 1571, 1076, 1032, 917, 1050, 906, 1049, 88,
-	330, 1367, 1566, 907, 1101, 1102, 1103, 344, 871, 344,
-	644, 1069, 1106, 1104, 296, 297, 1367, 1565, 820, 344,
-	1432, 1525, 1367, 1471, 1432, 1462, 1122, 1349, 1124, 1125,
-	1126, 1127, 1439, 1153, 1083, 1084, 1086, 619, 88, 350,
-	1432, 499, 350, 344, 1223, 1134, 1143, 1049, 1558, 1559,
-	1561, 1563, 1564, 619, 1107, 54, 913, 1050, 909, 918,
-	1432, 1433, 1140, 1367, 1366, 915, 914, 350, 1244, 499,
-	88, 88, 1353, 499, 1298, 1297, 1116, 1117, 88, 1119,
-	1120, 1121, 1130, 1292, 1293, 1292, 1291, 350, 1018, 499,
-	645, 1190, 545, 1018, 23, 619, 499, 1136, 1049, 1146,
-	23, 1147, 1118, 1154, 1150, 820, 499, 1155, 1152, 1151,
-	661, 660, 73, 1214, 618, 1188, 1383, 1043, 476, 1302,
-	1044, 1296, 23, 1156, 1300, 1299, 1427, 350, 350, 1149,
-	646, 1075, 644, 848, 1224, 1191, 1192, 876, 619, 848,
-	50, 50, 1030, 1018, 1197, 1178, 50, 719, 647, 1229,
-	1211, 1227, 782, 1218, 1210, 1065, 350, 1018, 350, 350,
-	889, 1027, 889, 455, 1246, 1194, 1195, 911, 50, 1233,
-	1232, 1234, 757, 912, 344, 254, 1618, 1614, 1212, 1213,
-	1108, 1215, 1216, 1251, 1029, 1081, 1576, 1245, 624, 627,
-	628, 629, 625, 1250, 626, 630, 1090, 1513, 1054, 1055,
-	769, 1512, 1060, 1026, 1476, 1283, 1284, 1267, 1286, 1287,
-	1288, 1269, 1109, 1473, 1111, 1110, 1472, 1463, 1411, 928,
-	955, 50, 617, 919, 1281, 920, 1279, 1271, 904, 1238,
-	350, 641, 950, 899, 1100, 898, 916, 900, 901, 350,
-	1078, 944, 902, 905, 943, 1139, 1054, 1055, 344, 957,
-	958, 88, 65, 1455, 1452, 1301, 1223, 350, 289, 288,
-	291, 292, 293, 294, 1079, 1285, 350, 290, 295, 88,
-	1190, 1057, 731, 344, 723, 496, 1059, 858, 856, 1311,
-	1278, 1280, 859, 857, 1322, 855, 854, 860, 1313, 628,
-	629, 1601, 1319, 344, 258, 259, 1577, 1184, 991, 513,
-	1323, 1599, 1316, 624, 627, 628, 629, 625, 1001, 626,
-	630, 1000, 511, 1123, 1330, 344, 501, 330, 659, 350,
-	478, 350, 350, 350, 88, 350, 1163, 502, 1273, 1347,
-	828, 350, 1412, 1231, 1065, 889, 828, 965, 1348, 498,
-	730, 1360, 1361, 1362, 1272, 1325, 1356, 1145, 959, 632,
-	255, 256, 350, 1363, 513, 717, 1076, 1375, 1365, 999,
-	1378, 724, 344, 725, 344, 1254, 729, 998, 1305, 732,
-	1327, 1328, 1381, 1329, 1391, 249, 1594, 1331, 1482, 1333,
-	350, 350, 88, 350, 350, 250, 54, 1143, 889, 350,
-	1481, 1164, 1415, 1399, 751, 1050, 1166, 1159, 1160, 350,
-	1167, 1162, 1161, 1257, 1256, 1169, 1165, 515, 1511, 1403,
-	1402, 1510, 1490, 770, 1091, 1406, 1168, 1407, 1408, 1409,
-	752, 56, 1158, 58, 1148, 1368, 1369, 1308, 643, 1405,
-	51, 1, 1516, 1097, 350, 350, 1312, 1460, 1392, 67,
-	1394, 1567, 1521, 1275, 1304, 1314, 1144, 1157, 350, 962,
-	1141, 972, 1526, 1438, 1440, 1428, 1227, 350, 1426, 896,
-	886, 504, 453, 1317, 64, 1500, 897, 895, 1437, 1451,
-	893, 662, 344, 1458, 1456, 1416, 922, 1113, 925, 668,
-	666, 1467, 667, 1468, 1466, 350, 664, 670, 663, 228,
-	1418, 1419, 350, 1420, 1421, 1422, 86, 337, 631, 240,
-	655, 516, 1171, 1170, 968, 1179, 748, 988, 494, 230,
-	553, 997, 1477, 350, 845, 300, 47, 1070, 343, 1230,
-	758, 264, 1491, 86, 86, 1358, 1495, 1358, 1358, 1358,
-	505, 1364, 1492, 1480, 1227, 1414, 1033, 344, 86, 579,
-	86, 833, 873, 275, 773, 287, 86, 284, 286, 285,
-	764, 1042, 526, 350, 350, 265, 329, 350, 1358, 615,
-	623, 621, 620, 47, 1056, 1052, 1519, 1520, 1518, 328,
-	1524, 253, 1187, 1344, 350, 1531, 1487, 331, 768, 350,
-	848, 1536, 25, 55, 260, 19, 1254, 1400, 18, 344,
-	344, 17, 20, 350, 350, 1410, 1554, 1547, 1548, 1549,
-	1550, 1551, 16, 350, 15, 1413, 14, 1555, 29, 350,
-	1552, 1553, 1572, 13, 12, 11, 10, 9, 8, 7,
-	6, 967, 5, 4, 984, 251, 985, 22, 2, 986,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1430, 1431, 0, 0, 0, 0, 0, 0, 0, 1342,
-	0, 0, 0, 0, 1254, 0, 1598, 350, 1597, 0,
-	0, 0, 1595, 1457, 0, 0, 499, 0, 0, 1602,
-	1600, 0, 0, 0, 0, 88, 0, 0, 0, 0,
-	0, 0, 86, 0, 88, 0, 0, 0, 0, 0,
-	0, 1475, 0, 0, 0, 0, 350, 1619, 1358, 350,
-	1620, 0, 1623, 533, 532, 542, 543, 535, 536, 537,
-	538, 539, 540, 541, 534, 0, 0, 544, 0, 1494,
-	0, 533, 532, 542, 543, 535, 536, 537, 538, 539,
-	540, 541, 534, 0, 0, 544, 0, 0, 0, 0,
-	486, 486, 486, 486, 0, 486, 0, 0, 0, 0,
-	0, 0, 486, 0, 263, 0, 0, 0, 0, 1254,
-	1254, 0, 0, 1254, 0, 0, 0, 0, 0, 47,
-	0, 0, 0, 1625, 0, 0, 0, 828, 0, 0,
-	1538, 0, 0, 0, 554, 1541, 86, 556, 0, 0,
-	0, 0, 1339, 86, 639, 86, 0, 0, 0, 1475,
-	1254, 0, 0, 0, 0, 0, 0, 0, 0, 1569,
-	0, 0, 0, 0, 566, 1575, 570, 571, 572, 573,
-	574, 575, 576, 577, 578, 0, 581, 583, 583, 583,
-	583, 583, 583, 583, 583, 0, 611, 612, 613, 614,
-	0, 0, 0, 0, 0, 0, 0, 634, 0, 762,
-	0, 0, 0, 0, 0, 1138, 0, 0, 0, 1014,
-	0, 0, 0, 1254, 533, 532, 542, 543, 535, 536,
-	537, 538, 539, 540, 541, 534, 0, 0, 544, 533,
-	532, 542, 543, 535, 536, 537, 538, 539, 540, 541,
-	534, 0, 0, 544, 0, 1186, 0, 0, 0, 0,
-	0, 0, 344, 0, 0, 1475, 0, 819, 821, 0,
-	0, 0, 0, 0, 0, 0, 545, 0, 0, 86,
-	0, 0, 0, 837, 0, 86, 0, 86, 0, 1199,
-	86, 0, 0, 86, 545, 0, 0, 736, 0, 0,
-	533, 532, 542, 543, 535, 536, 537, 538, 539, 540,
-	541, 534, 0, 0, 544, 0, 0, 0, 86, 0,
-	0, 0, 1201, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 863, 0, 0, 0, 86, 0, 0,
-	0, 0, 486, 0, 0, 0, 736, 0, 0, 0,
-	0, 0, 0, 486, 486, 486, 486, 486, 486, 486,
-	486, 0, 0, 0, 503, 507, 0, 486, 486, 0,
-	978, 0, 0, 0, 1203, 0, 0, 0, 1208, 0,
-	1202, 525, 0, 977, 0, 1200, 0, 0, 264, 0,
-	0, 1206, 0, 264, 264, 0, 0, 829, 829, 264,
-	0, 0, 0, 829, 1204, 1205, 0, 0, 0, 0,
-	982, 0, 0, 0, 0, 569, 0, 0, 0, 976,
-	0, 1207, 1209, 0, 580, 0, 0, 0, 1315, 0,
-	0, 0, 47, 264, 264, 264, 264, 545, 86, 0,
-	829, 86, 86, 86, 86, 86, 570, 0, 0, 0,
-	0, 0, 545, 862, 0, 0, 86, 0, 0, 0,
-	639, 0, 0, 0, 0, 86, 86, 0, 973, 970,
-	971, 0, 969, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 592, 0, 331, 331, 331, 331, 331,
-	980, 983, 0, 0, 0, 0, 0, 0, 0, 0,
-	634, 1015, 868, 545, 0, 1016, 0, 0, 0, 331,
-	0, 0, 1020, 1021, 1022, 0, 594, 0, 0, 0,
-	0, 1031, 0, 0, 0, 0, 1037, 0, 0, 1038,
-	1039, 1040, 1041, 0, 0, 86, 0, 0, 86, 0,
-	86, 0, 0, 86, 0, 0, 0, 0, 0, 1401,
-	0, 975, 0, 599, 600, 601, 602, 603, 604, 605,
-	606, 607, 608, 0, 0, 0, 0, 0, 736, 0,
-	0, 226, 0, 0, 595, 0, 0, 0, 0, 0,
-	264, 974, 609, 593, 0, 0, 486, 0, 486, 598,
-	0, 0, 0, 0, 0, 236, 0, 0, 486, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 23, 24,
-	48, 26, 27, 0, 0, 0, 0, 0, 0, 0,
-	979, 0, 771, 772, 0, 0, 0, 42, 264, 0,
-	0, 28, 0, 0, 0, 0, 981, 0, 0, 0,
-	0, 0, 0, 0, 264, 0, 221, 1007, 0, 0,
-	37, 0, 223, 0, 50, 0, 0, 0, 0, 229,
-	225, 610, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 569, 0, 0, 825, 826,
-	0, 0, 0, 86, 0, 0, 0, 0, 0, 227,
-	0, 0, 231, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1196, 0, 1046,
-	1047, 0, 0, 0, 30, 31, 33, 32, 35, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1096, 0, 0, 0, 0, 0, 0, 331, 36, 43,
-	44, 0, 0, 45, 46, 34, 222, 0, 0, 0,
-	0, 0, 0, 0, 1243, 0, 0, 0, 0, 882,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 86,
-	0, 0, 0, 38, 39, 0, 40, 41, 0, 0,
-	0, 0, 1094, 224, 0, 232, 233, 234, 235, 239,
-	0, 0, 0, 0, 238, 237, 0, 0, 0, 0,
-	0, 1182, 1183, 0, 0, 0, 0, 0, 0, 86,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
-	0, 0, 0, 0, 0, 47, 0, 0, 0, 0,
-	264, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	736, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1613, 486, 0, 0, 829, 0, 0, 0, 992,
-	993, 829, 507, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 49, 0, 0, 0,
-	0, 1324, 0, 0, 0, 0, 0, 0, 1326, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1335, 1336, 1337, 0, 0, 1340, 0, 0, 0, 0,
-	1228, 0, 47, 0, 0, 0, 0, 0, 1350, 1351,
-	1352, 0, 1355, 0, 0, 1019, 0, 1240, 1241, 1242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1036, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1380, 0, 0, 0, 0, 0, 0, 1388, 0, 0,
-	0, 0, 0, 0, 1277, 0, 0, 0, 0, 0,
-	0, 0, 86, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1423, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1434, 1435, 1436, 0, 331, 639, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1343, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1483, 1484,
-	1485, 1486, 0, 86, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1379, 0, 0, 0, 0, 0,
-	1220, 0, 0, 0, 0, 0, 0, 0, 0, 1514,
-	0, 0, 0, 0, 0, 1235, 1236, 0, 0, 1237,
-	0, 0, 1239, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1532, 0, 0,
-	0, 0, 1537, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1270,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1228, 1573, 0, 1429, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1478, 0,
-	0, 0, 0, 0, 0, 0, 1321, 0, 0, 0,
-	0, 0, 0, 1228, 0, 47, 0, 0, 0, 0,
-	0, 0, 829, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1628, 1629, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1346, 0, 0, 0,
-	0, 0, 0, 569, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1608, 0, 0, 0,
-	0, 0, 0, 0, 0, 86, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1621, 0, 1459, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1530, 569, 0, 0,
-	0, 0, 0, 0, 0, 441, 431, 0, 401, 443,
-	376, 391, 451, 393, 394, 423, 360, 409, 152, 388,
-	91, 379, 354, 385, 355, 377, 403, 115, 375, 433,
-	412, 128, 449, 131, 417, 0, 173, 140, 1570, 0,
-	405, 435, 407, 429, 400, 424, 367, 416, 444, 389,
-	420, 445, 0, 0, 0, 349, 0, 890, 891, 0,
-	0, 0, 0, 0, 104, 0, 419, 440, 387, 452,
-	422, 353, 418, 0, 358, 361, 450, 438, 382, 383,
-	1077, 0, 0, 0, 0, 0, 0, 404, 408, 426,
-	398, 0, 0, 0, 0, 0, 0, 0, 0, 380,
-	0, 415, 0, 0, 0, 364, 359, 0, 402, 0,
-	0, 1609, 366, 0, 381, 427, 0, 351, 430, 436,
-	399, 200, 439, 397, 396, 159, 0, 107, 0, 179,
-	119, 390, 129, 425, 442, 406, 434, 378, 386, 109,
-	384, 166, 153, 191, 414, 154, 164, 132, 183, 160,
-	190, 201, 202, 181, 199, 168, 99, 147, 89, 158,
-	165, 0, 108, 0, 213, 214, 215, 216, 217, 218,
-	219, 92, 180, 189, 105, 169, 95, 187, 176, 178,
-	138, 124, 125, 171, 93, 94, 0, 163, 114, 157,
-	118, 113, 150, 177, 141, 184, 185, 110, 210, 112,
-	111, 175, 100, 197, 198, 97, 101, 196, 146, 151,
-	149, 195, 182, 188, 139, 136, 0, 96, 186, 137,
-	135, 127, 0, 116, 120, 155, 134, 156, 121, 143,
-	142, 144, 0, 148, 0, 0, 356, 0, 174, 193,
-	211, 212, 357, 374, 437, 203, 204, 205, 206, 0,
-	0, 0, 145, 102, 122, 170, 126, 133, 162, 209,
-	421, 167, 106, 192, 172, 370, 373, 368, 369, 410,
-	411, 446, 447, 448, 428, 365, 0, 371, 372, 0,
-	432, 123, 413, 90, 98, 130, 207, 208, 0, 161,
-	117, 194, 392, 352, 395, 0, 0, 0, 0, 0,
-	0, 0, 362, 363, 0, 103, 441, 431, 0, 401,
-	443, 376, 391, 451, 393, 394, 423, 360, 409, 152,
-	388, 91, 379, 354, 385, 355, 377, 403, 115, 375,
-	433, 412, 128, 449, 131, 417, 0, 173, 140, 0,
-	0, 405, 435, 407, 429, 400, 424, 367, 416, 444,
-	389, 420, 445, 0, 0, 0, 349, 0, 890, 891,
-	0, 0, 0, 0, 0, 104, 0, 419, 440, 387,
-	452, 422, 353, 418, 0, 358, 361, 450, 438, 382,
-	383, 0, 0, 0, 0, 0, 0, 0, 404, 408,
-	426, 398, 0, 0, 0, 0, 0, 0, 0, 0,
-	380, 0, 415, 0, 0, 0, 364, 359, 0, 402,
-	0, 0, 0, 366, 0, 381, 427, 0, 351, 430,
-	436, 399, 200, 439, 397, 396, 159, 0, 107, 0,
-	179, 119, 390, 129, 425, 442, 406, 434, 378, 386,
-	109, 384, 166, 153, 191, 414, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 356, 0, 174,
-	193, 211, 212, 357, 374, 437, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 421, 167, 106, 192, 172, 370, 373, 368, 369,
-	410, 411, 446, 447, 448, 428, 365, 0, 371, 372,
-	0, 432, 123, 413, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 392, 352, 395, 0, 0, 0, 0,
-	0, 0, 0, 362, 363, 0, 103, 441, 431, 0,
-	401, 443, 376, 391, 451, 393, 394, 423, 360, 409,
-	152, 388, 91, 379, 354, 385, 355, 377, 403, 115,
-	375, 433, 412, 128, 449, 131, 417, 0, 173, 140,
-	0, 0, 405, 435, 407, 429, 400, 424, 367, 416,
-	444, 389, 420, 445, 0, 0, 0, 349, 0, 0,
-	0, 0, 0, 0, 0, 0, 104, 0, 419, 440,
-	387, 452, 422, 353, 418, 0, 358, 361, 450, 438,
-	382, 383, 0, 0, 0, 0, 0, 0, 0, 404,
-	408, 426, 398, 0, 0, 0, 0, 0, 0, 1189,
-	0, 380, 0, 415, 0, 0, 0, 364, 359, 0,
-	402, 0, 0, 0, 366, 0, 381, 427, 0, 351,
-	430, 436, 399, 200, 439, 397, 396, 159, 0, 107,
-	0, 179, 119, 390, 129, 425, 442, 406, 434, 378,
-	386, 109, 384, 166, 153, 191, 414, 154, 164, 132,
-	183, 160, 190, 201, 202, 181, 199, 168, 99, 147,
-	89, 158, 165, 0, 108, 0, 213, 214, 215, 216,
-	217, 218, 219, 92, 180, 189, 105, 169, 95, 187,
-	176, 178, 138, 124, 125, 171, 93, 94, 0, 163,
-	114, 157, 118, 113, 150, 177, 141, 184, 185, 110,
-	210, 112, 111, 175, 100, 197, 198, 97, 101, 196,
-	146, 151, 149, 195, 182, 188, 139, 136, 0, 96,
-	186, 137, 135, 127, 0, 116, 120, 155, 134, 156,
-	121, 143, 142, 144, 0, 148, 0, 0, 356, 0,
-	174, 193, 211, 212, 357, 374, 437, 203, 204, 205,
-	206, 0, 0, 0, 145, 102, 122, 170, 126, 133,
-	162, 209, 421, 167, 106, 192, 172, 370, 373, 368,
-	369, 410, 411, 446, 447, 448, 428, 365, 0, 371,
-	372, 0, 432, 123, 413, 90, 98, 130, 207, 208,
-	0, 161, 117, 194, 392, 352, 395, 0, 0, 0,
-	0, 0, 0, 0, 362, 363, 0, 103, 441, 431,
-	0, 401, 443, 376, 391, 451, 393, 394, 423, 360,
-	409, 152, 388, 91, 379, 354, 385, 355, 377, 403,
-	115, 375, 433, 412, 128, 449, 131, 417, 0, 173,
-	140, 0, 0, 405, 435, 407, 429, 400, 424, 367,
-	416, 444, 389, 420, 445, 50, 0, 0, 349, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 419,
-	440, 387, 452, 422, 353, 418, 0, 358, 361, 450,
-	438, 382, 383, 0, 0, 0, 0, 0, 0, 0,
-	404, 408, 426, 398, 0, 0, 0, 0, 0, 0,
-	0, 0, 380, 0, 415, 0, 0, 0, 364, 359,
-	0, 402, 0, 0, 0, 366, 0, 381, 427, 0,
-	351, 430, 436, 399, 200, 439, 397, 396, 159, 0,
-	107, 0, 179, 119, 390, 129, 425, 442, 406, 434,
-	378, 386, 109, 384, 166, 153, 191, 414, 154, 164,
-	132, 183, 160, 190, 201, 202, 181, 199, 168, 99,
-	147, 89, 158, 165, 0, 108, 0, 213, 214, 215,
-	216, 217, 218, 219, 92, 180, 189, 105, 169, 95,
-	187, 176, 178, 138, 124, 125, 171, 93, 94, 0,
-	163, 114, 157, 118, 113, 150, 177, 141, 184, 185,
-	110, 210, 112, 111, 175, 100, 197, 198, 97, 101,
-	196, 146, 151, 149, 195, 182, 188, 139, 136, 0,
-	96, 186, 137, 135, 127, 0, 116, 120, 155, 134,
-	156, 121, 143, 142, 144, 0, 148, 0, 0, 356,
-	0, 174, 193, 211, 212, 357, 374, 437, 203, 204,
-	205, 206, 0, 0, 0, 145, 102, 122, 170, 126,
-	133, 162, 209, 421, 167, 106, 192, 172, 370, 373,
-	368, 369, 410, 411, 446, 447, 448, 428, 365, 0,
-	371, 372, 0, 432, 123, 413, 90, 98, 130, 207,
-	208, 0, 161, 117, 194, 392, 352, 395, 0, 0,
-	0, 0, 0, 0, 0, 362, 363, 0, 103, 441,
-	431, 0, 401, 443, 376, 391, 451, 393, 394, 423,
-	360, 409, 152, 388, 91, 379, 354, 385, 355, 377,
-	403, 115, 375, 433, 412, 128, 449, 131, 417, 0,
-	173, 140, 0, 0, 405, 435, 407, 429, 400, 424,
-	367, 416, 444, 389, 420, 445, 0, 0, 0, 269,
-	0, 0, 0, 0, 0, 0, 0, 0, 104, 0,
-	419, 440, 387, 452, 422, 353, 418, 0, 358, 361,
-	450, 438, 382, 383, 0, 0, 0, 0, 0, 0,
-	0, 404, 408, 426, 398, 0, 0, 0, 0, 0,
-	0, 779, 0, 380, 0, 415, 0, 0, 0, 364,
-	359, 0, 402, 0, 0, 0, 366, 0, 381, 427,
-	0, 351, 430, 436, 399, 200, 439, 397, 396, 159,
-	0, 107, 0, 179, 119, 390, 129, 425, 442, 406,
-	434, 378, 386, 109, 384, 166, 153, 191, 414, 154,
-	164, 132, 183, 160, 190, 201, 202, 181, 199, 168,
-	99, 147, 89, 158, 165, 0, 108, 0, 213, 214,
-	215, 216, 217, 218, 219, 92, 180, 189, 105, 169,
-	95, 187, 176, 178, 138, 124, 125, 171, 93, 94,
-	0, 163, 114, 157, 118, 113, 150, 177, 141, 184,
-	185, 110, 210, 112, 111, 175, 100, 197, 198, 97,
-	101, 196, 146, 151, 149, 195, 182, 188, 139, 136,
-	0, 96, 186, 137, 135, 127, 0, 116, 120, 155,
-	134, 156, 121, 143, 142, 144, 0, 148, 0, 0,
-	356, 0, 174, 193, 211, 212, 357, 374, 437, 203,
-	204, 205, 206, 0, 0, 0, 145, 102, 122, 170,
-	126, 133, 162, 209, 421, 167, 106, 192, 172, 370,
-	373, 368, 369, 410, 411, 446, 447, 448, 428, 365,
-	0, 371, 372, 0, 432, 123, 413, 90, 98, 130,
-	207, 208, 0, 161, 117, 194, 392, 352, 395, 0,
-	0, 0, 0, 0, 0, 0, 362, 363, 0, 103,
-	441, 431, 0, 401, 443, 376, 391, 451, 393, 394,
-	423, 360, 409, 152, 388, 91, 379, 354, 385, 355,
-	377, 403, 115, 375, 433, 412, 128, 449, 131, 417,
-	0, 173, 140, 0, 0, 405, 435, 407, 429, 400,
-	424, 367, 416, 444, 389, 420, 445, 0, 0, 0,
-	349, 0, 0, 0, 0, 0, 0, 0, 0, 104,
-	0, 419, 440, 387, 452, 422, 353, 418, 0, 358,
-	361, 450, 438, 382, 383, 0, 0, 0, 0, 0,
-	0, 0, 404, 408, 426, 398, 0, 0, 0, 0,
-	0, 0, 0, 0, 380, 0, 415, 0, 0, 0,
-	364, 359, 0, 402, 0, 0, 0, 366, 0, 381,
-	427, 0, 351, 430, 436, 399, 200, 439, 397, 396,
-	159, 0, 107, 0, 179, 119, 390, 129, 425, 442,
-	406, 434, 378, 386, 109, 384, 166, 153, 191, 414,
-	154, 164, 132, 183, 160, 190, 201, 202, 181, 199,
-	168, 99, 147, 89, 158, 165, 0, 108, 0, 213,
-	214, 215, 216, 217, 218, 219, 92, 180, 189, 105,
-	169, 95, 187, 176, 178, 138, 124, 125, 171, 93,
-	94, 0, 163, 114, 157, 118, 113, 150, 177, 141,
-	184, 185, 110, 210, 112, 111, 175, 100, 197, 198,
-	97, 101, 196, 146, 151, 149, 195, 182, 188, 139,
-	136, 0, 96, 186, 137, 135, 127, 0, 116, 120,
-	155, 134, 156, 121, 143, 142, 144, 0, 148, 0,
-	0, 356, 0, 174, 193, 211, 212, 357, 374, 437,
-	203, 204, 205, 206, 0, 0, 0, 145, 102, 122,
-	170, 126, 133, 162, 209, 421, 167, 106, 192, 172,
-	370, 373, 368, 369, 410, 411, 446, 447, 448, 428,
-	365, 0, 371, 372, 0, 432, 123, 413, 90, 98,
-	130, 207, 208, 0, 161, 117, 194, 392, 352, 395,
-	0, 0, 0, 0, 0, 0, 0, 362, 363, 0,
-	103, 441, 431, 0, 401, 443, 376, 391, 451, 393,
-	394, 423, 360, 409, 152, 388, 91, 379, 354, 385,
-	355, 377, 403, 115, 375, 433, 412, 128, 449, 131,
-	417, 0, 173, 140, 0, 0, 405, 435, 407, 429,
-	400, 424, 367, 416, 444, 389, 420, 445, 0, 0,
-	0, 269, 0, 0, 0, 0, 0, 0, 0, 0,
-	104, 0, 419, 440, 387, 452, 422, 353, 418, 0,
-	358, 361, 450, 438, 382, 383, 0, 0, 0, 0,
-	0, 0, 0, 404, 408, 426, 398, 0, 0, 0,
-	0, 0, 0, 0, 0, 380, 0, 415, 0, 0,
-	0, 364, 359, 0, 402, 0, 0, 0, 366, 0,
-	381, 427, 0, 351, 430, 436, 399, 200, 439, 397,
-	396, 159, 0, 107, 0, 179, 119, 390, 129, 425,
-	442, 406, 434, 378, 386, 109, 384, 166, 153, 191,
-	414, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 356, 0, 174, 193, 211, 212, 357, 374,
-	437, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 421, 167, 106, 192,
-	172, 370, 373, 368, 369, 410, 411, 446, 447, 448,
-	428, 365, 0, 371, 372, 0, 432, 123, 413, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 392, 352,
-	395, 0, 0, 0, 0, 0, 0, 0, 362, 363,
-	0, 103, 441, 431, 0, 401, 443, 376, 391, 451,
-	393, 394, 423, 360, 409, 152, 388, 91, 379, 354,
-	385, 355, 377, 403, 115, 375, 433, 412, 128, 449,
-	131, 417, 0, 173, 140, 0, 0, 405, 435, 407,
-	429, 400, 424, 367, 416, 444, 389, 420, 445, 0,
-	0, 0, 349, 0, 0, 0, 0, 0, 0, 0,
-	0, 104, 0, 419, 440, 387, 452, 422, 353, 418,
-	0, 358, 361, 450, 438, 382, 383, 0, 0, 0,
-	0, 0, 0, 0, 404, 408, 426, 398, 0, 0,
-	0, 0, 0, 0, 0, 0, 380, 0, 415, 0,
-	0, 0, 364, 359, 0, 402, 0, 0, 0, 366,
-	0, 381, 427, 0, 351, 430, 436, 399, 200, 439,
-	397, 396, 159, 0, 107, 0, 179, 119, 390, 129,
-	425, 442, 406, 434, 378, 386, 109, 384, 166, 153,
-	191, 414, 154, 164, 132, 183, 160, 190, 201, 202,
-	181, 199, 168, 99, 147, 89, 158, 165, 0, 108,
-	0, 213, 214, 215, 216, 217, 218, 219, 92, 180,
-	189, 105, 169, 95, 187, 176, 178, 138, 124, 125,
-	171, 93, 94, 0, 163, 114, 157, 118, 113, 150,
-	177, 141, 184, 185, 110, 210, 112, 111, 175, 100,
-	197, 198, 97, 347, 196, 146, 151, 149, 195, 182,
-	188, 139, 136, 0, 96, 186, 137, 135, 127, 0,
-	116, 120, 155, 134, 156, 121, 143, 142, 144, 0,
-	148, 0, 0, 356, 0, 174, 193, 211, 212, 357,
-	374, 437, 203, 204, 205, 206, 0, 0, 0, 348,
-	346, 122, 170, 126, 133, 162, 209, 421, 167, 106,
-	192, 172, 370, 373, 368, 369, 410, 411, 446, 447,
-	448, 428, 365, 0, 371, 372, 0, 432, 123, 413,
-	90, 98, 130, 207, 208, 0, 161, 117, 194, 392,
-	352, 395, 0, 0, 0, 0, 0, 0, 0, 362,
-	363, 0, 103, 441, 431, 0, 401, 443, 376, 391,
-	451, 393, 394, 423, 360, 409, 152, 388, 91, 379,
-	354, 385, 355, 377, 403, 115, 375, 433, 412, 128,
-	449, 131, 417, 0, 173, 140, 0, 0, 405, 435,
-	407, 429, 400, 424, 367, 416, 444, 389, 420, 445,
-	0, 0, 0, 87, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 419, 440, 387, 452, 422, 353,
-	418, 0, 358, 361, 450, 438, 382, 383, 0, 0,
-	0, 0, 0, 0, 0, 404, 408, 426, 398, 0,
-	0, 0, 0, 0, 0, 0, 0, 380, 0, 415,
-	0, 0, 0, 364, 359, 0, 402, 0, 0, 0,
-	366, 0, 381, 427, 0, 351, 430, 436, 399, 200,
-	439, 397, 396, 159, 0, 107, 0, 179, 119, 390,
-	129, 425, 442, 406, 434, 378, 386, 109, 384, 166,
-	153, 191, 414, 154, 164, 132, 183, 160, 190, 201,
-	202, 181, 199, 168, 99, 147, 89, 158, 165, 0,
-	108, 0, 213, 214, 215, 216, 217, 218, 219, 92,
-	180, 189, 105, 169, 95, 187, 176, 178, 138, 124,
-	125, 171, 93, 94, 0, 163, 114, 157, 118, 113,
-	150, 177, 141, 184, 185, 110, 210, 112, 111, 175,
-	100, 197, 198, 97, 101, 196, 146, 151, 149, 195,
-	182, 188, 139, 136, 0, 96, 186, 137, 135, 127,
-	0, 116, 120, 155, 134, 156, 121, 143, 142, 144,
-	0, 148, 0, 0, 356, 0, 174, 193, 211, 212,
-	357, 374, 437, 203, 204, 205, 206, 0, 0, 0,
-	145, 102, 122, 170, 126, 133, 162, 209, 421, 167,
-	106, 192, 172, 370, 373, 368, 369, 410, 411, 446,
-	447, 448, 428, 365, 0, 371, 372, 0, 432, 123,
-	413, 90, 98, 130, 207, 208, 0, 161, 117, 194,
-	392, 352, 395, 0, 0, 0, 0, 0, 0, 0,
-	362, 363, 0, 103, 441, 431, 0, 401, 443, 376,
-	391, 451, 393, 394, 423, 360, 409, 152, 388, 91,
-	379, 354, 385, 355, 377, 403, 115, 375, 433, 412,
-	128, 449, 131, 417, 0, 173, 140, 0, 0, 405,
-	435, 407, 429, 400, 424, 367, 416, 444, 389, 420,
-	445, 0, 0, 0, 349, 0, 0, 0, 0, 0,
-	0, 0, 0, 104, 0, 419, 440, 387, 452, 422,
-	353, 418, 0, 358, 361, 450, 438, 382, 383, 0,
-	0, 0, 0, 0, 0, 0, 404, 408, 426, 398,
-	0, 0, 0, 0, 0, 0, 0, 0, 380, 0,
-	415, 0, 0, 0, 364, 359, 0, 402, 0, 0,
-	0, 366, 0, 381, 427, 0, 351, 430, 436, 399,
-	200, 439, 397, 396, 159, 0, 107, 0, 179, 119,
-	390, 129, 425, 442, 406, 434, 378, 386, 109, 384,
-	166, 153, 191, 414, 154, 164, 132, 183, 160, 190,
-	201, 202, 181, 199, 168, 99, 147, 89, 158, 165,
-	0, 108, 0, 213, 214, 215, 216, 217, 218, 219,
-	92, 180, 649, 105, 169, 95, 187, 176, 178, 138,
-	124, 125, 171, 93, 94, 0, 163, 114, 157, 118,
-	113, 150, 177, 141, 184, 185, 110, 210, 112, 111,
-	175, 100, 197, 198, 97, 347, 196, 146, 151, 149,
-	195, 182, 188, 139, 136, 0, 96, 186, 137, 135,
-	127, 0, 116, 120, 155, 134, 156, 121, 143, 142,
-	144, 0, 148, 0, 0, 356, 0, 174, 193, 211,
-	212, 357, 374, 437, 203, 204, 205, 206, 0, 0,
-	0, 348, 346, 122, 170, 126, 133, 162, 209, 421,
-	167, 106, 192, 172, 370, 373, 368, 369, 410, 411,
-	446, 447, 448, 428, 365, 0, 371, 372, 0, 432,
-	123, 413, 90, 98, 130, 207, 208, 0, 161, 117,
-	194, 392, 352, 395, 0, 0, 0, 0, 0, 0,
-	0, 362, 363, 0, 103, 441, 431, 0, 401, 443,
-	376, 391, 451, 393, 394, 423, 360, 409, 152, 388,
-	91, 379, 354, 385, 355, 377, 403, 115, 375, 433,
-	412, 128, 449, 131, 417, 0, 173, 140, 0, 0,
-	405, 435, 407, 429, 400, 424, 367, 416, 444, 389,
-	420, 445, 0, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 0, 0, 104, 0, 419, 440, 387, 452,
-	422, 353, 418, 0, 358, 361, 450, 438, 382, 383,
-	0, 0, 0, 0, 0, 0, 0, 404, 408, 426,
-	398, 0, 0, 0, 0, 0, 0, 0, 0, 380,
-	0, 415, 0, 0, 0, 364, 359, 0, 402, 0,
-	0, 0, 366, 0, 381, 427, 0, 351, 430, 436,
-	399, 200, 439, 397, 396, 159, 0, 107, 0, 179,
-	119, 390, 129, 425, 442, 406, 434, 378, 386, 109,
-	384, 166, 153, 191, 414, 154, 164, 132, 183, 160,
-	190, 201, 202, 181, 199, 168, 99, 147, 89, 158,
-	165, 0, 108, 0, 213, 214, 215, 216, 217, 218,
-	219, 92, 180, 338, 105, 169, 95, 187, 176, 178,
-	138, 124, 125, 171, 93, 94, 0, 163, 114, 157,
-	118, 113, 150, 177, 141, 184, 185, 110, 210, 112,
-	111, 175, 100, 197, 198, 97, 347, 196, 146, 151,
-	149, 195, 182, 188, 139, 136, 0, 96, 186, 137,
-	135, 127, 0, 116, 120, 155, 134, 156, 121, 143,
-	142, 144, 0, 148, 0, 0, 356, 0, 174, 193,
-	211, 212, 357, 374, 437, 203, 204, 205, 206, 0,
-	0, 0, 348, 346, 341, 340, 126, 133, 162, 209,
-	421, 167, 106, 192, 172, 370, 373, 368, 369, 410,
-	411, 446, 447, 448, 428, 365, 0, 371, 372, 0,
-	432, 123, 413, 90, 98, 130, 207, 208, 0, 161,
-	117, 194, 392, 352, 395, 0, 0, 0, 0, 152,
-	0, 91, 362, 363, 271, 103, 0, 0, 115, 268,
-	0, 0, 128, 310, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 301, 302, 0, 0, 0, 0, 0,
-	0, 880, 0, 50, 0, 0, 269, 289, 288, 291,
-	292, 293, 294, 0, 0, 104, 290, 295, 296, 297,
-	881, 0, 0, 266, 282, 0, 309, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 280, 0, 0,
-	0, 0, 322, 0, 281, 0, 0, 277, 278, 283,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 320, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 311, 321, 317, 318,
-	315, 316, 314, 313, 312, 323, 303, 304, 305, 306,
-	308, 0, 123, 307, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 816, 0, 271,
-	0, 0, 0, 115, 268, 319, 103, 128, 310, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 301, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 269, 289, 288, 291, 292, 293, 294, 0, 0,
-	104, 290, 295, 296, 297, 0, 0, 0, 266, 282,
-	0, 309, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 280, 262, 0, 0, 0, 322, 0, 281,
-	0, 0, 277, 278, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	320, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 311, 321, 317, 318, 315, 316, 314, 313, 312,
-	323, 303, 304, 305, 306, 308, 0, 123, 307, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 271, 0, 0, 0, 115, 268,
-	319, 103, 128, 310, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 301, 302, 0, 0, 0, 0, 0,
-	0, 0, 0, 50, 0, 499, 269, 289, 288, 291,
-	292, 293, 294, 0, 0, 104, 290, 295, 296, 297,
-	0, 0, 0, 266, 282, 0, 309, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 280, 0, 0,
-	0, 0, 322, 0, 281, 0, 0, 277, 278, 283,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 320, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 311, 321, 317, 318,
-	315, 316, 314, 313, 312, 323, 303, 304, 305, 306,
-	308, 0, 123, 307, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 271,
-	0, 0, 0, 115, 268, 319, 103, 128, 310, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 301, 302,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 269, 289, 288, 291, 292, 293, 294, 0, 0,
-	104, 290, 295, 296, 297, 0, 0, 0, 266, 282,
-	0, 309, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 280, 262, 0, 0, 0, 322, 0, 281,
-	0, 0, 277, 278, 283, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	320, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 311, 321, 317, 318, 315, 316, 314, 313, 312,
-	323, 303, 304, 305, 306, 308, 0, 123, 307, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 0,
-	23, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	319, 103, 152, 0, 91, 0, 0, 271, 0, 0,
-	0, 115, 268, 0, 0, 128, 310, 131, 0, 0,
-	173, 140, 0, 0, 0, 0, 301, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 269,
-	289, 288, 291, 292, 293, 294, 0, 0, 104, 290,
-	295, 296, 297, 0, 0, 0, 266, 282, 0, 309,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	280, 0, 0, 0, 0, 322, 0, 281, 0, 0,
-	277, 278, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 200, 0, 0, 320, 159,
-	0, 107, 0, 179, 119, 0, 129, 0, 0, 0,
-	0, 0, 0, 109, 0, 166, 153, 191, 0, 154,
-	164, 132, 183, 160, 190, 201, 202, 181, 199, 168,
-	99, 147, 89, 158, 165, 0, 108, 0, 213, 214,
-	215, 216, 217, 218, 219, 92, 180, 189, 105, 169,
-	95, 187, 176, 178, 138, 124, 125, 171, 93, 94,
-	0, 163, 114, 157, 118, 113, 150, 177, 141, 184,
-	185, 110, 210, 112, 111, 175, 100, 197, 198, 97,
-	101, 196, 146, 151, 149, 195, 182, 188, 139, 136,
-	0, 96, 186, 137, 135, 127, 0, 116, 120, 155,
-	134, 156, 121, 143, 142, 144, 0, 148, 0, 0,
-	0, 0, 174, 193, 211, 212, 0, 0, 0, 203,
-	204, 205, 206, 0, 0, 0, 145, 102, 122, 170,
-	126, 133, 162, 209, 0, 167, 106, 192, 172, 311,
-	321, 317, 318, 315, 316, 314, 313, 312, 323, 303,
-	304, 305, 306, 308, 0, 123, 307, 90, 98, 130,
-	207, 208, 0, 161, 117, 194, 0, 152, 0, 91,
-	0, 0, 271, 0, 0, 0, 115, 268, 319, 103,
-	128, 310, 131, 0, 0, 173, 140, 0, 0, 0,
-	0, 301, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 50, 0, 0, 269, 289, 288, 291, 292, 293,
-	294, 0, 0, 104, 290, 295, 296, 297, 0, 0,
-	0, 266, 282, 0, 309, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 280, 0, 0, 0, 0,
-	322, 0, 281, 0, 0, 277, 278, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	200, 0, 0, 320, 159, 0, 107, 0, 179, 119,
-	0, 129, 0, 0, 0, 0, 0, 0, 109, 0,
-	166, 153, 191, 0, 154, 164, 132, 183, 160, 190,
-	201, 202, 181, 199, 168, 99, 147, 89, 158, 165,
-	0, 108, 0, 213, 214, 215, 216, 217, 218, 219,
-	92, 180, 189, 105, 169, 95, 187, 176, 178, 138,
-	124, 125, 171, 93, 94, 0, 163, 114, 157, 118,
-	113, 150, 177, 141, 184, 185, 110, 210, 112, 111,
-	175, 100, 197, 198, 97, 101, 196, 146, 151, 149,
-	195, 182, 188, 139, 136, 0, 96, 186, 137, 135,
-	127, 0, 116, 120, 155, 134, 156, 121, 143, 142,
-	144, 0, 148, 0, 0, 0, 0, 174, 193, 211,
-	212, 0, 0, 0, 203, 204, 205, 206, 0, 0,
-	0, 145, 102, 122, 170, 126, 133, 162, 209, 0,
-	167, 106, 192, 172, 311, 321, 317, 318, 315, 316,
-	314, 313, 312, 323, 303, 304, 305, 306, 308, 0,
-	123, 307, 90, 98, 130, 207, 208, 0, 161, 117,
-	194, 0, 152, 0, 91, 0, 0, 0, 0, 0,
-	0, 115, 0, 319, 103, 128, 310, 131, 0, 0,
-	173, 140, 0, 0, 0, 0, 301, 302, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 269,
-	289, 288, 291, 292, 293, 294, 0, 0, 104, 290,
-	295, 296, 297, 0, 0, 0, 0, 282, 0, 309,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	280, 0, 0, 0, 0, 322, 0, 281, 0, 0,
-	277, 278, 283, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 200, 0, 0, 320, 159,
-	0, 107, 0, 179, 119, 0, 129, 0, 0, 0,
-	0, 0, 0, 109, 0, 166, 153, 191, 1626, 154,
-	164, 132, 183, 160, 190, 201, 202, 181, 199, 168,
-	99, 147, 89, 158, 165, 0, 108, 0, 213, 214,
-	215, 216, 217, 218, 219, 92, 180, 189, 105, 169,
-	95, 187, 176, 178, 138, 124, 125, 171, 93, 94,
-	0, 163, 114, 157, 118, 113, 150, 177, 141, 184,
-	185, 110, 210, 112, 111, 175, 100, 197, 198, 97,
-	101, 196, 146, 151, 149, 195, 182, 188, 139, 136,
-	0, 96, 186, 137, 135, 127, 0, 116, 120, 155,
-	134, 156, 121, 143, 142, 144, 0, 148, 0, 0,
-	0, 0, 174, 193, 211, 212, 0, 0, 0, 203,
-	204, 205, 206, 0, 0, 0, 145, 102, 122, 170,
-	126, 133, 162, 209, 0, 167, 106, 192, 172, 311,
-	321, 317, 318, 315, 316, 314, 313, 312, 323, 303,
-	304, 305, 306, 308, 0, 123, 307, 90, 98, 130,
-	207, 208, 0, 161, 117, 194, 0, 152, 0, 91,
-	0, 0, 0, 0, 0, 0, 115, 0, 319, 103,
-	128, 310, 131, 0, 0, 173, 140, 0, 0, 0,
-	0, 301, 302, 0, 0, 0, 0, 0, 0, 0,
-	0, 50, 0, 0, 269, 289, 288, 291, 292, 293,
-	294, 0, 0, 104, 290, 295, 296, 297, 0, 0,
-	0, 0, 282, 0, 309, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 280, 0, 0, 0, 0,
-	322, 0, 281, 0, 0, 277, 278, 283, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	200, 0, 0, 320, 159, 0, 107, 0, 179, 119,
-	0, 129, 0, 0, 0, 0, 0, 0, 109, 0,
-	166, 153, 191, 0, 154, 164, 132, 183, 160, 190,
-	201, 202, 181, 199, 168, 99, 147, 89, 158, 165,
-	0, 108, 0, 213, 214, 215, 216, 217, 218, 219,
-	92, 180, 189, 105, 169, 95, 187, 176, 178, 138,
-	124, 125, 171, 93, 94, 0, 163, 114, 157, 118,
-	113, 150, 177, 141, 184, 185, 110, 210, 112, 111,
-	175, 100, 197, 198, 97, 101, 196, 146, 151, 149,
-	195, 182, 188, 139, 136, 0, 96, 186, 137, 135,
-	127, 0, 116, 120, 155, 134, 156, 121, 143, 142,
-	144, 0, 148, 0, 0, 0, 0, 174, 193, 211,
-	212, 0, 0, 0, 203, 204, 205, 206, 0, 0,
-	0, 145, 102, 122, 170, 126, 133, 162, 209, 0,
-	167, 106, 192, 172, 311, 321, 317, 318, 315, 316,
-	314, 313, 312, 323, 303, 304, 305, 306, 308, 0,
-	123, 307, 90, 98, 130, 207, 208, 0, 161, 117,
-	194, 0, 152, 0, 91, 0, 0, 0, 0, 0,
-	0, 115, 0, 319, 103, 128, 0, 131, 0, 0,
-	173, 140, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 349,
-	0, 0, 0, 0, 0, 0, 0, 0, 104, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 533, 532, 542, 543, 535,
-	536, 537, 538, 539, 540, 541, 534, 0, 0, 544,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 200, 0, 0, 0, 159,
-	0, 107, 0, 179, 119, 0, 129, 0, 0, 0,
-	0, 0, 0, 109, 0, 166, 153, 191, 0, 154,
-	164, 132, 183, 160, 190, 201, 202, 181, 199, 168,
-	99, 147, 89, 158, 165, 0, 108, 0, 213, 214,
-	215, 216, 217, 218, 219, 92, 180, 189, 105, 169,
-	95, 187, 176, 178, 138, 124, 125, 171, 93, 94,
-	0, 163, 114, 157, 118, 113, 150, 177, 141, 184,
-	185, 110, 210, 112, 111, 175, 100, 197, 198, 97,
-	101, 196, 146, 151, 149, 195, 182, 188, 139, 136,
-	0, 96, 186, 137, 135, 127, 0, 116, 120, 155,
-	134, 156, 121, 143, 142, 144, 0, 148, 0, 0,
-	0, 0, 174, 193, 211, 212, 0, 0, 0, 203,
-	204, 205, 206, 0, 0, 0, 145, 102, 122, 170,
-	126, 133, 162, 209, 0, 167, 106, 192, 172, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 90, 98, 130,
-	207, 208, 0, 161, 117, 194, 0, 152, 0, 91,
-	0, 521, 0, 0, 0, 0, 115, 0, 545, 103,
-	128, 0, 131, 0, 0, 173, 140, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 349, 0, 523, 0, 0, 0,
-	0, 0, 0, 104, 0, 0, 0, 0, 0, 518,
-	517, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 519, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	200, 0, 0, 0, 159, 0, 107, 0, 179, 119,
-	0, 129, 0, 0, 0, 0, 0, 0, 109, 0,
-	166, 153, 191, 0, 154, 164, 132, 183, 160, 190,
-	201, 202, 181, 199, 168, 99, 147, 89, 158, 165,
-	0, 108, 0, 213, 214, 215, 216, 217, 218, 219,
-	92, 180, 189, 105, 169, 95, 187, 176, 178, 138,
-	124, 125, 171, 93, 94, 0, 163, 114, 157, 118,
-	113, 150, 177, 141, 184, 185, 110, 210, 112, 111,
-	175, 100, 197, 198, 97, 101, 196, 146, 151, 149,
-	195, 182, 188, 139, 136, 0, 96, 186, 137, 135,
-	127, 0, 116, 120, 155, 134, 156, 121, 143, 142,
-	144, 0, 148, 0, 0, 0, 0, 174, 193, 211,
-	212, 0, 0, 0, 203, 204, 205, 206, 0, 0,
-	0, 145, 102, 122, 170, 126, 133, 162, 209, 0,
-	167, 106, 192, 172, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 90, 98, 130, 207, 208, 0, 161, 117,
-	194, 0, 152, 0, 91, 0, 638, 0, 0, 0,
-	0, 115, 0, 0, 103, 128, 0, 131, 0, 0,
-	173, 140, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 87,
-	0, 640, 0, 0, 0, 0, 0, 0, 104, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 200, 0, 0, 0, 159,
-	0, 107, 0, 179, 119, 0, 129, 0, 0, 0,
-	0, 0, 0, 109, 0, 166, 153, 191, 0, 154,
-	164, 132, 183, 160, 190, 201, 202, 181, 199, 168,
-	99, 147, 89, 158, 165, 0, 108, 0, 213, 214,
-	215, 216, 217, 218, 219, 92, 180, 189, 105, 169,
-	95, 187, 176, 178, 138, 124, 125, 171, 93, 94,
-	0, 163, 114, 157, 118, 113, 150, 177, 141, 184,
-	185, 110, 210, 112, 111, 175, 100, 197, 198, 97,
-	101, 196, 146, 151, 149, 195, 182, 188, 139, 136,
-	0, 96, 186, 137, 135, 127, 0, 116, 120, 155,
-	134, 156, 121, 143, 142, 144, 0, 148, 0, 0,
-	0, 0, 174, 193, 211, 212, 0, 0, 0, 203,
-	204, 205, 206, 0, 0, 0, 145, 102, 122, 170,
-	126, 133, 162, 209, 0, 167, 106, 192, 172, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 23, 90, 98, 130,
-	207, 208, 0, 161, 117, 194, 0, 0, 152, 0,
-	91, 0, 0, 0, 0, 0, 0, 115, 0, 103,
-	0, 128, 0, 131, 0, 0, 173, 140, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 50, 0, 0, 349, 0, 0, 0, 0,
-	0, 0, 0, 0, 104, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 200, 0, 0, 0, 159, 0, 107, 0, 179,
-	119, 0, 129, 0, 0, 0, 0, 0, 0, 109,
-	0, 166, 153, 191, 0, 154, 164, 132, 183, 160,
-	190, 201, 202, 181, 199, 168, 99, 147, 89, 158,
-	165, 0, 108, 0, 213, 214, 215, 216, 217, 218,
-	219, 92, 180, 189, 105, 169, 95, 187, 176, 178,
-	138, 124, 125, 171, 93, 94, 0, 163, 114, 157,
-	118, 113, 150, 177, 141, 184, 185, 110, 210, 112,
-	111, 175, 100, 197, 198, 97, 101, 196, 146, 151,
-	149, 195, 182, 188, 139, 136, 0, 96, 186, 137,
-	135, 127, 0, 116, 120, 155, 134, 156, 121, 143,
-	142, 144, 0, 148, 0, 0, 0, 0, 174, 193,
-	211, 212, 0, 0, 0, 203, 204, 205, 206, 0,
-	0, 0, 145, 102, 122, 170, 126, 133, 162, 209,
-	0, 167, 106, 192, 172, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 23, 90, 98, 130, 207, 208, 0, 161,
-	117, 194, 0, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 0, 103, 0, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 50, 0,
-	0, 87, 0, 0, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 0, 0, 0, 0, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 766,
-	0, 0, 767, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 658, 0, 103, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 657, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 638, 0, 0, 0, 0, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 0, 640, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 636, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 0, 0, 103, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 0, 0, 0, 1607, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 1255, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 0, 0, 103, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 349, 0, 0, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 1359, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 0, 0, 0, 0, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 50, 0, 0, 87, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 0, 0, 103, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 0, 640, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 0, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 0, 0, 0, 0, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 349, 0, 523, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 0,
-	161, 117, 194, 0, 152, 0, 91, 0, 0, 0,
-	0, 0, 0, 115, 0, 0, 103, 128, 0, 131,
-	0, 0, 173, 140, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 0, 0, 0, 0,
-	104, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 200, 0, 0,
-	0, 159, 0, 107, 0, 179, 119, 0, 129, 0,
-	0, 0, 0, 0, 0, 109, 0, 166, 153, 191,
-	0, 154, 164, 132, 183, 160, 190, 201, 202, 181,
-	199, 168, 99, 147, 89, 158, 165, 0, 108, 0,
-	213, 214, 215, 216, 217, 218, 219, 92, 180, 189,
-	105, 169, 95, 187, 176, 178, 138, 124, 125, 171,
-	93, 94, 0, 163, 114, 157, 118, 113, 150, 177,
-	141, 184, 185, 110, 210, 112, 111, 175, 100, 197,
-	198, 97, 101, 196, 146, 151, 149, 195, 182, 188,
-	139, 136, 0, 96, 186, 137, 135, 127, 0, 116,
-	120, 155, 134, 156, 121, 143, 142, 144, 0, 148,
-	0, 0, 0, 0, 174, 193, 211, 212, 0, 0,
-	0, 203, 204, 205, 206, 0, 0, 0, 145, 102,
-	122, 170, 126, 133, 162, 209, 726, 167, 106, 192,
-	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 90,
-	98, 130, 207, 208, 0, 161, 117, 194, 0, 152,
-	0, 91, 0, 0, 0, 0, 0, 616, 115, 0,
-	0, 103, 128, 0, 131, 0, 0, 173, 140, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 87, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 200, 0, 0, 0, 159, 0, 107, 0,
-	179, 119, 0, 129, 0, 0, 0, 0, 0, 0,
-	109, 0, 166, 153, 191, 0, 154, 164, 132, 183,
-	160, 190, 201, 202, 181, 199, 168, 99, 147, 89,
-	158, 165, 0, 108, 0, 213, 214, 215, 216, 217,
-	218, 219, 92, 180, 189, 105, 169, 95, 187, 176,
-	178, 138, 124, 125, 171, 93, 94, 0, 163, 114,
-	157, 118, 113, 150, 177, 141, 184, 185, 110, 210,
-	112, 111, 175, 100, 197, 198, 97, 101, 196, 146,
-	151, 149, 195, 182, 188, 139, 136, 0, 96, 186,
-	137, 135, 127, 0, 116, 120, 155, 134, 156, 121,
-	143, 142, 144, 0, 148, 0, 0, 0, 0, 174,
-	193, 211, 212, 0, 0, 0, 203, 204, 205, 206,
-	0, 0, 0, 145, 102, 122, 170, 126, 133, 162,
-	209, 0, 167, 106, 192, 172, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 90, 98, 130, 207, 208, 333,
-	161, 117, 194, 0, 0, 0, 152, 0, 91, 0,
-	0, 0, 0, 0, 0, 115, 103, 0, 0, 128,
-	0, 131, 0, 0, 173, 140, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 200,
-	0, 0, 0, 159, 0, 107, 0, 179, 119, 0,
-	129, 0, 0, 0, 0, 0, 0, 109, 0, 166,
-	153, 191, 0, 154, 164, 132, 183, 160, 190, 201,
-	202, 181, 199, 168, 99, 147, 89, 158, 165, 0,
-	108, 0, 213, 214, 215, 216, 217, 218, 219, 92,
-	180, 189, 105, 169, 95, 187, 176, 178, 138, 124,
-	125, 171, 93, 94, 0, 163, 114, 157, 118, 113,
-	150, 177, 141, 184, 185, 110, 210, 112, 111, 175,
-	100, 197, 198, 97, 101, 196, 146, 151, 149, 195,
-	182, 188, 139, 136, 0, 96, 186, 137, 135, 127,
-	0, 116, 120, 155, 134, 156, 121, 143, 142, 144,
-	0, 148, 0, 0, 0, 0, 174, 193, 211, 212,
-	0, 0, 0, 203, 204, 205, 206, 0, 0, 0,
-	145, 102, 122, 170, 126, 133, 162, 209, 0, 167,
-	106, 192, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 90, 98, 130, 207, 208, 0, 161, 117, 194,
-	0, 152, 0, 91, 0, 0, 0, 0, 0, 0,
-	115, 0, 0, 103, 128, 0, 131, 0, 0, 173,
-	140, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 200, 0, 0, 0, 159, 0,
-	107, 0, 179, 119, 0, 129, 0, 0, 0, 0,
-	0, 0, 109, 0, 166, 153, 191, 0, 154, 164,
-	132, 183, 160, 190, 201, 202, 181, 199, 168, 99,
-	147, 89, 158, 165, 0, 108, 0, 213, 214, 215,
-	216, 217, 218, 219, 92, 180, 189, 105, 169, 95,
-	187, 176, 178, 138, 124, 125, 171, 93, 94, 0,
-	163, 114, 157, 118, 113, 150, 177, 141, 184, 185,
-	110, 210, 112, 111, 175, 100, 197, 198, 97, 101,
-	196, 146, 151, 149, 195, 182, 188, 139, 136, 0,
-	96, 186, 137, 135, 127, 0, 116, 120, 155, 134,
-	156, 121, 143, 142, 144, 0, 148, 0, 0, 0,
-	0, 174, 193, 211, 212, 0, 0, 0, 203, 204,
-	205, 206, 0, 0, 0, 145, 102, 122, 170, 126,
-	133, 162, 209, 0, 167, 106, 192, 172, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 90, 98, 130, 207,
-	208, 0, 161, 117, 194, 0, 152, 0, 91, 0,
-	0, 0, 0, 0, 0, 115, 0, 0, 103, 128,
-	0, 131, 0, 0, 173, 140, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 200,
-	0, 0, 0, 159, 0, 107, 0, 179, 119, 0,
-	129, 0, 0, 0, 0, 0, 0, 109, 0, 166,
-	153, 191, 0, 154, 164, 132, 183, 160, 190, 201,
-	202, 181, 199, 168, 99, 147, 89, 158, 165, 0,
-	108, 0, 213, 214, 215, 216, 217, 218, 219, 92,
-	180, 189, 105, 169, 95, 187, 176, 178, 138, 124,
-	125, 171, 93, 94, 0, 163, 114, 157, 118, 113,
-	150, 177, 141, 184, 185, 110, 210, 112, 111, 175,
-	100, 197, 198, 97, 101, 196, 146, 151, 149, 195,
-	182, 188, 139, 136, 0, 96, 186, 137, 135, 127,
-	0, 116, 120, 155, 134, 156, 121, 143, 142, 144,
-	0, 148, 0, 0, 0, 0, 174, 193, 211, 212,
-	0, 0, 0, 203, 204, 205, 206, 0, 0, 0,
-	145, 102, 122, 170, 126, 133, 162, 209, 0, 167,
-	106, 192, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 90, 98, 130, 207, 208, 0, 161, 117, 194,
-	0, 152, 0, 91, 0, 0, 0, 0, 0, 0,
-	115, 0, 0, 103, 128, 0, 131, 0, 0, 173,
-	140, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 87, 0,
-	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 200, 0, 0, 0, 159, 0,
-	107, 0, 179, 119, 0, 129, 0, 0, 0, 0,
-	0, 0, 109, 0, 166, 153, 191, 0, 154, 164,
-	132, 183, 160, 190, 201, 202, 181, 199, 168, 99,
-	147, 89, 158, 165, 0, 108, 0, 213, 214, 215,
-	216, 217, 218, 219, 92, 180, 189, 105, 169, 95,
-	187, 176, 178, 138, 124, 125, 171, 93, 94, 0,
-	163, 114, 157, 118, 113, 150, 177, 141, 184, 185,
-	110, 210, 112, 111, 175, 100, 197, 198, 97, 101,
-	196, 146, 151, 149, 195, 182, 188, 139, 136, 0,
-	96, 186, 137, 135, 127, 0, 116, 120, 155, 134,
-	156, 121, 143, 142, 144, 0, 148, 0, 0, 0,
-	0, 174, 193, 211, 212, 0, 0, 0, 203, 204,
-	205, 206, 0, 0, 0, 145, 102, 122, 170, 126,
-	133, 162, 209, 0, 167, 106, 192, 172, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 90, 98, 130, 207,
-	208, 0, 161, 117, 194, 0, 152, 0, 91, 0,
-	0, 0, 0, 0, 0, 115, 0, 0, 103, 128,
-	0, 131, 0, 0, 173, 140, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 269, 0, 0, 0, 0, 0, 0,
-	0, 0, 104, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 200,
-	0, 0, 0, 159, 0, 107, 0, 179, 119, 0,
-	129, 0, 0, 0, 0, 0, 0, 109, 0, 166,
-	153, 191, 0, 154, 164, 132, 183, 160, 190, 201,
-	202, 181, 199, 168, 99, 147, 89, 158, 165, 0,
-	108, 0, 213, 214, 215, 216, 217, 218, 219, 92,
-	180, 189, 105, 169, 95, 187, 176, 178, 138, 124,
-	125, 171, 93, 94, 0, 163, 114, 157, 118, 113,
-	150, 177, 141, 184, 185, 110, 210, 112, 111, 175,
-	100, 197, 198, 97, 101, 196, 146, 151, 149, 195,
-	182, 188, 139, 136, 0, 96, 186, 137, 135, 127,
-	0, 116, 120, 155, 134, 156, 121, 143, 142, 144,
-	0, 148, 0, 0, 0, 0, 174, 193, 211, 212,
-	0, 0, 0, 203, 204, 205, 206, 0, 0, 0,
-	145, 102, 122, 170, 126, 133, 162, 209, 0, 167,
-	106, 192, 172, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 90, 98, 130, 207, 208, 0, 161, 117, 194,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 103,
+	284, 1708, 1322, 1098, 1638, 1696, 1006, 746, 1662, 1612,
+	878, 1503, 1355, 1323, 1507, 590, 1485, 288, 546, 1296,
+	1527, 281, 1131, 1504, 1486, 1136, 965, 589, 3, 918,
+	841, 1459, 1297, 1207, 287, 896, 92, 923, 849, 92,
+	687, 313, 1139, 658, 1293, 998, 656, 256, 929, 1157,
+	505, 949, 922, 348, 1114, 879, 1062, 852, 55, 314,
+	49, 262, 1270, 68, 92, 92, 364, 993, 1192, 1195,
+	92, 943, 1103, 364, 970, 674, 364, 261, 866, 816,
+	520, 92, 526, 92, 685, 673, 660, 645, 353, 92,
+	532, 298, 341, 257, 258, 259, 260, 359, 875, 340,
+	286, 470, 613, 1044, 271, 694, 350, 689, 540, 49,
+	339, 1345, 1176, 54, 1363, 1767, 356, 267, 519, 275,
+	1348, 290, 604, 345, 1356, 1654, 555, 554, 564, 565,
+	557, 558, 559, 560, 561, 562, 563, 556, 566, 1367,
+	566, 557, 558, 559, 560, 561, 562, 563, 556, 915,
+	967, 566, 556, 1357, 1358, 566, 555, 554, 564, 565,
+	557, 558, 559, 560, 561, 562, 563, 556, 1500, 1501,
+	566, 980, 559, 560, 561, 562, 563, 556, 1443, 519,
+	566, 52, 548, 1172, 553, 344, 1802, 1763, 1747, 851,
+	568, 569, 570, 571, 572, 573, 574, 503, 549, 550,
+	551, 547, 555, 554, 564, 565, 557, 558, 559, 560,
+	561, 562, 563, 556, 552, 1798, 566, 555, 554, 564,
+	565, 557, 558, 559, 560, 561, 562, 563, 556, 1796,
+	1265, 566, 1749, 1704, 92, 1474, 1788, 966, 364, 364,
+	364, 364, 1756, 364, 1528, 1529, 1530, 1196, 1197, 1007,
+	364, 555, 554, 564, 565, 557, 558, 559, 560, 561,
+	562, 563, 556, 1585, 1754, 566, 1734, 1616, 1746, 1703,
+	1288, 1663, 1493, 1494, 1342, 1135, 1347, 1343, 364, 555,
+	554, 564, 565, 557, 558, 559, 560, 561, 562, 563,
+	556, 1673, 1447, 566, 482, 529, 1318, 504, 504, 504,
+	504, 1161, 504, 1163, 1162, 1346, 1357, 1358, 909, 504,
+	513, 1319, 1320, 581, 582, 583, 584, 585, 586, 587,
+	1122, 1536, 1535, 1121, 528, 1178, 1123, 49, 1063, 910,
+	911, 1655, 675, 498, 676, 1171, 777, 969, 1560, 92,
+	981, 870, 576, 778, 1394, 578, 92, 92, 92, 971,
+	1393, 567, 364, 567, 1762, 1436, 1764, 1434, 364, 87,
+	83, 84, 85, 254, 567, 1620, 994, 1613, 567, 1758,
+	1440, 519, 588, 1765, 592, 593, 594, 595, 596, 597,
+	598, 599, 600, 567, 603, 605, 605, 605, 605, 605,
+	605, 605, 605, 567, 634, 635, 636, 637, 500, 1510,
+	502, 665, 356, 1405, 1406, 657, 1362, 577, 1648, 555,
+	554, 564, 565, 557, 558, 559, 560, 561, 562, 563,
+	556, 509, 510, 566, 1127, 264, 1697, 499, 501, 567,
+	1243, 876, 1795, 1786, 1523, 1698, 506, 507, 508, 618,
+	511, 619, 59, 1642, 567, 1409, 945, 515, 1568, 606,
+	607, 608, 609, 610, 611, 612, 1146, 1144, 671, 1496,
+	1410, 946, 1474, 1757, 1495, 1411, 1166, 344, 61, 62,
+	63, 64, 65, 364, 1165, 92, 92, 1141, 567, 1474,
+	1344, 1755, 92, 1776, 92, 364, 1420, 92, 517, 1134,
+	92, 1645, 981, 1553, 92, 516, 364, 364, 364, 364,
+	364, 364, 364, 364, 974, 995, 567, 487, 478, 86,
+	364, 364, 80, 81, 81, 92, 1544, 1702, 92, 756,
+	897, 899, 945, 945, 1113, 1444, 475, 1508, 1509, 1511,
+	474, 1112, 364, 1111, 780, 472, 92, 946, 946, 483,
+	1240, 233, 364, 82, 504, 1244, 1794, 497, 579, 580,
+	1441, 1659, 1605, 1456, 1257, 504, 504, 504, 504, 504,
+	504, 504, 504, 1056, 1039, 788, 544, 493, 815, 504,
+	504, 824, 825, 826, 827, 828, 829, 830, 831, 832,
+	833, 834, 835, 836, 837, 838, 839, 840, 364, 817,
+	793, 765, 917, 916, 1602, 813, 898, 763, 555, 554,
+	564, 565, 557, 558, 559, 560, 561, 562, 563, 556,
+	785, 1388, 566, 1036, 539, 861, 862, 1040, 1482, 1038,
+	856, 868, 1481, 555, 554, 564, 565, 557, 558, 559,
+	560, 561, 562, 563, 556, 795, 567, 566, 49, 1241,
+	92, 1239, 814, 92, 92, 92, 92, 92, 812, 537,
+	810, 1480, 592, 1479, 1242, 92, 1478, 1477, 92, 880,
+	1476, 1475, 92, 1389, 1472, 539, 1402, 92, 92, 818,
+	844, 364, 823, 618, 872, 619, 1101, 791, 792, 1248,
+	846, 847, 755, 677, 364, 856, 821, 1290, 822, 820,
+	867, 864, 1037, 766, 767, 768, 769, 770, 771, 772,
+	773, 749, 345, 345, 345, 345, 345, 774, 775, 1720,
+	867, 530, 1085, 904, 806, 808, 809, 657, 1678, 900,
+	807, 356, 1149, 538, 537, 1586, 345, 568, 569, 570,
+	571, 572, 573, 574, 924, 486, 882, 883, 69, 885,
+	539, 893, 881, 534, 1587, 884, 964, 1254, 901, 364,
+	1780, 364, 92, 78, 1247, 92, 1255, 92, 907, 906,
+	92, 364, 1779, 902, 344, 344, 344, 344, 344, 1619,
+	346, 972, 973, 975, 976, 977, 519, 978, 979, 344,
+	787, 538, 537, 857, 858, 1000, 927, 1761, 344, 863,
+	471, 79, 538, 537, 988, 989, 990, 991, 539, 992,
+	1724, 1760, 74, 76, 996, 997, 89, 1618, 504, 539,
+	504, 1075, 477, 1074, 1726, 786, 1759, 75, 77, 1076,
+	504, 489, 490, 491, 871, 567, 873, 874, 1003, 1721,
+	538, 537, 538, 537, 1629, 349, 72, 1251, 22, 813,
+	473, 538, 537, 1059, 1060, 1061, 1252, 539, 1292, 539,
+	567, 484, 1588, 485, 338, 842, 945, 843, 539, 492,
+	1520, 939, 817, 936, 1179, 940, 941, 538, 537, 1583,
+	942, 946, 1045, 1057, 1046, 52, 1538, 982, 983, 984,
+	985, 1567, 1064, 1533, 539, 819, 814, 479, 1537, 481,
+	1065, 1053, 1054, 1055, 1519, 1378, 266, 1201, 1179, 1199,
+	1058, 364, 1179, 1095, 92, 1473, 1116, 1422, 1118, 1193,
+	1168, 555, 554, 564, 565, 557, 558, 559, 560, 561,
+	562, 563, 556, 364, 1470, 566, 1354, 1469, 1808, 303,
+	302, 305, 306, 307, 308, 1096, 1097, 364, 304, 309,
+	1689, 1807, 818, 1353, 1084, 1352, 1010, 1351, 1012, 1340,
+	1117, 364, 73, 1469, 1799, 1469, 1789, 1108, 1034, 1147,
+	1129, 92, 1124, 345, 1601, 1787, 1722, 1723, 1725, 1727,
+	1728, 1601, 1785, 924, 1601, 1777, 1689, 1775, 1689, 1751,
+	519, 1128, 1742, 519, 1119, 1009, 1159, 1601, 1738, 1684,
+	1052, 845, 71, 1601, 1737, 1601, 1732, 1138, 1586, 1601,
+	1731, 1634, 92, 364, 494, 1598, 1594, 1597, 364, 1601,
+	1716, 1151, 1142, 1143, 1145, 310, 311, 1587, 1167, 1615,
+	1715, 1575, 1694, 1174, 762, 344, 938, 854, 519, 1601,
+	1635, 1633, 1204, 1205, 364, 1575, 1626, 92, 92, 312,
+	1068, 1615, 1614, 1601, 1600, 1575, 519, 1381, 92, 1182,
+	1575, 1576, 667, 519, 1082, 937, 1194, 364, 1208, 1198,
+	1469, 1468, 1211, 1200, 49, 49, 761, 555, 554, 564,
+	565, 557, 558, 559, 560, 561, 562, 563, 556, 750,
+	1213, 566, 1315, 519, 1260, 1266, 748, 1267, 1455, 519,
+	1253, 1397, 1396, 504, 1391, 1392, 1263, 364, 364, 1284,
+	1285, 1286, 1287, 495, 1295, 358, 1282, 1262, 488, 640,
+	471, 880, 476, 1391, 1390, 480, 1264, 880, 664, 1069,
+	519, 1317, 642, 519, 1300, 1069, 364, 1269, 364, 92,
+	1186, 364, 1188, 1189, 1190, 1191, 1298, 814, 567, 1283,
+	1099, 1289, 684, 683, 1180, 1181, 854, 1183, 1184, 1185,
+	1451, 1690, 1303, 1689, 1299, 1305, 49, 1304, 564, 565,
+	557, 558, 559, 560, 561, 562, 563, 556, 1338, 668,
+	566, 1311, 1312, 1313, 24, 1316, 924, 1294, 1321, 24,
+	1099, 924, 1337, 1100, 56, 554, 564, 565, 557, 558,
+	559, 560, 561, 562, 563, 556, 1093, 1125, 566, 1094,
+	1100, 1080, 1361, 903, 1570, 667, 1078, 642, 669, 641,
+	667, 24, 1365, 364, 1373, 1525, 364, 1401, 364, 52,
+	1368, 1069, 1395, 642, 52, 52, 364, 1025, 1399, 1398,
+	1797, 1245, 908, 642, 1069, 670, 1369, 1371, 92, 1024,
+	1099, 789, 1079, 1778, 364, 744, 745, 1077, 1744, 1739,
+	268, 1713, 752, 518, 753, 1711, 52, 757, 364, 1668,
+	760, 92, 1641, 1424, 1413, 1640, 1029, 647, 650, 651,
+	652, 648, 1415, 649, 653, 1023, 1637, 358, 358, 358,
+	358, 1636, 358, 1627, 1611, 779, 1418, 1427, 783, 358,
+	1610, 971, 1554, 1421, 567, 52, 999, 1375, 1372, 1370,
+	1350, 1339, 1309, 994, 1173, 1126, 802, 1425, 1262, 987,
+	364, 986, 364, 364, 364, 92, 364, 542, 1104, 1105,
+	345, 1432, 364, 1001, 1002, 1020, 1017, 1018, 67, 1016,
+	1382, 1383, 747, 1385, 1386, 1387, 1132, 1140, 1621, 1617,
+	1450, 1400, 1294, 1462, 1463, 1464, 1429, 1430, 1445, 1431,
+	1212, 364, 1148, 1433, 1384, 1435, 1107, 1030, 1465, 1129,
+	759, 751, 1027, 514, 255, 890, 1458, 801, 888, 1506,
+	891, 1110, 924, 889, 1109, 1512, 364, 892, 1467, 651,
+	652, 887, 344, 567, 886, 272, 273, 1773, 1745, 1256,
+	1498, 358, 1041, 1771, 1051, 1050, 1483, 679, 1159, 1491,
+	364, 92, 364, 364, 1515, 533, 1497, 1522, 1516, 364,
+	877, 567, 521, 1449, 1187, 1539, 682, 496, 531, 1377,
+	1022, 364, 1513, 522, 1551, 1555, 1546, 1011, 1547, 1548,
+	1549, 1489, 758, 1376, 1210, 1542, 1524, 1005, 905, 1545,
+	1543, 1049, 1004, 743, 655, 269, 270, 533, 1048, 1404,
+	1021, 263, 1208, 924, 56, 1647, 364, 364, 1491, 1552,
+	1558, 1100, 1561, 1562, 535, 1563, 1564, 1565, 1680, 364,
+	1360, 1359, 277, 1679, 1656, 364, 1164, 784, 58, 60,
+	1216, 1408, 1571, 1582, 1569, 666, 53, 1, 1499, 1026,
+	1489, 1682, 1170, 1298, 1341, 1133, 364, 70, 1581, 1599,
+	1733, 1580, 1688, 1015, 1366, 1403, 1209, 1224, 1008, 1608,
+	1206, 1299, 742, 1028, 1572, 1019, 1695, 1596, 1487, 924,
+	934, 1606, 1013, 469, 358, 1031, 66, 1032, 1471, 1676,
+	1033, 1625, 933, 932, 1531, 358, 358, 358, 358, 358,
+	358, 358, 358, 1624, 944, 364, 935, 1630, 588, 358,
+	358, 931, 930, 928, 1177, 364, 781, 968, 692, 524,
+	690, 691, 1532, 688, 1534, 1603, 695, 241, 351, 654,
+	678, 797, 536, 1238, 1237, 1014, 364, 1246, 776, 1035,
+	512, 542, 243, 575, 358, 1047, 1643, 1120, 357, 1301,
+	1657, 790, 525, 1646, 1557, 90, 1083, 601, 253, 865,
+	1658, 289, 1559, 805, 301, 300, 364, 299, 796, 1092,
+	279, 364, 1298, 343, 638, 1644, 364, 646, 1674, 644,
+	643, 278, 1671, 90, 90, 1106, 1664, 848, 1672, 90,
+	1299, 1669, 49, 1665, 1102, 1677, 342, 781, 781, 1675,
+	90, 1259, 90, 781, 1685, 1446, 1653, 800, 90, 26,
+	57, 274, 19, 1686, 1687, 1693, 1667, 1691, 1692, 18,
+	364, 1491, 17, 20, 1700, 21, 16, 1705, 15, 364,
+	14, 1491, 30, 1712, 880, 13, 1710, 12, 11, 10,
+	1714, 781, 1631, 9, 1632, 8, 7, 6, 5, 1730,
+	364, 1719, 4, 1489, 1717, 1718, 1491, 1491, 364, 265,
+	1491, 1491, 1740, 1489, 1729, 23, 2, 0, 0, 0,
+	358, 0, 364, 0, 0, 0, 1750, 0, 0, 1753,
+	0, 0, 1748, 358, 0, 0, 0, 1057, 1489, 1489,
+	1752, 0, 1489, 1489, 0, 0, 523, 527, 0, 0,
+	1766, 1589, 1590, 1591, 1592, 1593, 1595, 1769, 1770, 0,
+	0, 1324, 1774, 545, 0, 1335, 1768, 0, 0, 0,
+	0, 1772, 0, 621, 1326, 0, 92, 0, 0, 963,
+	1783, 0, 1202, 1491, 0, 951, 0, 92, 0, 1792,
+	0, 1793, 0, 794, 0, 0, 0, 591, 358, 0,
+	358, 1710, 0, 90, 0, 364, 602, 952, 364, 1491,
+	358, 1804, 1803, 0, 1491, 1489, 1748, 0, 0, 0,
+	0, 959, 0, 947, 0, 614, 0, 0, 1258, 948,
+	0, 0, 0, 1805, 0, 0, 0, 0, 358, 0,
+	0, 1489, 0, 1325, 0, 0, 1489, 1324, 0, 0,
+	0, 1335, 0, 853, 855, 1800, 0, 0, 616, 0,
+	1326, 0, 1324, 0, 0, 1801, 1335, 0, 0, 869,
+	0, 0, 0, 0, 0, 1326, 1328, 1329, 1330, 1331,
+	1332, 1333, 1334, 955, 0, 950, 960, 0, 0, 0,
+	0, 0, 957, 956, 0, 0, 622, 623, 624, 625,
+	626, 627, 628, 629, 630, 631, 0, 0, 90, 349,
+	0, 0, 0, 0, 0, 90, 662, 90, 617, 0,
+	895, 0, 0, 0, 0, 0, 632, 615, 0, 1325,
+	0, 0, 52, 620, 0, 1152, 0, 1153, 1154, 1155,
+	0, 0, 0, 0, 1325, 1158, 1156, 310, 311, 0,
+	1115, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 0,
+	0, 0, 358, 0, 0, 0, 0, 1328, 1329, 1330,
+	1331, 1332, 1333, 1334, 0, 0, 1137, 647, 650, 651,
+	652, 648, 0, 649, 653, 0, 953, 1104, 1105, 0,
+	1150, 0, 954, 0, 0, 0, 1271, 1327, 0, 0,
+	0, 0, 0, 633, 0, 1153, 1154, 1155, 1417, 0,
+	803, 804, 0, 1158, 1156, 310, 311, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1273,
+	0, 0, 0, 0, 90, 90, 0, 0, 0, 0,
+	0, 90, 1203, 90, 0, 0, 90, 358, 0, 90,
+	961, 0, 962, 764, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 591, 0, 958, 859, 860,
+	0, 0, 0, 358, 90, 0, 782, 90, 0, 358,
+	0, 0, 1275, 1791, 0, 0, 1280, 0, 0, 1274,
+	0, 0, 0, 0, 1272, 90, 358, 0, 1709, 1066,
+	1278, 0, 0, 1067, 764, 0, 1160, 0, 0, 0,
+	1071, 1072, 1073, 1276, 1277, 0, 0, 1081, 0, 0,
+	0, 0, 1087, 0, 0, 1088, 1089, 1090, 1091, 0,
+	1279, 1281, 0, 781, 0, 0, 1302, 1115, 1161, 781,
+	1163, 1162, 0, 0, 0, 0, 0, 0, 278, 0,
+	914, 0, 0, 278, 278, 239, 0, 782, 782, 278,
+	0, 0, 0, 782, 0, 358, 0, 1336, 0, 0,
+	358, 1541, 0, 0, 0, 0, 0, 0, 0, 249,
+	0, 0, 0, 0, 1160, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 278, 278, 278, 278, 0, 90,
+	0, 782, 90, 90, 90, 90, 90, 0, 0, 1230,
+	0, 0, 0, 0, 894, 0, 1161, 90, 1163, 1162,
+	0, 662, 0, 0, 0, 0, 90, 90, 0, 0,
+	0, 234, 0, 0, 0, 0, 0, 236, 0, 0,
+	0, 0, 0, 0, 242, 238, 0, 0, 0, 0,
+	0, 0, 1407, 0, 0, 1412, 0, 1414, 0, 1042,
+	1043, 0, 527, 0, 0, 1416, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 1231, 0, 0, 244, 0,
+	1233, 1226, 1227, 1419, 1234, 1229, 1228, 0, 0, 1236,
+	1232, 0, 0, 0, 0, 0, 0, 358, 0, 0,
+	0, 0, 0, 0, 0, 1235, 0, 1225, 0, 0,
+	0, 90, 0, 0, 90, 0, 90, 0, 0, 90,
+	1221, 0, 1268, 0, 0, 0, 0, 1070, 0, 0,
+	0, 0, 0, 235, 0, 0, 0, 0, 0, 0,
+	1086, 0, 0, 0, 0, 0, 0, 0, 764, 1460,
+	0, 1460, 1460, 1460, 0, 1466, 0, 0, 0, 0,
+	278, 358, 24, 25, 50, 27, 28, 0, 1314, 0,
+	237, 0, 245, 246, 247, 248, 252, 0, 0, 1488,
+	44, 251, 250, 0, 29, 0, 0, 0, 0, 0,
+	1505, 1214, 1218, 1215, 0, 1222, 1220, 1219, 0, 0,
+	77, 0, 0, 38, 0, 0, 0, 52, 0, 0,
+	278, 0, 0, 0, 0, 1460, 1223, 0, 1217, 43,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 1380,
+	0, 0, 0, 0, 0, 0, 0, 1175, 1488, 1540,
+	0, 358, 358, 0, 0, 0, 0, 0, 1550, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1556, 0, 0, 90, 0, 0, 0, 0, 31, 32,
+	34, 33, 36, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 37, 45, 46, 1573, 1574, 47, 48, 35,
+	0, 0, 0, 0, 0, 0, 0, 0, 358, 0,
+	0, 0, 0, 0, 1584, 0, 0, 1426, 0, 0,
+	1169, 0, 0, 0, 1428, 0, 0, 0, 39, 40,
+	0, 41, 42, 0, 0, 1607, 1437, 1438, 1439, 0,
+	1442, 0, 0, 0, 0, 0, 0, 1790, 0, 0,
+	0, 0, 0, 1452, 1453, 1454, 0, 1457, 1291, 0,
+	0, 90, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1306, 1307, 0, 0, 1308, 0, 0,
+	1310, 0, 0, 0, 1639, 0, 0, 0, 0, 0,
+	0, 1484, 0, 0, 1460, 0, 1249, 1250, 0, 764,
+	0, 0, 0, 1502, 0, 0, 0, 90, 0, 0,
+	0, 1349, 0, 0, 0, 1660, 0, 278, 1514, 0,
+	0, 0, 1518, 0, 1364, 0, 0, 0, 1521, 278,
+	0, 0, 51, 1526, 0, 0, 0, 0, 0, 0,
+	1374, 1488, 0, 0, 0, 358, 0, 1379, 0, 0,
+	1505, 1488, 0, 782, 0, 1505, 0, 0, 0, 782,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1488, 1488, 0, 0,
+	1488, 1488, 0, 0, 0, 0, 0, 0, 90, 0,
+	0, 0, 0, 0, 0, 0, 781, 0, 0, 1707,
+	1566, 0, 0, 0, 0, 0, 0, 0, 1639, 0,
+	0, 0, 0, 0, 0, 0, 1577, 1578, 1579, 0,
+	0, 1423, 0, 0, 0, 0, 0, 0, 0, 1735,
+	0, 0, 0, 0, 0, 0, 0, 1743, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1639, 0, 1488, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1448, 0, 0, 0, 1623,
+	0, 0, 591, 0, 0, 0, 0, 0, 0, 1488,
+	0, 0, 0, 0, 1488, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1649, 1650, 1651, 1652, 0,
+	90, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 358, 0, 0, 1639, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1661, 0, 0, 0, 0, 1666, 0,
+	0, 0, 0, 1670, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 662, 0, 1681, 0, 0, 0,
+	0, 0, 1683, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1492,
+	0, 0, 0, 0, 0, 0, 0, 1701, 0, 0,
+	0, 0, 1706, 0, 717, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 278, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	693, 0, 0, 0, 0, 0, 0, 0, 0, 1741,
+	0, 0, 0, 0, 0, 0, 591, 0, 1492, 0,
+	90, 0, 0, 0, 1604, 0, 0, 0, 0, 0,
+	1609, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1622, 0, 0, 0,
+	0, 0, 702, 0, 0, 0, 0, 1628, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 718, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1809,
+	1810, 0, 0, 622, 623, 624, 625, 626, 627, 628,
+	629, 630, 631, 0, 735, 736, 0, 737, 738, 739,
+	741, 740, 719, 720, 721, 722, 726, 724, 723, 725,
+	696, 698, 0, 632, 697, 703, 699, 700, 701, 715,
+	704, 705, 706, 707, 708, 709, 710, 711, 712, 713,
+	714, 716, 727, 728, 729, 730, 731, 732, 733, 734,
+	1699, 591, 1517, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1492, 0, 1736, 0, 0, 0, 0, 0, 0,
+	0, 1492, 0, 0, 0, 0, 0, 0, 0, 0,
+	633, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1492, 1492, 0, 0,
+	1492, 1492, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 782, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1784, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1492, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1492,
+	0, 0, 0, 0, 1492, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1782, 0, 0, 0, 0,
+	0, 0, 0, 0, 456, 445, 90, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	0, 0, 0, 363, 0, 925, 926, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 1130, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 165,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 363, 0,
+	925, 926, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 0, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 0, 0, 0,
+	376, 377, 0, 107, 456, 445, 0, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	0, 0, 0, 363, 0, 925, 926, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 0, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 920,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 921, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 919, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 363, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 1261, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 0, 0, 0,
+	376, 377, 0, 107, 456, 445, 0, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	52, 0, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 0, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 165,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 811, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 0, 0, 0,
+	376, 377, 0, 107, 456, 445, 0, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 0, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 165,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 283, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 0, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 0, 0, 0,
+	376, 377, 0, 107, 456, 445, 0, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 0, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 165,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 361, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 362, 360, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 91, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 0, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 0, 0, 0,
+	376, 377, 0, 107, 456, 445, 0, 415, 458, 390,
+	405, 467, 407, 408, 437, 423, 163, 402, 95, 393,
+	368, 399, 369, 391, 417, 120, 389, 447, 426, 138,
+	464, 141, 431, 0, 185, 151, 0, 0, 419, 450,
+	421, 443, 414, 438, 381, 430, 459, 403, 434, 460,
+	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 433, 455, 401, 468, 436, 367,
+	432, 0, 372, 375, 466, 453, 396, 397, 0, 0,
+	0, 0, 0, 0, 0, 418, 422, 0, 440, 412,
+	0, 0, 0, 0, 0, 0, 0, 0, 394, 0,
+	429, 0, 0, 0, 378, 373, 0, 416, 0, 0,
+	0, 380, 0, 395, 441, 0, 365, 444, 451, 413,
+	213, 454, 411, 410, 171, 0, 111, 0, 191, 124,
+	404, 139, 439, 457, 420, 448, 392, 400, 113, 398,
+	178, 164, 204, 428, 176, 142, 195, 172, 203, 165,
+	374, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 672, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 361, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 370, 0, 186,
+	206, 224, 225, 371, 388, 452, 216, 217, 218, 219,
+	0, 0, 0, 362, 360, 127, 182, 136, 143, 174,
+	222, 435, 179, 110, 205, 184, 384, 387, 382, 383,
+	424, 425, 461, 462, 463, 442, 379, 0, 385, 386,
+	0, 446, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 427, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 406, 366, 409, 449, 465, 170, 147, 0, 0,
+	0, 0, 0, 0, 0, 376, 377, 0, 107, 456,
+	445, 0, 415, 458, 390, 405, 467, 407, 408, 437,
+	423, 163, 402, 95, 393, 368, 399, 369, 391, 417,
+	120, 389, 447, 426, 138, 464, 141, 431, 0, 185,
+	151, 0, 0, 419, 450, 421, 443, 414, 438, 381,
+	430, 459, 403, 434, 460, 0, 0, 0, 363, 0,
+	0, 0, 0, 0, 0, 0, 0, 108, 0, 433,
+	455, 401, 468, 436, 367, 432, 0, 372, 375, 466,
+	453, 396, 397, 0, 0, 0, 0, 0, 0, 0,
+	418, 422, 0, 440, 412, 0, 0, 0, 0, 0,
+	0, 0, 0, 394, 0, 429, 0, 0, 0, 378,
+	373, 0, 416, 0, 0, 0, 380, 0, 395, 441,
+	0, 365, 444, 451, 413, 213, 454, 411, 410, 171,
+	0, 111, 0, 191, 124, 404, 139, 439, 457, 420,
+	448, 392, 400, 113, 398, 178, 164, 204, 428, 176,
+	142, 195, 172, 203, 165, 374, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 352, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 361, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 370, 0, 186, 206, 224, 225, 371, 388,
+	452, 216, 217, 218, 219, 0, 0, 0, 362, 360,
+	355, 354, 136, 143, 174, 222, 435, 179, 110, 205,
+	184, 384, 387, 382, 383, 424, 425, 461, 462, 463,
+	442, 379, 0, 385, 386, 0, 446, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 427, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 406, 366, 409, 449,
+	465, 170, 147, 0, 0, 0, 0, 163, 0, 95,
+	376, 377, 285, 107, 0, 0, 120, 282, 0, 0,
+	138, 324, 141, 0, 0, 185, 151, 0, 0, 0,
+	0, 315, 316, 0, 0, 0, 0, 0, 0, 912,
+	0, 52, 0, 0, 283, 303, 302, 305, 306, 307,
+	308, 0, 0, 108, 304, 309, 310, 311, 913, 0,
+	0, 280, 296, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 293, 294, 0, 0, 0,
+	0, 336, 0, 295, 0, 0, 291, 292, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 0, 334, 171, 0, 111, 0, 191,
+	124, 0, 139, 0, 0, 0, 0, 0, 0, 113,
+	0, 178, 164, 204, 0, 176, 142, 195, 172, 203,
+	165, 0, 214, 215, 193, 212, 180, 103, 158, 93,
+	169, 177, 0, 112, 0, 226, 227, 228, 229, 230,
+	231, 232, 96, 192, 202, 109, 181, 99, 200, 188,
+	190, 149, 134, 135, 183, 97, 98, 0, 175, 119,
+	168, 123, 117, 161, 189, 152, 196, 197, 198, 114,
+	223, 116, 115, 187, 104, 210, 211, 101, 105, 209,
+	157, 162, 160, 208, 194, 201, 150, 146, 0, 100,
+	199, 148, 145, 137, 0, 121, 125, 166, 144, 167,
+	126, 154, 153, 155, 0, 159, 0, 0, 0, 0,
+	186, 206, 224, 225, 0, 0, 0, 216, 217, 218,
+	219, 0, 0, 0, 156, 106, 127, 182, 136, 143,
+	174, 222, 0, 179, 110, 205, 184, 325, 335, 331,
+	332, 329, 330, 328, 327, 326, 337, 317, 318, 319,
+	320, 322, 0, 132, 133, 0, 0, 118, 128, 131,
+	130, 129, 321, 94, 102, 140, 220, 221, 0, 173,
+	122, 207, 0, 0, 0, 0, 0, 170, 147, 0,
+	0, 163, 0, 95, 850, 0, 285, 0, 333, 107,
+	120, 282, 0, 0, 138, 324, 141, 0, 0, 185,
+	151, 0, 0, 0, 0, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 283, 303,
+	302, 305, 306, 307, 308, 0, 0, 108, 304, 309,
+	310, 311, 0, 0, 0, 280, 296, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
+	294, 276, 0, 0, 0, 336, 0, 295, 0, 0,
+	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 0, 334, 171,
+	0, 111, 0, 191, 124, 0, 139, 0, 0, 0,
+	0, 0, 0, 113, 0, 178, 164, 204, 0, 176,
+	142, 195, 172, 203, 165, 0, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 0, 0, 186, 206, 224, 225, 0, 0,
+	0, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 0, 179, 110, 205,
+	184, 325, 335, 331, 332, 329, 330, 328, 327, 326,
+	337, 317, 318, 319, 320, 322, 0, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 321, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 0, 0, 0, 0,
+	0, 170, 147, 0, 0, 163, 0, 95, 0, 0,
+	285, 0, 333, 107, 120, 282, 0, 0, 138, 324,
+	141, 0, 0, 185, 151, 0, 0, 0, 0, 315,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 52,
+	0, 0, 283, 303, 302, 305, 306, 307, 308, 0,
+	0, 108, 304, 309, 310, 311, 0, 0, 0, 280,
+	296, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 293, 294, 276, 0, 0, 0, 336,
+	0, 295, 0, 0, 291, 292, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	0, 0, 334, 171, 0, 111, 0, 191, 124, 0,
+	139, 0, 0, 0, 0, 0, 0, 113, 0, 178,
+	164, 204, 0, 176, 142, 195, 172, 203, 165, 0,
+	214, 215, 193, 212, 180, 103, 158, 93, 169, 177,
+	0, 112, 0, 226, 227, 228, 229, 230, 231, 232,
+	96, 192, 202, 109, 181, 99, 200, 188, 190, 149,
+	134, 135, 183, 97, 98, 0, 175, 119, 168, 123,
+	117, 161, 189, 152, 196, 197, 198, 114, 223, 116,
+	115, 187, 104, 210, 211, 101, 105, 209, 157, 162,
+	160, 208, 194, 201, 150, 146, 0, 100, 199, 148,
+	145, 137, 0, 121, 125, 166, 144, 167, 126, 154,
+	153, 155, 0, 159, 0, 0, 0, 0, 186, 206,
+	224, 225, 0, 0, 0, 216, 217, 218, 219, 0,
+	0, 0, 156, 106, 127, 182, 136, 143, 174, 222,
+	0, 179, 110, 205, 184, 325, 335, 331, 332, 329,
+	330, 328, 327, 326, 337, 317, 318, 319, 320, 322,
+	0, 132, 133, 0, 0, 118, 128, 131, 130, 129,
+	321, 94, 102, 140, 220, 221, 0, 173, 122, 207,
+	0, 0, 0, 0, 0, 170, 147, 0, 0, 163,
+	0, 95, 0, 0, 285, 0, 333, 107, 120, 282,
+	0, 0, 138, 324, 141, 0, 0, 185, 151, 0,
+	0, 0, 0, 315, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 52, 0, 519, 283, 303, 302, 305,
+	306, 307, 308, 0, 0, 108, 304, 309, 310, 311,
+	0, 0, 0, 280, 296, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 293, 294, 0,
+	0, 0, 0, 336, 0, 295, 0, 0, 291, 292,
+	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 0, 0, 334, 171, 0, 111,
+	0, 191, 124, 0, 139, 0, 0, 0, 0, 0,
+	0, 113, 0, 178, 164, 204, 0, 176, 142, 195,
+	172, 203, 165, 0, 214, 215, 193, 212, 180, 103,
+	158, 93, 169, 177, 0, 112, 0, 226, 227, 228,
+	229, 230, 231, 232, 96, 192, 202, 109, 181, 99,
+	200, 188, 190, 149, 134, 135, 183, 97, 98, 0,
+	175, 119, 168, 123, 117, 161, 189, 152, 196, 197,
+	198, 114, 223, 116, 115, 187, 104, 210, 211, 101,
+	105, 209, 157, 162, 160, 208, 194, 201, 150, 146,
+	0, 100, 199, 148, 145, 137, 0, 121, 125, 166,
+	144, 167, 126, 154, 153, 155, 0, 159, 0, 0,
+	0, 0, 186, 206, 224, 225, 0, 0, 0, 216,
+	217, 218, 219, 0, 0, 0, 156, 106, 127, 182,
+	136, 143, 174, 222, 0, 179, 110, 205, 184, 325,
+	335, 331, 332, 329, 330, 328, 327, 326, 337, 317,
+	318, 319, 320, 322, 0, 132, 133, 0, 0, 118,
+	128, 131, 130, 129, 321, 94, 102, 140, 220, 221,
+	0, 173, 122, 207, 0, 0, 24, 0, 0, 170,
+	147, 0, 0, 0, 0, 0, 0, 163, 0, 95,
+	333, 107, 285, 0, 0, 0, 120, 282, 0, 0,
+	138, 324, 141, 0, 0, 185, 151, 0, 0, 0,
+	0, 315, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 52, 0, 0, 283, 303, 302, 305, 306, 307,
+	308, 0, 0, 108, 304, 309, 310, 311, 0, 0,
+	0, 280, 296, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 293, 294, 0, 0, 0,
+	0, 336, 0, 295, 0, 0, 291, 292, 297, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 0, 334, 171, 0, 111, 0, 191,
+	124, 0, 139, 0, 0, 0, 0, 0, 0, 113,
+	0, 178, 164, 204, 0, 176, 142, 195, 172, 203,
+	165, 0, 214, 215, 193, 212, 180, 103, 158, 93,
+	169, 177, 0, 112, 0, 226, 227, 228, 229, 230,
+	231, 232, 96, 192, 202, 109, 181, 99, 200, 188,
+	190, 149, 134, 135, 183, 97, 98, 0, 175, 119,
+	168, 123, 117, 161, 189, 152, 196, 197, 198, 114,
+	223, 116, 115, 187, 104, 210, 211, 101, 105, 209,
+	157, 162, 160, 208, 194, 201, 150, 146, 0, 100,
+	199, 148, 145, 137, 0, 121, 125, 166, 144, 167,
+	126, 154, 153, 155, 0, 159, 0, 0, 0, 0,
+	186, 206, 224, 225, 0, 0, 0, 216, 217, 218,
+	219, 0, 0, 0, 156, 106, 127, 182, 136, 143,
+	174, 222, 0, 179, 110, 205, 184, 325, 335, 331,
+	332, 329, 330, 328, 327, 326, 337, 317, 318, 319,
+	320, 322, 0, 132, 133, 0, 0, 118, 128, 131,
+	130, 129, 321, 94, 102, 140, 220, 221, 0, 173,
+	122, 207, 0, 0, 0, 0, 0, 170, 147, 0,
+	0, 163, 0, 95, 0, 0, 285, 0, 333, 107,
+	120, 282, 0, 0, 138, 324, 141, 0, 0, 185,
+	151, 0, 0, 0, 0, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 283, 303,
+	302, 305, 306, 307, 308, 0, 0, 108, 304, 309,
+	310, 311, 0, 0, 0, 280, 296, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
+	294, 0, 0, 0, 0, 336, 0, 295, 0, 0,
+	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 0, 334, 171,
+	0, 111, 0, 191, 124, 0, 139, 0, 0, 0,
+	0, 0, 0, 113, 0, 178, 164, 204, 0, 176,
+	142, 195, 172, 203, 165, 0, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 0, 0, 186, 206, 224, 225, 0, 0,
+	0, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 0, 179, 110, 205,
+	184, 325, 335, 331, 332, 329, 330, 328, 327, 326,
+	337, 317, 318, 319, 320, 322, 0, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 321, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 163, 0, 95, 0,
+	0, 170, 147, 0, 0, 120, 0, 0, 0, 138,
+	324, 141, 333, 107, 185, 151, 0, 0, 0, 0,
+	315, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	52, 0, 0, 283, 303, 302, 305, 306, 307, 308,
+	0, 0, 108, 304, 309, 310, 311, 0, 0, 0,
+	0, 296, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 293, 294, 0, 0, 0, 0,
+	336, 0, 295, 0, 0, 291, 292, 297, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 0, 334, 171, 0, 111, 0, 191, 124,
+	0, 139, 0, 0, 0, 0, 0, 0, 113, 0,
+	178, 164, 204, 1806, 176, 142, 195, 172, 203, 165,
+	0, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 0, 0, 186,
+	206, 224, 225, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 0, 179, 110, 205, 184, 325, 335, 331, 332,
+	329, 330, 328, 327, 326, 337, 317, 318, 319, 320,
+	322, 0, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 321, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 163, 0, 95, 0, 0, 170, 147, 0, 0,
+	120, 0, 0, 0, 138, 324, 141, 333, 107, 185,
+	151, 0, 0, 0, 0, 315, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 283, 303,
+	302, 305, 306, 307, 308, 0, 0, 108, 304, 309,
+	310, 311, 0, 0, 0, 0, 296, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 293,
+	294, 0, 0, 0, 0, 336, 0, 295, 0, 0,
+	291, 292, 297, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 0, 334, 171,
+	0, 111, 0, 191, 124, 0, 139, 0, 0, 0,
+	0, 0, 0, 113, 0, 178, 164, 204, 0, 176,
+	142, 195, 172, 203, 165, 0, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 0, 0, 186, 206, 224, 225, 0, 0,
+	0, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 0, 179, 110, 205,
+	184, 325, 335, 331, 332, 329, 330, 328, 327, 326,
+	337, 317, 318, 319, 320, 322, 0, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 321, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 163, 0, 95, 0,
+	0, 170, 147, 0, 0, 120, 0, 0, 0, 138,
+	0, 141, 333, 107, 185, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 363, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	555, 554, 564, 565, 557, 558, 559, 560, 561, 562,
+	563, 556, 0, 0, 566, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 0, 0, 171, 0, 111, 0, 191, 124,
+	0, 139, 0, 0, 0, 0, 0, 0, 113, 0,
+	178, 164, 204, 0, 176, 142, 195, 172, 203, 165,
+	0, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 0, 0, 186,
+	206, 224, 225, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 0, 179, 110, 205, 184, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 0, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 163, 0, 95, 0, 541, 170, 147, 0, 0,
+	120, 0, 0, 0, 138, 0, 141, 567, 107, 185,
+	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 363, 0,
+	543, 0, 0, 0, 0, 0, 0, 108, 0, 0,
+	0, 0, 0, 538, 537, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	539, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 0, 0, 171,
+	0, 111, 0, 191, 124, 0, 139, 0, 0, 0,
+	0, 0, 0, 113, 0, 178, 164, 204, 0, 176,
+	142, 195, 172, 203, 165, 0, 214, 215, 193, 212,
+	180, 103, 158, 93, 169, 177, 0, 112, 0, 226,
+	227, 228, 229, 230, 231, 232, 96, 192, 202, 109,
+	181, 99, 200, 188, 190, 149, 134, 135, 183, 97,
+	98, 0, 175, 119, 168, 123, 117, 161, 189, 152,
+	196, 197, 198, 114, 223, 116, 115, 187, 104, 210,
+	211, 101, 105, 209, 157, 162, 160, 208, 194, 201,
+	150, 146, 0, 100, 199, 148, 145, 137, 0, 121,
+	125, 166, 144, 167, 126, 154, 153, 155, 0, 159,
+	0, 0, 0, 0, 186, 206, 224, 225, 0, 0,
+	0, 216, 217, 218, 219, 0, 0, 0, 156, 106,
+	127, 182, 136, 143, 174, 222, 0, 179, 110, 205,
+	184, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 132, 133, 0,
+	0, 118, 128, 131, 130, 129, 0, 94, 102, 140,
+	220, 221, 0, 173, 122, 207, 163, 0, 95, 0,
+	0, 170, 147, 0, 0, 120, 0, 0, 0, 138,
+	0, 141, 0, 107, 185, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	52, 0, 0, 283, 0, 0, 0, 0, 0, 0,
+	0, 0, 108, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 0, 0, 171, 0, 111, 0, 191, 124,
+	0, 139, 0, 0, 1490, 0, 0, 0, 113, 0,
+	178, 164, 204, 0, 176, 142, 195, 172, 203, 165,
+	0, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 0, 0, 186,
+	206, 224, 225, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 0, 179, 110, 205, 184, 163, 0, 95, 0,
+	661, 0, 0, 0, 0, 120, 0, 0, 0, 138,
+	0, 141, 132, 133, 185, 151, 118, 128, 131, 130,
+	129, 0, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 0, 0, 91, 0, 663, 170, 147, 0, 0,
+	0, 0, 108, 0, 0, 0, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 0, 0, 171, 0, 111, 0, 191, 124,
+	0, 139, 0, 0, 0, 0, 0, 0, 113, 0,
+	178, 164, 204, 0, 176, 142, 195, 172, 203, 165,
+	0, 214, 215, 193, 212, 180, 103, 158, 93, 169,
+	177, 0, 112, 0, 226, 227, 228, 229, 230, 231,
+	232, 96, 192, 202, 109, 181, 99, 200, 188, 190,
+	149, 134, 135, 183, 97, 98, 0, 175, 119, 168,
+	123, 117, 161, 189, 152, 196, 197, 198, 114, 223,
+	116, 115, 187, 104, 210, 211, 101, 105, 209, 157,
+	162, 160, 208, 194, 201, 150, 146, 0, 100, 199,
+	148, 145, 137, 0, 121, 125, 166, 144, 167, 126,
+	154, 153, 155, 0, 159, 0, 0, 0, 0, 186,
+	206, 224, 225, 0, 0, 0, 216, 217, 218, 219,
+	0, 0, 0, 156, 106, 127, 182, 136, 143, 174,
+	222, 0, 179, 110, 205, 184, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 132, 133, 0, 0, 118, 128, 131, 130,
+	129, 24, 94, 102, 140, 220, 221, 0, 173, 122,
+	207, 0, 163, 0, 95, 0, 170, 147, 0, 0,
+	0, 120, 0, 0, 0, 138, 0, 141, 107, 0,
+	185, 151, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 52, 0, 0, 363,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 0, 0, 0,
+	171, 0, 111, 0, 191, 124, 0, 139, 0, 0,
+	0, 0, 0, 0, 113, 0, 178, 164, 204, 0,
+	176, 142, 195, 172, 203, 165, 0, 214, 215, 193,
+	212, 180, 103, 158, 93, 169, 177, 0, 112, 0,
+	226, 227, 228, 229, 230, 231, 232, 96, 192, 202,
+	109, 181, 99, 200, 188, 190, 149, 134, 135, 183,
+	97, 98, 0, 175, 119, 168, 123, 117, 161, 189,
+	152, 196, 197, 198, 114, 223, 116, 115, 187, 104,
+	210, 211, 101, 105, 209, 157, 162, 160, 208, 194,
+	201, 150, 146, 0, 100, 199, 148, 145, 137, 0,
+	121, 125, 166, 144, 167, 126, 154, 153, 155, 0,
+	159, 0, 0, 0, 0, 186, 206, 224, 225, 0,
+	0, 0, 216, 217, 218, 219, 0, 0, 0, 156,
+	106, 127, 182, 136, 143, 174, 222, 0, 179, 110,
+	205, 184, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 132, 133,
+	0, 0, 118, 128, 131, 130, 129, 24, 94, 102,
+	140, 220, 221, 0, 173, 122, 207, 0, 163, 0,
+	95, 0, 170, 147, 0, 0, 0, 120, 0, 0,
+	0, 138, 0, 141, 107, 0, 185, 151, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 52, 0, 0, 91, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 0, 179, 110, 205, 184, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 133, 0, 0, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 163, 0, 95, 0, 0, 170, 147,
+	0, 0, 120, 0, 0, 0, 138, 0, 141, 0,
+	107, 185, 151, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	363, 0, 0, 798, 0, 0, 799, 0, 0, 108,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
+	0, 171, 0, 111, 0, 191, 124, 0, 139, 0,
+	0, 0, 0, 0, 0, 113, 0, 178, 164, 204,
+	0, 176, 142, 195, 172, 203, 165, 0, 214, 215,
+	193, 212, 180, 103, 158, 93, 169, 177, 0, 112,
+	0, 226, 227, 228, 229, 230, 231, 232, 96, 192,
+	202, 109, 181, 99, 200, 188, 190, 149, 134, 135,
+	183, 97, 98, 0, 175, 119, 168, 123, 117, 161,
+	189, 152, 196, 197, 198, 114, 223, 116, 115, 187,
+	104, 210, 211, 101, 105, 209, 157, 162, 160, 208,
+	194, 201, 150, 146, 0, 100, 199, 148, 145, 137,
+	0, 121, 125, 166, 144, 167, 126, 154, 153, 155,
+	0, 159, 0, 0, 0, 0, 186, 206, 224, 225,
+	0, 0, 0, 216, 217, 218, 219, 0, 0, 0,
+	156, 106, 127, 182, 136, 143, 174, 222, 0, 179,
+	110, 205, 184, 163, 0, 95, 0, 0, 0, 0,
+	0, 0, 120, 681, 0, 0, 138, 0, 141, 132,
+	133, 185, 151, 118, 128, 131, 130, 129, 0, 94,
+	102, 140, 220, 221, 0, 173, 122, 207, 0, 0,
+	363, 0, 680, 170, 147, 0, 0, 0, 0, 108,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
+	0, 171, 0, 111, 0, 191, 124, 0, 139, 0,
+	0, 0, 0, 0, 0, 113, 0, 178, 164, 204,
+	0, 176, 142, 195, 172, 203, 165, 0, 214, 215,
+	193, 212, 180, 103, 158, 93, 169, 177, 0, 112,
+	0, 226, 227, 228, 229, 230, 231, 232, 96, 192,
+	202, 109, 181, 99, 200, 188, 190, 149, 134, 135,
+	183, 97, 98, 0, 175, 119, 168, 123, 117, 161,
+	189, 152, 196, 197, 198, 114, 223, 116, 115, 187,
+	104, 210, 211, 101, 105, 209, 157, 162, 160, 208,
+	194, 201, 150, 146, 0, 100, 199, 148, 145, 137,
+	0, 121, 125, 166, 144, 167, 126, 154, 153, 155,
+	0, 159, 0, 0, 0, 0, 186, 206, 224, 225,
+	0, 0, 0, 216, 217, 218, 219, 0, 0, 0,
+	156, 106, 127, 182, 136, 143, 174, 222, 0, 179,
+	110, 205, 184, 163, 0, 95, 0, 661, 0, 0,
+	0, 0, 120, 0, 0, 0, 138, 0, 141, 132,
+	133, 185, 151, 118, 128, 131, 130, 129, 0, 94,
+	102, 140, 220, 221, 0, 173, 122, 207, 0, 0,
+	91, 0, 663, 170, 147, 0, 0, 0, 0, 108,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
+	0, 171, 0, 111, 0, 191, 124, 0, 139, 0,
+	0, 0, 0, 0, 0, 113, 0, 178, 164, 204,
+	0, 176, 142, 195, 172, 203, 659, 0, 214, 215,
+	193, 212, 180, 103, 158, 93, 169, 177, 0, 112,
+	0, 226, 227, 228, 229, 230, 231, 232, 96, 192,
+	202, 109, 181, 99, 200, 188, 190, 149, 134, 135,
+	183, 97, 98, 0, 175, 119, 168, 123, 117, 161,
+	189, 152, 196, 197, 198, 114, 223, 116, 115, 187,
+	104, 210, 211, 101, 105, 209, 157, 162, 160, 208,
+	194, 201, 150, 146, 0, 100, 199, 148, 145, 137,
+	0, 121, 125, 166, 144, 167, 126, 154, 153, 155,
+	0, 159, 0, 0, 0, 0, 186, 206, 224, 225,
+	0, 0, 0, 216, 217, 218, 219, 0, 0, 0,
+	156, 106, 127, 182, 136, 143, 174, 222, 0, 179,
+	110, 205, 184, 163, 0, 95, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 0, 138, 0, 141, 132,
+	133, 185, 151, 118, 128, 131, 130, 129, 0, 94,
+	102, 140, 220, 221, 0, 173, 122, 207, 0, 0,
+	91, 0, 0, 170, 147, 0, 0, 0, 0, 108,
+	0, 0, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
+	0, 171, 0, 111, 0, 191, 124, 0, 139, 0,
+	0, 0, 0, 0, 0, 113, 0, 178, 164, 204,
+	0, 176, 142, 195, 172, 203, 165, 0, 214, 215,
+	193, 212, 180, 103, 158, 93, 169, 177, 0, 112,
+	0, 226, 227, 228, 229, 230, 231, 232, 96, 192,
+	202, 109, 181, 99, 200, 188, 190, 149, 134, 135,
+	183, 97, 98, 0, 175, 119, 168, 123, 117, 161,
+	189, 152, 196, 197, 198, 114, 223, 116, 115, 187,
+	104, 210, 211, 101, 105, 209, 157, 162, 160, 208,
+	194, 201, 150, 146, 0, 100, 199, 148, 145, 137,
+	0, 121, 125, 166, 144, 167, 126, 154, 153, 155,
+	0, 159, 0, 0, 0, 0, 186, 206, 224, 225,
+	0, 0, 0, 216, 217, 218, 219, 0, 0, 0,
+	156, 106, 127, 182, 136, 143, 174, 222, 0, 179,
+	110, 205, 184, 163, 0, 95, 0, 0, 0, 0,
+	0, 0, 120, 0, 0, 0, 138, 0, 141, 132,
+	133, 185, 151, 118, 128, 131, 130, 129, 0, 94,
+	102, 140, 220, 221, 0, 173, 122, 207, 0, 0,
+	363, 0, 0, 170, 147, 0, 0, 0, 0, 108,
+	0, 1781, 0, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 0,
+	0, 171, 0, 111, 0, 191, 124, 0, 139, 0,
+	0, 1461, 0, 0, 0, 113, 0, 178, 164, 204,
+	0, 176, 142, 195, 172, 203, 165, 0, 214, 215,
+	193, 212, 180, 103, 158, 93, 169, 177, 0, 112,
+	0, 226, 227, 228, 229, 230, 231, 232, 96, 192,
+	202, 109, 181, 99, 200, 188, 190, 149, 134, 135,
+	183, 97, 98, 0, 175, 119, 168, 123, 117, 161,
+	189, 152, 196, 197, 198, 114, 223, 116, 115, 187,
+	104, 210, 211, 101, 105, 209, 157, 162, 160, 208,
+	194, 201, 150, 146, 0, 100, 199, 148, 145, 137,
+	0, 121, 125, 166, 144, 167, 126, 154, 153, 155,
+	0, 159, 0, 0, 0, 0, 186, 206, 224, 225,
+	0, 0, 0, 216, 217, 218, 219, 0, 0, 0,
+	156, 106, 127, 182, 136, 143, 174, 222, 0, 179,
+	110, 205, 184, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 132,
+	133, 0, 0, 118, 128, 131, 130, 129, 0, 94,
+	102, 140, 220, 221, 0, 173, 122, 207, 163, 0,
+	95, 0, 0, 170, 147, 0, 0, 120, 0, 0,
+	0, 138, 0, 141, 0, 107, 185, 151, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 52, 0, 0, 91, 0, 0, 0, 0,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 0, 179, 110, 205, 184, 163, 0,
+	95, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 138, 0, 141, 132, 133, 185, 151, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 0, 0, 91, 0, 663, 170, 147,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 0, 179, 110, 205, 184, 163, 0,
+	95, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 138, 0, 141, 132, 133, 185, 151, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 0, 0, 363, 0, 543, 170, 147,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 0, 179, 110, 205, 184, 163, 0,
+	95, 0, 0, 0, 0, 0, 0, 120, 0, 0,
+	0, 138, 0, 141, 132, 133, 185, 151, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 0, 0, 91, 0, 0, 170, 147,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 754, 179, 110, 205, 184, 163, 0,
+	95, 0, 0, 0, 0, 0, 639, 120, 0, 0,
+	0, 138, 0, 141, 132, 133, 185, 151, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 0, 0, 91, 0, 0, 170, 147,
+	0, 0, 0, 0, 108, 0, 0, 0, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 0, 0, 171, 0, 111, 0,
+	191, 124, 0, 139, 0, 0, 0, 0, 0, 0,
+	113, 0, 178, 164, 204, 0, 176, 142, 195, 172,
+	203, 165, 0, 214, 215, 193, 212, 180, 103, 158,
+	93, 169, 177, 0, 112, 0, 226, 227, 228, 229,
+	230, 231, 232, 96, 192, 202, 109, 181, 99, 200,
+	188, 190, 149, 134, 135, 183, 97, 98, 0, 175,
+	119, 168, 123, 117, 161, 189, 152, 196, 197, 198,
+	114, 223, 116, 115, 187, 104, 210, 211, 101, 105,
+	209, 157, 162, 160, 208, 194, 201, 150, 146, 0,
+	100, 199, 148, 145, 137, 0, 121, 125, 166, 144,
+	167, 126, 154, 153, 155, 0, 159, 0, 0, 0,
+	0, 186, 206, 224, 225, 0, 0, 0, 216, 217,
+	218, 219, 0, 0, 0, 156, 106, 127, 182, 136,
+	143, 174, 222, 0, 179, 110, 205, 184, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 132, 133, 0, 0, 118, 128,
+	131, 130, 129, 0, 94, 102, 140, 220, 221, 0,
+	173, 122, 207, 0, 347, 0, 0, 0, 170, 147,
+	163, 0, 95, 0, 0, 0, 0, 0, 0, 120,
+	107, 0, 0, 138, 0, 141, 0, 0, 185, 151,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 91, 0, 0,
+	0, 0, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 0, 0, 171, 0,
+	111, 0, 191, 124, 0, 139, 0, 0, 0, 0,
+	0, 0, 113, 0, 178, 164, 204, 0, 176, 142,
+	195, 172, 203, 165, 0, 214, 215, 193, 212, 180,
+	103, 158, 93, 169, 177, 0, 112, 0, 226, 227,
+	228, 229, 230, 231, 232, 96, 192, 202, 109, 181,
+	99, 200, 188, 190, 149, 134, 135, 183, 97, 98,
+	0, 175, 119, 168, 123, 117, 161, 189, 152, 196,
+	197, 198, 114, 223, 116, 115, 187, 104, 210, 211,
+	101, 105, 209, 157, 162, 160, 208, 194, 201, 150,
+	146, 0, 100, 199, 148, 145, 137, 0, 121, 125,
+	166, 144, 167, 126, 154, 153, 155, 0, 159, 0,
+	0, 0, 0, 186, 206, 224, 225, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 0, 156, 106, 127,
+	182, 136, 143, 174, 222, 0, 179, 110, 205, 184,
+	163, 0, 95, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 138, 0, 141, 132, 133, 185, 151,
+	118, 128, 131, 130, 129, 0, 94, 102, 140, 220,
+	221, 0, 173, 122, 207, 0, 0, 91, 0, 0,
+	170, 147, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 88, 0, 213, 0, 0, 0, 171, 0,
+	111, 0, 191, 124, 0, 139, 0, 0, 0, 0,
+	0, 0, 113, 0, 178, 164, 204, 0, 176, 142,
+	195, 172, 203, 165, 0, 214, 215, 193, 212, 180,
+	103, 158, 93, 169, 177, 0, 112, 0, 226, 227,
+	228, 229, 230, 231, 232, 96, 192, 202, 109, 181,
+	99, 200, 188, 190, 149, 134, 135, 183, 97, 98,
+	0, 175, 119, 168, 123, 117, 161, 189, 152, 196,
+	197, 198, 114, 223, 116, 115, 187, 104, 210, 211,
+	101, 105, 209, 157, 162, 160, 208, 194, 201, 150,
+	146, 0, 100, 199, 148, 145, 137, 0, 121, 125,
+	166, 144, 167, 126, 154, 153, 155, 0, 159, 0,
+	0, 0, 0, 186, 206, 224, 225, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 0, 156, 106, 127,
+	182, 136, 143, 174, 222, 0, 179, 110, 205, 184,
+	163, 0, 95, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 138, 0, 141, 132, 133, 185, 151,
+	118, 128, 131, 130, 129, 0, 94, 102, 140, 220,
+	221, 0, 173, 122, 207, 0, 0, 363, 0, 0,
+	170, 147, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 0, 0, 171, 0,
+	111, 0, 191, 124, 0, 139, 0, 0, 0, 0,
+	0, 0, 113, 0, 178, 164, 204, 0, 176, 142,
+	195, 172, 203, 165, 0, 214, 215, 193, 212, 180,
+	103, 158, 93, 169, 177, 0, 112, 0, 226, 227,
+	228, 229, 230, 231, 232, 96, 192, 202, 109, 181,
+	99, 200, 188, 190, 149, 134, 135, 183, 97, 98,
+	0, 175, 119, 168, 123, 117, 161, 189, 152, 196,
+	197, 198, 114, 223, 116, 115, 187, 104, 210, 211,
+	101, 105, 209, 157, 162, 160, 208, 194, 201, 150,
+	146, 0, 100, 199, 148, 145, 137, 0, 121, 125,
+	166, 144, 167, 126, 154, 153, 155, 0, 159, 0,
+	0, 0, 0, 186, 206, 224, 225, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 0, 156, 106, 127,
+	182, 136, 143, 174, 222, 0, 179, 110, 205, 184,
+	163, 0, 95, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 138, 0, 141, 132, 133, 185, 151,
+	118, 128, 131, 130, 129, 0, 94, 102, 140, 220,
+	221, 0, 173, 122, 207, 0, 0, 91, 0, 0,
+	170, 147, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 0, 0, 171, 0,
+	111, 0, 191, 124, 0, 139, 0, 0, 0, 0,
+	0, 0, 113, 0, 178, 164, 204, 0, 176, 142,
+	195, 172, 203, 165, 0, 214, 215, 193, 212, 180,
+	103, 158, 93, 169, 177, 0, 112, 0, 226, 227,
+	228, 229, 230, 231, 232, 96, 192, 202, 109, 181,
+	99, 200, 188, 190, 149, 134, 135, 183, 97, 98,
+	0, 175, 119, 168, 123, 117, 161, 189, 152, 196,
+	197, 198, 114, 223, 116, 115, 187, 104, 210, 211,
+	101, 105, 209, 157, 162, 160, 208, 194, 201, 150,
+	146, 0, 100, 199, 148, 145, 137, 0, 121, 125,
+	166, 144, 167, 126, 154, 153, 155, 0, 159, 0,
+	0, 0, 0, 186, 206, 224, 225, 0, 0, 0,
+	216, 217, 218, 219, 0, 0, 0, 156, 106, 127,
+	182, 136, 143, 174, 222, 0, 179, 110, 205, 184,
+	163, 0, 95, 0, 0, 0, 0, 0, 0, 120,
+	0, 0, 0, 138, 0, 141, 132, 133, 185, 151,
+	118, 128, 131, 130, 129, 0, 94, 102, 140, 220,
+	221, 0, 173, 122, 207, 0, 0, 283, 0, 0,
+	170, 147, 0, 0, 0, 0, 108, 0, 0, 0,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 0, 0, 171, 0,
+	111, 0, 191, 124, 0, 139, 0, 0, 0, 0,
+	0, 0, 113, 0, 178, 164, 204, 0, 176, 142,
+	195, 172, 203, 165, 0, 214, 215, 193, 212, 180,
+	103, 158, 93, 169, 177, 0, 112, 0, 226, 227,
+	228, 229, 230, 231, 232, 96, 192, 202, 109, 181,
+	99, 200, 188, 190, 149, 134, 135, 183, 97, 98,
+	0, 175, 119, 168, 123, 117, 161, 189, 152, 196,
+	197, 198, 114, 223, 116, 115, 187, 104, 210, 211,
+	101, 105, 209, 157, 162, 160, 208, 194, 201, 150,
+	146, 0, 100, 199, 148, 145, 137, 0, 121, 125,
+	166, 144, 167, 126, 154, 153, 155, 0, 159, 0,
+	686, 0, 0, 186, 206, 224, 225, 717, 0, 0,
+	216, 217, 218, 219, 0, 0, 0, 156, 106, 127,
+	182, 136, 143, 174, 222, 0, 179, 110, 205, 184,
+	0, 0, 0, 693, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 132, 133, 0, 0,
+	118, 128, 131, 130, 129, 0, 94, 102, 140, 220,
+	221, 0, 173, 122, 207, 0, 0, 0, 0, 0,
+	170, 147, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 0, 702, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 718, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	717, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 622, 623, 624, 625,
+	626, 627, 628, 629, 630, 631, 693, 735, 736, 0,
+	737, 738, 739, 741, 740, 719, 720, 721, 722, 726,
+	724, 723, 725, 696, 698, 0, 632, 697, 703, 699,
+	700, 701, 715, 704, 705, 706, 707, 708, 709, 710,
+	711, 712, 713, 714, 716, 727, 728, 729, 730, 731,
+	732, 733, 734, 0, 0, 0, 0, 0, 702, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 718, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 633, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 622,
+	623, 624, 625, 626, 627, 628, 629, 630, 631, 0,
+	735, 736, 0, 737, 738, 739, 741, 740, 719, 720,
+	721, 722, 726, 724, 723, 725, 696, 698, 0, 632,
+	697, 703, 699, 700, 701, 715, 704, 705, 706, 707,
+	708, 709, 710, 711, 712, 713, 714, 716, 727, 728,
+	729, 730, 731, 732, 733, 734, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 633,
 }
 
 var yyPact = [...]int{
-	2172, -1000, -206, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2356, -1000, -207, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1291, 1336, -1000, -1000, -1000, -1000, -1000, -1000, 1120,
-	640, 240, 341, 96, 13343, 337, 2110, 13893, -1000, 121,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1036, -1000, -1000,
-	-1000, -1000, -1000, 1278, 1289, 1089, 1250, 1176, -1000, 7276,
-	241, 11691, 13068, 6170, -1000, 840, 325, 307, 13618, 224,
-	224, 13618, 224, -1000, -71, 328, 13893, -1000, 13893, 221,
-	838, 221, 221, 221, 13893, -1000, 389, -1000, -1000, -1000,
+	-1000, -1000, 1439, 1473, -1000, -1000, -1000, -1000, -1000, -1000,
+	1277, 684, 391, 424, 241, 13923, 422, 2125, 14423, -1000,
+	189, -1000, -1000, 1315, -1000, -1000, -1000, -1000, -1000, 1205,
+	-1000, -1000, -1000, -1000, -1000, 1435, 274, 1244, 1426, 1348,
+	-1000, 7678, 390, 12381, 13673, 6794, -1000, 1056, 415, 14423,
+	409, 405, 14173, 384, 384, 14173, 384, -1000, 18, 420,
+	14423, -1000, 14423, 383, 1054, 383, 383, 383, 14423, -1000,
+	458, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2246,20 +2376,20 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	13893, 836, 1211, 528, 4063, 4063, 4063, 4063, 158, 4063,
-	-27, 1145, -1000, -1000, -1000, -1000, 4063, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 832, 1217, 7839,
-	7839, 1291, -1000, 1036, -1000, -1000, -1000, 1198, -1000, -1000,
-	579, 1316, -1000, 8939, 387, -1000, 7839, 32, 1009, -1000,
-	-1000, 1009, -1000, -1000, 363, -1000, -1000, 8389, 8389, 8389,
-	8389, 8389, 8389, 8389, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1009, -1000,
-	7564, 1009, 1009, 1009, 1009, 1009, 1009, 1009, 1009, 7839,
-	1009, 1009, 1009, 1009, 1009, 1009, 1009, 1009, 1009, 1947,
-	1009, 1009, 1009, 1009, 12791, 1005, 1183, -1000, -1000, -1000,
-	1247, 9766, 10591, 13893, 999, -1000, 1015, 5869, -34, -1000,
-	-1000, -1000, 520, 10316, -1000, -1000, -1000, 1209, -1000, -1000,
+	-1000, -1000, -1000, 14423, 1049, 1389, 279, 4589, 4589, 4589,
+	4589, 267, 4589, 58, 1314, -1000, -1000, -1000, -1000, 4589,
+	-1000, -1000, -1000, -1000, -1000, 369, -1000, -1000, -1000, -1000,
+	-1000, 927, 1394, 8564, 8564, 1439, -1000, 1205, -1000, -1000,
+	-1000, 1385, -1000, -1000, 681, 1453, -1000, 9704, 457, -1000,
+	8564, 111, 1174, -1000, -1000, 1174, -1000, -1000, 438, -1000,
+	-1000, 9134, 9134, 9134, 9134, 9134, 9134, 9134, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1174, -1000, 8270, 1174, 1174, 1174, 1174, 1174,
+	1174, 1174, 1174, 8564, 1174, 1174, 1174, 1174, 1174, 1174,
+	1174, 1174, 1174, 1709, 1174, 1174, 1174, 1174, 13381, 1181,
+	1228, -1000, -1000, -1000, 1423, 10811, 11596, 14423, 1158, -1000,
+	1183, 6479, 74, -1000, -1000, -1000, 604, 11346, -1000, -1000,
+	-1000, 1388, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2269,236 +2399,251 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 977, -1000, 191, 13618, 13893, 1016, 823,
-	547, 819, 1144, 13893, -1000, 12516, 4063, 244, 13893, 1237,
-	1142, 13893, 813, 802, -1000, 5568, -1000, 4063, 4063, 4063,
-	4063, 4063, 4063, 4063, 4063, -1000, -1000, -1000, -1000, -1000,
-	-1000, 4063, 4063, -1000, 45, -1000, 13893, -1000, -1000, -1000,
-	-1000, 1331, 452, 725, 385, 1039, -1000, 563, 1278, 832,
-	1176, 10041, 1079, -1000, -1000, 13893, -1000, 7839, 7839, 809,
-	-1000, 12241, -1000, -1000, 4364, 441, 8389, 594, 437, 8389,
-	8389, 8389, 8389, 8389, 8389, 8389, 8389, 8389, 8389, 8389,
-	8389, 8389, 8389, 8389, 735, 1947, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 800, -1000, 1036, 1122, 1122, 14,
-	14, 14, 14, 14, 14, 8664, 6726, 832, 972, 482,
-	7564, 7276, 7276, 7839, 7839, 14168, 14168, 7276, 1253, 542,
-	482, 14168, -1000, 832, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 58, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1090,
+	-1000, 14889, 14173, 1422, 14423, 14423, 1282, 1032, 630, 1025,
+	1312, 14423, -1000, 13131, 4589, 397, 14423, 1410, 1311, 14423,
+	1012, 970, -1000, 6164, -1000, 4589, 4589, 4589, 4589, 4589,
+	4589, 4589, 4589, -1000, -1000, -1000, -1000, -1000, -1000, 4589,
+	4589, -1000, 90, -1000, 14423, -1000, 14673, 14423, -1000, -1000,
+	-1000, 1468, 520, 763, 456, 1189, -1000, 654, 1435, 927,
+	1348, 11096, 1327, -1000, -1000, 14423, -1000, 8564, 8564, 649,
+	-1000, 12881, -1000, -1000, 4904, 528, 9134, 824, 599, 9134,
+	9134, 9134, 9134, 9134, 9134, 9134, 9134, 9134, 9134, 9134,
+	9134, 9134, 9134, 9134, 9134, 9134, 801, 1709, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 937, -1000, 1205, 874,
+	874, 33, 33, 33, 33, 33, 33, 9419, 7384, 927,
+	975, 712, 8270, 7678, 7678, 8564, 8564, 14673, 14673, 7678,
+	1427, 615, 712, 14673, -1000, 927, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 135, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 7276, 7276, 7276, 7276, 171, 13893, -1000, 14168, 11691,
-	11691, 11691, 11691, 11691, -1000, 1166, 1165, -1000, 1158, 1157,
-	1167, 13893, -1000, 962, 9766, 469, 1009, -1000, 11966, -1000,
-	-1000, 171, 877, 11691, 13893, -1000, -1000, 5267, 1015, -34,
-	1004, -1000, -28, -41, 6451, 415, -1000, -1000, -1000, -1000,
-	3461, 1028, 851, -131, 18, -1000, -1000, -1000, -1000, 1087,
-	-1000, 1087, 225, 1087, 1087, 1087, -1000, 1087, 1087, 54,
-	54, 54, 54, 54, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1112, 1109, -1000, 1087, 1087, 1087, -1000, 1087, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1100,
-	238, 1100, 1088, 1088, -1000, -1000, 1119, 1246, -105, 796,
-	4063, 1234, 4063, 13893, -1000, 1915, 13893, -1000, 13893, -1000,
-	-1000, 13893, 4063, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 536, -1000,
-	-1000, -1000, -1000, 1182, 7839, 7839, 4966, 7839, -1000, -1000,
-	-1000, 1217, -1000, 1253, 1268, -1000, 1199, 1196, 7276, -1000,
-	-1000, 441, 478, -1000, -1000, 760, -1000, -1000, -1000, -1000,
-	382, 1009, -1000, 1769, -1000, -1000, -1000, -1000, 594, 8389,
-	8389, 8389, 73, 1769, 1708, 186, 438, 14, 134, 134,
-	6, 6, 6, 6, 6, 708, 708, -1000, -1000, -1000,
-	-1000, 832, -1000, -1000, -1000, 832, 7276, 1010, -1000, -1000,
-	7839, -1000, 832, 955, 955, 769, 502, 1070, -1000, 380,
-	1051, 955, 7276, 553, -1000, 7839, 832, -1000, -1000, 955,
-	832, 955, 955, 1008, 1009, -1000, 965, -1000, 519, 1183,
-	1116, 1141, 1068, -1000, -1000, -1000, -1000, 1156, -1000, 1082,
-	-1000, -1000, -1000, -1000, -1000, 322, 321, 315, 13618, -1000,
-	1303, 11691, 904, -1000, -1000, 1004, -34, -3, -1000, -1000,
-	-1000, -1000, 482, -1000, -1000, 794, 998, 3160, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1108, 1134, 13618, 205,
-	223, 460, 459, 788, -1000, -1000, -1000, 571, -1000, 13618,
-	1325, -1000, -1000, 200, -1000, 196, 1009, 736, 13893, 147,
-	1102, 867, -1000, -210, -1000, -2, -1000, -1000, 709, 54,
-	54, 1087, 54, 54, 54, -1000, -1000, 415, 1204, 415,
-	415, 415, 415, 727, 727, -107, -107, -1000, -1000, -1000,
-	702, 1100, -1000, -1000, -1000, 688, -1000, 13893, 13618, 1036,
-	-1000, 4665, -1000, -1000, -1000, -1000, -1000, 1245, -1000, 898,
-	1191, 324, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 169, 346, -1000, 4063, -1000, 539, 13893,
-	13893, 1180, 482, 482, 368, -1000, -1000, 13893, -1000, -1000,
-	-1000, -1000, 1024, -1000, -1000, -1000, 3762, 7276, -1000, 73,
-	1769, 91, -1000, 8389, 8389, -1000, -1000, 955, 7276, 482,
-	-1000, -1000, -1000, 1743, 735, 1743, 8389, 8389, 4966, 8389,
-	8389, -84, 960, 503, -1000, 7839, 771, -1000, -1000, -1000,
-	-1000, -1000, 1126, 14168, 1009, -1000, 9490, 13618, 1291, 14168,
-	7839, 7839, -1000, -1000, 7839, 1097, -1000, 7839, -1000, -1000,
-	-1000, 1009, 1009, 1009, 935, -1000, 1291, 904, -1000, -1000,
-	-1000, -50, -51, -1000, -1000, 3461, -1000, 3461, 11141, 1314,
-	190, 234, -1000, 783, 764, -1000, 759, -1000, 25, -1000,
-	132, -58, -1000, -1000, 7839, -1000, 1095, 1242, -1000, 1220,
-	678, -197, -1000, -1000, -1000, -1000, -1000, -1000, 1009, 1094,
-	1092, -1000, -1000, -1000, -1000, 855, 415, 415, 54, 415,
-	415, 415, -1000, 468, -1000, -1000, -1000, -1000, 952, -1000,
-	950, -1000, 76, 68, -1000, 988, -1000, 941, 993, 1125,
-	-1000, 986, -1000, 516, 1269, 140, -1000, 202, -1000, 13618,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 13618, -1000,
+	-1000, -1000, -1000, -1000, 7678, 7678, 7678, 7678, 281, 14423,
+	-1000, 14673, 12381, 12381, 12381, 12381, 12381, -1000, 1345, 1342,
+	-1000, 1329, 1326, 1338, 14423, -1000, 1070, 10811, 473, 1174,
+	-1000, 12631, -1000, -1000, 281, 1153, 12381, 14423, -1000, -1000,
+	5849, 1183, 74, 1180, -1000, 49, 68, 7090, 487, -1000,
+	-1000, -1000, -1000, 3959, 736, 1748, 1174, -84, 96, -1000,
+	-1000, -1000, -1000, -1000, 1240, -1000, 1240, 298, 1240, 1240,
+	1240, -1000, 1240, 1240, 132, 132, 132, 132, 132, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1260, 1258, -1000, 1240,
+	1240, 1240, 1240, -1000, 1240, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1252, 315, 1252, 1245, 1245,
+	-1000, -1000, 1274, 15022, 1421, 1416, -47, 931, 4589, 1405,
+	4589, 14423, -1000, 1212, 14423, -1000, 14423, -1000, -1000, 14423,
+	4589, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 602, -1000, -1000, -1000,
+	533, -1000, 455, 531, -1000, 1357, 8564, 8564, 5534, 8564,
+	-1000, -1000, -1000, 1394, -1000, 1427, 1430, -1000, 1364, 1363,
+	7678, -1000, -1000, 528, 579, -1000, -1000, 826, -1000, -1000,
+	-1000, -1000, 454, 1174, -1000, 976, -1000, -1000, -1000, -1000,
+	824, 9134, 9134, 9134, 188, 188, 976, 976, 820, 1065,
+	1093, 33, 75, 75, 50, 50, 50, 50, 50, 46,
+	46, -1000, -1000, -1000, -1000, 927, -1000, -1000, -1000, 927,
+	7678, 1182, -1000, -1000, 8564, -1000, 927, 1067, 1067, 761,
+	798, 1195, 1190, 1067, 7678, 635, -1000, 8564, 927, -1000,
+	-1000, 1067, 927, 1067, 1067, 1168, 1174, -1000, 1188, -1000,
+	597, 1228, 1269, 1307, 1938, -1000, -1000, -1000, -1000, 1335,
+	-1000, 1332, -1000, -1000, -1000, -1000, -1000, 413, 411, 404,
+	14173, -1000, 1449, 12381, 1171, -1000, -1000, 1180, 74, 60,
+	-1000, -1000, -1000, -1000, 712, -1000, -1000, 908, 1145, 1254,
+	273, 1174, 3329, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1286, 204, 14173, 1174, 1287, 342,
+	326, 403, 402, 905, 1303, -1000, -1000, -1000, 657, -1000,
+	14173, 1871, 1467, -1000, -1000, 339, -1000, 331, 1174, 854,
+	14423, 32, 1253, 1174, 8564, -1000, -210, -1000, 83, -1000,
+	-1000, 845, 132, 132, 1240, 132, 132, 132, -1000, -1000,
+	487, 1386, 487, 487, 487, 487, 853, 853, -49, -49,
+	-1000, -1000, -1000, -1000, 842, 1252, -1000, -1000, -1000, 840,
+	-1000, 14423, 14173, 1748, 1205, 1205, -1000, 5219, -1000, -1000,
+	-1000, -1000, -1000, 1413, -1000, 1301, 2266, 2155, 519, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	13893, -1000, -1000, -1000, -1000, -1000, 13618, 220, -1000, -1000,
-	723, 7839, -1000, -1000, -1000, 4665, -1000, 1303, 11691, -1000,
-	-1000, 832, -1000, 8389, 1769, 1769, -1000, -1000, 832, 1087,
-	1087, -1000, 1087, 1088, -1000, -1000, 1087, 92, 1087, 90,
-	832, 832, 408, 1693, -1000, 151, 1550, 1009, -79, -1000,
-	482, 7839, -1000, 1222, 914, 894, -1000, -1000, 7001, 832,
-	939, 362, 935, 1278, -1000, 482, 482, 482, 11416, 482,
-	11416, 11416, 11416, 9214, 13618, 1278, -1000, -1000, -1000, -1000,
-	3160, -1000, 930, -1000, 1087, 1087, 331, 331, 194, 189,
-	-1000, -1000, -1000, -1000, -164, -1000, -1000, -1000, 1009, -1000,
-	610, 11416, -185, -1000, 983, -1000, 107, 832, -1000, 687,
-	-1000, 651, -1000, -1000, -1000, 415, -1000, -1000, -1000, -1000,
-	-1000, 54, 718, 54, 13, 0, 676, -1000, 667, 11141,
-	13618, 13893, 4665, 3461, 243, 1329, -1000, -1000, 13618, -1000,
-	-1000, -1000, 1086, -1000, -1000, -1000, -1000, 1226, 13618, -1000,
-	-1000, 482, 1299, 920, -1000, 1769, -1000, -1000, 218, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 8389, 8389,
-	-1000, 8389, 8389, 8389, 832, 714, 482, 188, -1000, 1009,
-	-1000, -1000, 1014, 13618, 13618, -1000, -1000, 927, -1000, -1000,
-	907, 907, 907, 469, -1000, -1000, 666, 11141, -1000, -1000,
-	1124, -1000, -1000, 570, 143, 1123, 13618, -164, -1000, 7839,
-	141, 891, 1085, 664, 55, -107, -1000, -1000, -1000, -1000,
-	-1000, -1000, 415, -1000, 415, -1000, -1000, 850, 846, 889,
-	1084, 1081, -1000, -1000, 13618, -1000, -1000, -1000, -1000, -1000,
-	1072, 11416, 1009, 231, 1296, 1282, -1000, -1000, 1532, 1532,
-	1532, 1532, 53, -1000, -1000, 1323, -1000, 1009, -1000, 1036,
-	359, -1000, 13618, -1000, -1000, -1000, -1000, -1000, 587, 142,
-	-1000, 747, 510, 713, 504, 501, 498, 497, 495, 484,
-	466, -1000, 1322, -1000, -1000, 1318, 1069, -1000, 1065, 610,
-	-1000, -81, -1000, -1000, 843, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1303, 11141, 11141, 854, -1000, 11141, 887, 164, 182,
-	-1000, 7839, 7839, -1000, -1000, -1000, -1000, 832, 155, -133,
-	14168, 894, 832, 13618, -1000, -1000, -127, 587, 13618, -1000,
-	662, -1000, -1000, 593, 655, 593, 593, 593, 593, 593,
-	331, 331, 13618, 11141, -1000, -1000, 672, -1000, -1000, 883,
-	868, -91, 13618, 7839, 858, 1016, 831, -1000, 13618, 1054,
-	482, 885, -1000, 1179, -87, -149, 865, -1000, -1000, 829,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 817, 811, -1000, 139, 642, 636,
-	624, 618, -4, -1000, 1280, 1303, -1000, -1000, -203, -1000,
-	482, -1000, -105, -1000, 164, 1189, 11141, -1000, 1174, -1000,
-	-1000, 587, 198, -109, 616, -1000, 609, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 10866, -1000, 7839, -1000, -1000, 160,
-	806, -129, -1000, 13893, 1045, -1000, -1000, -1000, 356, 482,
-	154, -1000, -147, 1044, 587, 4665, 1009, -154, 13618, 792,
-	-1000, 8114, -1000, 770, -1000, 1532, 832, -1000, -1000, -1000,
+	280, 428, -1000, 4589, -1000, 667, 14423, 14423, 781, 5534,
+	691, 1353, 712, 712, 445, -1000, -1000, 14423, -1000, -1000,
+	-1000, -1000, 1073, -1000, -1000, -1000, 4274, 7678, -1000, 188,
+	976, 160, -1000, 9134, -1000, 9134, -1000, -1000, 1067, 7678,
+	712, -1000, -1000, -1000, 1890, 801, 1890, 9134, 9134, 9134,
+	9134, -23, 1169, 609, -1000, 8564, 772, -1000, -1000, -1000,
+	-1000, -1000, 1293, 14673, 1174, -1000, 10525, 14173, 1439, 14673,
+	8564, 8564, -1000, -1000, 8564, 1251, -1000, 8564, -1000, -1000,
+	-1000, 1174, 1174, 1174, 1030, -1000, 1439, 1171, -1000, -1000,
+	-1000, 36, 47, -1000, -1000, 3644, 1701, 14173, 14423, -1000,
+	3644, 1250, 895, -12, -1000, -8, 349, -14, 8564, 1249,
+	893, -1000, 891, 889, -1000, 872, -1000, -10, 1461, -1000,
+	87, 8564, 1174, -179, -1000, -1000, -1000, -1000, -1000, -1000,
+	1174, 1248, 1247, -1000, 34, -1000, -1000, 8564, -1000, 1246,
+	1412, -1000, 1392, 838, 8564, 723, -1000, -1000, -1000, 994,
+	487, 487, 132, 487, 487, 487, -1000, 557, -1000, -1000,
+	-1000, -1000, 1061, -1000, 1042, -1000, 154, 148, -1000, 1170,
+	-1000, 1039, 1178, 1292, -1000, -1000, 1165, -1000, 587, 1431,
+	243, -1000, 14173, 325, 330, 14173, -1000, 14173, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 14173, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14423, -1000, -1000,
+	-1000, -1000, -1000, 14173, 359, -1000, -1000, 851, 8564, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 5219, -1000, 1449,
+	12381, -1000, -1000, 927, -1000, 9134, 976, 976, -1000, -1000,
+	927, 1240, 1240, -1000, 1240, 1245, -1000, -1000, 1240, 174,
+	1240, 172, 927, 927, 318, 532, 126, 507, 1174, 10,
+	-1000, 712, 8564, -1000, 1387, 1128, 1098, -1000, -1000, 7972,
+	927, 1036, 444, 1030, 1435, -1000, 712, 712, 712, 12096,
+	712, 12096, 12096, 12096, 10239, 14173, 1435, -1000, -1000, -1000,
+	-1000, 3329, 1008, -1000, 870, 585, 849, -63, 582, 581,
+	578, 577, 574, 572, 543, 539, 1174, 1000, -1000, 9989,
+	-1000, -1000, -15, -1000, 329, 324, 1174, 1287, -148, 723,
+	14173, -1000, -1000, -1000, -1000, -1000, -163, -1000, -1000, 371,
+	371, -1000, 1174, 1949, 723, 7678, -1000, 2886, 927, -1000,
+	841, -1000, 807, -1000, 723, 12096, 130, -1000, 1163, 723,
+	-61, -1000, -1000, -1000, 487, -1000, -1000, -1000, -1000, -1000,
+	132, 827, 132, 79, 78, 831, -1000, 819, 9989, 14173,
+	14423, 5219, 3644, 394, 1420, -1000, -1000, -1000, 14173, -1000,
+	-1000, -163, 368, -1000, 1241, -1000, -1000, -1000, -1000, 1400,
+	14173, -1000, -1000, 712, 1447, 1155, -1000, 976, -1000, -1000,
+	284, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	9134, 9134, -1000, 9134, 9134, 9134, 927, 825, 712, 313,
+	-1000, 1174, -1000, -1000, 1173, 14173, 14173, -1000, -1000, 998,
+	-1000, -1000, 993, 993, 993, 473, -1000, -1000, 3644, 1701,
+	-1000, 812, -1000, -1000, 14173, 676, 795, 676, 676, 676,
+	676, 676, 949, 8564, -1000, 991, -1000, 489, 1174, -1000,
+	1240, 8564, 443, -1000, -1000, 14173, -163, 8564, 1239, 1233,
+	-1000, -1000, 209, 989, -1000, -29, -1000, 1290, -1000, -1000,
+	742, 206, 1289, 8564, -1000, 927, -84, -1000, -1000, -1000,
+	-1000, 209, 983, 1232, 8564, 777, -61, -1000, -1000, -1000,
+	-1000, -1000, 487, -1000, 487, -1000, -1000, 978, 948, 977,
+	1230, 1225, -1000, -1000, 14173, -1000, -1000, -1000, -1000, -1000,
+	1214, 1211, -1000, 308, 12096, 1174, 366, 1441, 257, -1000,
+	-1000, 65, 65, 65, 65, 35, -1000, -1000, 1465, -1000,
+	1174, -1000, 1205, 442, -1000, 14173, -1000, -1000, -1000, -1000,
+	-1000, 1145, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 975,
+	-18, 9989, 801, -1000, 723, 5219, 1208, -1000, 1286, 723,
+	14173, 9989, -1000, 8, 1449, 14173, 648, 1464, -1000, -1000,
+	-1000, 1458, 723, -1000, -1000, -1000, -1000, -1000, 723, 936,
+	-1000, -1000, -1000, -1000, -1000, -18, 9989, 9989, 1101, -1000,
+	9989, 9989, -163, 969, 276, 300, -1000, 8564, 8564, -1000,
+	-1000, -1000, -1000, 927, 223, -66, 14673, 1098, 927, 14173,
+	-1000, -1000, 1802, 1204, -1000, -1000, -1000, 1174, 14173, 1200,
+	209, 967, 957, -1000, -1000, -1000, -1000, -1000, -1000, 371,
+	371, 209, 656, -61, -1000, 1449, 947, 943, -30, 14173,
+	8564, 941, 935, 1198, 1282, 930, -1000, 14173, 1197, 712,
+	1094, -1000, 1352, -27, -112, 1088, -1000, -1000, 1701, 181,
+	-1000, 14173, 926, 9989, -1000, 1449, -32, -1000, -1000, -1000,
+	-1000, 185, 312, 759, 744, 730, 43, -1000, 222, -1000,
+	-1000, -18, -1000, -1000, -204, -1000, 712, -1000, -1000, 9989,
+	-47, -1000, 276, 1362, 9989, -1000, 1351, -1000, -1000, 1701,
+	924, 355, 922, -1000, 1192, 705, -1000, 693, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 11846, 1449, 8564, 919, -1000,
+	-1000, 287, 912, -60, 903, -1000, 14423, 1787, 1701, -1000,
+	-1000, -1000, 437, -1000, 712, -1000, 285, -1000, -70, -1000,
+	1179, 164, 1701, 901, 5219, 1174, -114, 14173, 1701, -1000,
+	-1000, 8849, -1000, 888, 875, 65, 927, -1000, -1000, -1000,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 1548, 22, 797, 1547, 1545, 1543, 1542, 1540, 1539,
-	1538, 1537, 1536, 1535, 1534, 1533, 1528, 1526, 1524, 1522,
-	1512, 1511, 1508, 1505, 240, 1504, 1503, 1502, 87, 1498,
-	86, 1496, 1493, 46, 81, 47, 45, 1674, 1492, 31,
-	83, 80, 1489, 55, 1485, 1484, 89, 1482, 76, 1481,
-	1480, 817, 1479, 1476, 24, 16, 1475, 52, 1472, 1471,
-	82, 71, 1470, 1469, 1468, 1467, 1465, 1464, 58, 12,
-	14, 26, 28, 1463, 48, 9, 1461, 56, 1459, 1456,
-	1455, 1453, 40, 1450, 60, 1440, 32, 59, 1439, 20,
-	74, 42, 33, 10, 88, 61, 1438, 41, 67, 54,
-	1437, 1431, 679, 1430, 1429, 1428, 1427, 1426, 1425, 655,
-	708, 1424, 1423, 1422, 43, 0, 206, 4, 103, 1421,
-	51, 1420, 1381, 91, 75, 29, 1418, 50, 78, 49,
-	1417, 1409, 44, 84, 1408, 99, 92, 1407, 1406, 1402,
-	1400, 1399, 90, 39, 66, 8, 1398, 1397, 1396, 18,
-	65, 30, 57, 73, 1391, 1390, 1387, 34, 1386, 19,
-	17, 3, 53, 1385, 1384, 1382, 1380, 37, 25, 1379,
-	21, 13, 7, 1373, 2, 1372, 1, 1371, 27, 1370,
-	5, 1369, 6, 1367, 1366, 1364, 1363, 1362, 1361, 1359,
-	1357, 1353, 1352, 15, 36, 11, 1351, 1350, 1435, 1259,
-	1348, 1347, 1344, 1343, 135,
+	0, 1706, 27, 838, 1705, 1699, 1692, 1688, 1687, 1686,
+	1685, 1683, 1679, 1678, 1677, 1675, 1672, 1670, 1668, 1666,
+	1665, 1663, 1662, 1659, 1652, 442, 1651, 1650, 1649, 90,
+	1647, 104, 1646, 1645, 56, 189, 38, 57, 1472, 1641,
+	46, 99, 92, 1636, 72, 1634, 1625, 53, 1620, 87,
+	1619, 1617, 770, 1614, 1613, 35, 3, 1610, 34, 18,
+	1609, 100, 21, 1608, 1607, 1605, 91, 1604, 1603, 79,
+	15, 19, 41, 32, 1601, 121, 17, 1599, 78, 1597,
+	1596, 1594, 1593, 58, 1592, 82, 1591, 61, 80, 1589,
+	31, 98, 54, 44, 10, 106, 85, 1588, 55, 88,
+	75, 1587, 1585, 791, 1583, 1582, 1580, 1579, 1578, 1577,
+	735, 812, 1575, 1574, 1573, 97, 0, 1039, 50, 108,
+	1572, 63, 1570, 1559, 103, 86, 43, 1569, 47, 197,
+	30, 1568, 1567, 62, 102, 40, 107, 105, 1566, 1563,
+	1561, 1560, 1558, 74, 49, 171, 149, 1557, 1554, 26,
+	69, 67, 45, 68, 101, 84, 1553, 1552, 1551, 48,
+	1546, 1544, 1533, 1532, 23, 11, 42, 1529, 14, 25,
+	4, 8, 71, 1528, 1526, 1523, 29, 52, 37, 1520,
+	24, 1518, 16, 13, 1, 2, 22, 1516, 5, 1515,
+	33, 1510, 6, 1508, 7, 1507, 1506, 1505, 1504, 20,
+	1502, 1500, 1497, 9, 1495, 1494, 1492, 1491, 12, 1488,
+	51, 263, 1487, 1486, 59, 1253, 1485, 1481, 1480, 1479,
+	122,
 }
 
 var yyR1 = [...]int{
-	0, 196, 197, 197, 1, 1, 1, 1, 1, 1,
+	0, 212, 213, 213, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 6, 3, 4, 4, 5,
-	5, 7, 7, 27, 27, 8, 9, 9, 9, 200,
-	200, 46, 46, 90, 90, 10, 10, 10, 10, 95,
-	95, 99, 99, 99, 100, 100, 100, 100, 130, 130,
-	11, 11, 11, 11, 11, 11, 11, 11, 11, 184,
-	184, 184, 185, 185, 185, 185, 185, 185, 187, 187,
-	188, 188, 120, 120, 182, 182, 181, 180, 180, 179,
-	179, 178, 189, 189, 16, 164, 165, 165, 165, 165,
-	165, 153, 134, 134, 134, 134, 134, 154, 154, 154,
-	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
-	154, 154, 154, 154, 154, 154, 154, 194, 194, 194,
-	194, 194, 194, 194, 194, 191, 191, 192, 192, 192,
-	192, 192, 192, 192, 192, 192, 192, 192, 192, 192,
-	192, 143, 143, 143, 143, 143, 190, 190, 186, 186,
-	186, 186, 186, 138, 138, 136, 136, 136, 136, 136,
-	136, 136, 136, 136, 136, 137, 137, 137, 137, 137,
-	137, 137, 137, 139, 139, 139, 139, 139, 139, 139,
-	139, 135, 135, 140, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 141, 141, 141, 141,
-	141, 141, 141, 141, 152, 152, 142, 142, 150, 150,
-	151, 151, 151, 149, 149, 149, 146, 146, 147, 147,
-	148, 148, 148, 144, 144, 144, 145, 145, 145, 155,
-	155, 155, 173, 173, 174, 174, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 163, 163, 195,
-	195, 169, 169, 169, 169, 169, 169, 169, 169, 162,
-	162, 171, 171, 170, 170, 157, 157, 157, 157, 157,
-	158, 159, 159, 159, 159, 156, 156, 193, 193, 193,
-	160, 160, 161, 161, 166, 166, 166, 167, 167, 167,
-	168, 168, 168, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 183, 183, 183, 183, 183,
-	183, 183, 183, 183, 183, 183, 201, 201, 202, 202,
-	202, 202, 202, 202, 202, 177, 175, 175, 176, 176,
+	1, 1, 1, 2, 2, 2, 6, 3, 4, 4,
+	5, 5, 7, 7, 28, 28, 8, 9, 9, 9,
+	216, 216, 47, 47, 91, 91, 10, 10, 10, 10,
+	96, 96, 100, 100, 100, 101, 101, 101, 101, 131,
+	131, 11, 11, 11, 11, 11, 11, 11, 11, 11,
+	11, 11, 196, 196, 196, 197, 197, 197, 197, 197,
+	197, 200, 200, 201, 201, 121, 121, 194, 194, 193,
+	192, 192, 191, 191, 190, 202, 202, 16, 174, 174,
+	174, 174, 175, 175, 175, 175, 175, 175, 175, 175,
+	154, 154, 135, 135, 135, 135, 135, 135, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	155, 155, 199, 199, 199, 199, 210, 210, 210, 210,
+	210, 210, 210, 210, 206, 206, 207, 207, 207, 207,
+	207, 207, 207, 207, 207, 207, 207, 207, 207, 207,
+	144, 144, 144, 144, 144, 203, 203, 198, 198, 198,
+	139, 139, 137, 137, 137, 137, 137, 137, 137, 137,
+	137, 137, 138, 138, 138, 138, 138, 138, 138, 138,
+	140, 140, 140, 140, 140, 140, 140, 140, 140, 136,
+	136, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 142, 142, 142, 142, 142, 142,
+	142, 142, 153, 153, 143, 143, 151, 151, 152, 152,
+	152, 150, 150, 150, 147, 147, 148, 148, 149, 149,
+	149, 145, 145, 145, 146, 146, 146, 156, 156, 156,
+	186, 186, 171, 171, 184, 184, 185, 185, 183, 183,
+	183, 183, 183, 183, 183, 183, 183, 183, 183, 183,
+	183, 183, 183, 183, 173, 173, 211, 211, 179, 179,
+	179, 179, 179, 179, 179, 179, 172, 172, 182, 182,
+	180, 180, 181, 181, 181, 181, 159, 160, 160, 160,
+	160, 160, 161, 204, 204, 204, 205, 205, 205, 168,
+	168, 168, 168, 168, 157, 157, 157, 162, 162, 163,
+	163, 166, 166, 165, 165, 164, 167, 167, 158, 158,
+	208, 208, 208, 209, 209, 209, 169, 169, 170, 170,
+	176, 176, 176, 177, 177, 177, 178, 178, 178, 12,
+	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
+	12, 12, 12, 12, 12, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 217, 217, 218, 218,
+	218, 218, 218, 218, 218, 189, 187, 187, 188, 188,
 	13, 14, 14, 14, 14, 14, 15, 15, 17, 18,
 	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
 	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 107, 107, 104, 104, 105, 105, 106, 106,
-	106, 108, 108, 108, 131, 131, 131, 19, 19, 21,
-	21, 22, 23, 20, 20, 20, 20, 20, 203, 24,
-	25, 25, 26, 26, 26, 30, 30, 30, 28, 28,
-	29, 29, 35, 35, 34, 34, 36, 36, 36, 36,
-	119, 119, 119, 118, 118, 38, 38, 39, 39, 40,
-	40, 41, 41, 41, 53, 53, 89, 89, 89, 91,
-	91, 42, 42, 42, 42, 43, 43, 44, 44, 45,
-	45, 126, 126, 125, 125, 125, 124, 124, 47, 47,
-	47, 49, 48, 48, 48, 48, 50, 50, 52, 52,
-	51, 51, 54, 54, 54, 54, 55, 55, 37, 37,
-	37, 37, 37, 37, 37, 103, 103, 57, 57, 56,
-	56, 56, 56, 56, 56, 56, 56, 56, 56, 67,
-	67, 67, 67, 67, 67, 58, 58, 58, 58, 58,
-	58, 58, 33, 33, 68, 68, 68, 74, 69, 69,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 61, 61, 61, 61, 61, 61, 61, 61,
-	61, 61, 65, 65, 65, 63, 63, 63, 63, 63,
-	63, 63, 63, 63, 63, 63, 63, 63, 63, 63,
-	64, 64, 64, 64, 64, 64, 64, 64, 64, 204,
-	204, 66, 66, 66, 66, 31, 31, 31, 31, 31,
-	129, 129, 132, 132, 132, 132, 132, 132, 132, 132,
-	132, 132, 132, 132, 132, 132, 133, 133, 133, 133,
-	133, 133, 133, 78, 78, 32, 32, 76, 76, 77,
-	79, 79, 75, 75, 75, 60, 60, 60, 60, 60,
-	60, 60, 60, 62, 62, 62, 80, 80, 81, 81,
-	82, 82, 83, 83, 84, 85, 85, 85, 86, 86,
-	86, 86, 87, 87, 87, 59, 59, 59, 59, 59,
-	59, 88, 88, 88, 88, 92, 92, 70, 70, 72,
-	72, 71, 73, 93, 93, 97, 94, 94, 98, 98,
-	98, 98, 96, 96, 96, 121, 121, 121, 101, 101,
-	109, 109, 110, 110, 102, 102, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 112, 112, 112, 113,
-	113, 116, 116, 117, 117, 122, 122, 123, 123, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 114, 114, 114,
-	114, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	18, 18, 108, 108, 105, 105, 106, 106, 107, 107,
+	107, 109, 109, 109, 132, 132, 132, 19, 19, 22,
+	22, 23, 24, 21, 21, 21, 21, 20, 20, 20,
+	20, 20, 219, 25, 26, 26, 27, 27, 27, 31,
+	31, 31, 29, 29, 30, 30, 36, 36, 35, 35,
+	37, 37, 37, 37, 120, 120, 120, 119, 119, 39,
+	39, 40, 40, 41, 41, 42, 42, 42, 54, 54,
+	90, 90, 90, 92, 92, 43, 43, 43, 43, 44,
+	44, 45, 45, 46, 46, 127, 127, 126, 126, 126,
+	125, 125, 48, 48, 48, 50, 49, 49, 49, 49,
+	51, 51, 53, 53, 52, 52, 55, 55, 55, 55,
+	56, 56, 38, 38, 38, 38, 38, 38, 38, 104,
+	104, 58, 58, 57, 57, 57, 57, 57, 57, 57,
+	57, 57, 57, 57, 57, 68, 68, 68, 68, 68,
+	68, 59, 59, 59, 59, 59, 59, 59, 34, 34,
+	69, 69, 69, 75, 70, 70, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 62, 62,
+	62, 62, 62, 62, 62, 62, 62, 62, 66, 66,
+	66, 64, 64, 64, 64, 64, 64, 64, 64, 64,
+	64, 64, 64, 64, 64, 64, 65, 65, 65, 65,
+	65, 65, 65, 65, 65, 220, 220, 67, 67, 67,
+	67, 32, 32, 32, 32, 32, 130, 130, 133, 133,
+	133, 133, 133, 133, 133, 133, 133, 133, 133, 133,
+	133, 133, 134, 134, 134, 134, 134, 134, 134, 134,
+	79, 79, 33, 33, 77, 77, 78, 80, 80, 76,
+	76, 76, 61, 61, 61, 61, 61, 61, 61, 61,
+	63, 63, 63, 81, 81, 82, 82, 83, 83, 84,
+	84, 85, 86, 86, 86, 87, 87, 87, 87, 88,
+	88, 88, 60, 60, 60, 60, 60, 60, 89, 89,
+	89, 89, 93, 93, 71, 71, 73, 73, 72, 74,
+	94, 94, 98, 95, 95, 99, 99, 99, 99, 97,
+	97, 97, 122, 122, 122, 102, 102, 110, 110, 111,
+	111, 103, 103, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 112, 113, 113, 113, 114, 114, 117, 117,
+	118, 118, 123, 123, 124, 124, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
@@ -2509,43 +2654,62 @@ var yyR1 = [...]int{
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 198, 199, 127, 128, 128, 128,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 116, 116,
+	214, 215, 128, 129, 129, 129,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 6, 7, 5, 10, 1, 3, 1,
-	3, 7, 8, 1, 1, 8, 8, 7, 6, 1,
-	1, 1, 3, 0, 4, 3, 4, 5, 4, 1,
-	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
-	2, 10, 11, 12, 6, 5, 5, 5, 11, 0,
-	2, 2, 0, 2, 2, 2, 2, 2, 0, 2,
-	0, 3, 0, 1, 0, 2, 1, 0, 2, 1,
-	3, 3, 0, 2, 4, 4, 1, 3, 3, 3,
-	3, 2, 3, 1, 1, 1, 1, 2, 2, 3,
-	2, 4, 4, 2, 2, 3, 2, 3, 2, 6,
-	7, 3, 3, 6, 5, 8, 7, 3, 2, 2,
-	2, 2, 2, 2, 4, 1, 2, 0, 4, 3,
-	4, 3, 3, 3, 3, 3, 3, 3, 2, 4,
-	6, 2, 3, 2, 3, 1, 0, 2, 0, 3,
-	3, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 3, 2, 2, 2,
-	2, 1, 1, 1, 3, 3, 2, 1, 2, 1,
-	1, 1, 1, 4, 4, 4, 4, 4, 1, 5,
-	2, 2, 3, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 1, 1, 6, 6, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 3, 0, 5,
-	0, 3, 5, 0, 3, 3, 0, 1, 0, 1,
-	0, 2, 1, 0, 3, 3, 0, 1, 2, 5,
-	8, 4, 1, 2, 1, 3, 2, 3, 2, 3,
-	3, 3, 3, 3, 3, 3, 3, 0, 1, 1,
-	1, 2, 3, 3, 2, 3, 2, 3, 4, 1,
-	1, 1, 3, 2, 2, 1, 4, 4, 7, 7,
-	13, 1, 1, 2, 2, 8, 12, 0, 1, 1,
-	0, 1, 1, 3, 0, 1, 3, 1, 2, 3,
-	1, 1, 1, 6, 11, 13, 7, 7, 7, 12,
+	1, 1, 1, 4, 6, 7, 5, 10, 1, 3,
+	1, 3, 7, 8, 1, 1, 8, 8, 7, 6,
+	1, 1, 1, 3, 0, 4, 3, 4, 5, 4,
+	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
+	1, 2, 11, 11, 13, 5, 6, 6, 5, 5,
+	5, 11, 0, 2, 2, 0, 2, 2, 2, 2,
+	2, 0, 2, 0, 3, 0, 1, 0, 2, 1,
+	0, 2, 1, 3, 3, 0, 2, 4, 4, 8,
+	9, 7, 1, 3, 3, 3, 3, 3, 3, 3,
+	2, 6, 3, 1, 1, 1, 1, 1, 2, 2,
+	3, 2, 4, 5, 6, 4, 2, 2, 3, 2,
+	3, 2, 6, 8, 3, 3, 6, 5, 8, 7,
+	8, 6, 0, 1, 1, 1, 3, 2, 2, 2,
+	2, 2, 2, 4, 1, 2, 0, 4, 3, 4,
+	3, 3, 3, 3, 3, 3, 3, 2, 4, 6,
+	2, 3, 2, 3, 1, 0, 2, 0, 3, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 3, 2, 2, 2, 2, 1, 1,
+	1, 3, 3, 2, 2, 1, 2, 1, 1, 1,
+	1, 4, 4, 4, 4, 4, 1, 5, 2, 2,
+	3, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	1, 1, 6, 6, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 3, 0, 3, 0, 5, 0, 3,
+	5, 0, 3, 3, 0, 1, 0, 1, 0, 2,
+	1, 0, 3, 3, 0, 1, 2, 7, 10, 6,
+	0, 2, 0, 4, 1, 2, 1, 3, 2, 3,
+	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 0, 1, 1, 1, 2, 3,
+	3, 2, 3, 2, 3, 4, 1, 1, 1, 3,
+	1, 3, 1, 1, 2, 3, 3, 1, 4, 4,
+	7, 7, 13, 0, 1, 2, 0, 2, 2, 1,
+	1, 2, 2, 2, 9, 13, 10, 7, 5, 8,
+	6, 0, 2, 1, 3, 3, 1, 1, 7, 11,
+	0, 1, 1, 0, 1, 1, 0, 1, 1, 3,
+	0, 1, 3, 1, 2, 3, 1, 1, 1, 6,
+	7, 11, 13, 11, 13, 8, 7, 7, 7, 12,
 	7, 7, 7, 4, 5, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 7, 1, 3, 8, 8,
@@ -2554,38 +2718,40 @@ var yyR2 = [...]int{
 	3, 4, 3, 6, 4, 2, 4, 2, 2, 2,
 	2, 3, 1, 1, 0, 1, 0, 1, 0, 2,
 	2, 0, 2, 2, 0, 1, 1, 2, 1, 1,
-	2, 1, 1, 2, 2, 2, 2, 2, 0, 2,
-	0, 2, 1, 2, 2, 0, 1, 1, 0, 1,
-	0, 1, 0, 1, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
-	1, 1, 3, 3, 3, 7, 1, 1, 3, 1,
-	3, 4, 4, 4, 3, 2, 4, 0, 1, 0,
-	2, 0, 1, 0, 1, 2, 1, 1, 1, 2,
-	2, 1, 2, 3, 2, 3, 2, 2, 2, 1,
-	1, 3, 0, 5, 5, 5, 0, 2, 1, 3,
-	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
-	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
-	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 3, 1, 3,
-	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	2, 2, 2, 2, 2, 2, 3, 3, 1, 1,
-	1, 1, 4, 5, 6, 4, 4, 6, 6, 6,
-	6, 8, 8, 6, 8, 8, 9, 7, 5, 4,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 0,
-	2, 4, 4, 4, 4, 0, 3, 4, 7, 3,
-	1, 1, 2, 3, 3, 1, 2, 2, 1, 1,
-	2, 1, 2, 2, 1, 2, 1, 2, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 1, 2, 4,
-	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
-	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
-	4, 4, 0, 2, 4, 2, 1, 3, 5, 4,
-	6, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
-	0, 2, 0, 3, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	2, 1, 1, 6, 6, 6, 6, 2, 2, 2,
+	2, 2, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
+	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
+	2, 1, 3, 1, 1, 1, 3, 3, 3, 7,
+	1, 1, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 0, 5, 5, 5,
+	0, 2, 1, 3, 3, 2, 3, 1, 2, 0,
+	3, 1, 1, 3, 3, 4, 4, 5, 4, 3,
+	4, 3, 5, 6, 2, 1, 2, 1, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 3, 1, 3, 1, 1, 1, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
+	2, 2, 3, 3, 1, 1, 1, 1, 4, 5,
+	6, 4, 4, 6, 6, 6, 6, 8, 8, 6,
+	8, 8, 9, 7, 5, 4, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 0, 2, 4, 4, 4,
+	4, 0, 3, 4, 7, 3, 1, 1, 2, 3,
+	3, 1, 2, 2, 1, 1, 2, 1, 2, 2,
+	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 2, 1, 2, 4, 0, 2, 1,
+	3, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 4, 2, 1, 3, 5, 4, 6, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2610,352 +2776,390 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -196, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -17, -18, -19, -21, -22, -23,
-	-20, -3, -4, 6, 7, -27, 9, 10, 29, -16,
-	112, 113, 115, 114, 143, 116, 136, 48, 171, 172,
-	174, 175, 25, 137, 138, 141, 142, -198, 8, 274,
-	52, -197, 306, -82, 15, -26, 5, -24, -203, -24,
-	-24, -24, -24, -24, -164, 52, -120, -189, 151, 266,
-	118, 133, 119, 134, 70, -102, 121, 123, 119, 119,
-	120, 121, 266, 118, 119, -51, -122, 55, -115, 158,
-	283, 20, 171, 184, 185, 176, 217, 205, 284, 156,
-	202, 206, 253, 305, 64, 174, 262, 127, 162, 139,
-	197, 200, 199, 191, 188, 27, 223, 290, 190, 130,
-	224, 228, 254, 281, 181, 182, 256, 221, 31, 132,
-	285, 33, 147, 257, 226, 220, 215, 219, 180, 214,
-	37, 194, 230, 229, 231, 252, 208, 157, 233, 210,
-	192, 209, 18, 142, 145, 225, 227, 189, 159, 125,
-	149, 289, 258, 187, 146, 160, 141, 261, 155, 175,
-	255, 183, 264, 36, 238, 201, 178, 193, 179, 129,
-	172, 153, 212, 148, 195, 196, 218, 177, 213, 173,
-	150, 143, 263, 239, 291, 211, 207, 203, 204, 154,
-	121, 151, 152, 245, 246, 247, 248, 286, 287, 259,
-	198, 240, 241, 164, 165, 166, 167, 168, 169, 170,
-	119, 106, 206, 112, 243, 120, 31, 149, -131, 119,
-	-104, 152, 245, 246, 247, 248, 55, 255, 254, 249,
-	-122, 173, -127, -127, -127, -127, -127, -2, -86, 17,
-	16, -5, -3, -198, 6, 20, 21, -30, 38, 39,
-	-25, -36, 97, -37, -122, -56, 72, -61, 28, 55,
-	-115, 23, -60, -57, -75, -73, -74, 106, 107, 95,
-	96, 103, 73, 108, -65, -63, -64, -66, 57, 56,
-	65, 58, 59, 60, 61, 66, 67, 68, -116, -71,
-	-198, 42, 43, 275, 276, 277, 278, 282, 279, 75,
-	32, 265, 273, 272, 271, 269, 270, 267, 268, 304,
-	124, 266, 101, 274, -102, -39, -40, -41, -42, -53,
-	-74, -198, -51, 11, -46, -51, -94, -130, 173, -98,
-	255, 254, -117, -96, -116, -114, 253, 206, 252, 55,
-	-115, 117, 293, 71, 22, 24, 236, 242, 74, 106,
-	16, 75, 302, 303, 105, 275, 112, 46, 267, 268,
-	265, 277, 278, 266, 243, 28, 10, 25, 137, 21,
-	99, 114, 78, 79, 140, 23, 138, 68, 19, 49,
-	131, 11, 292, 13, 14, 294, 124, 123, 90, 120,
-	44, 8, 108, 26, 87, 40, 135, 42, 88, 17,
-	269, 270, 30, 282, 144, 101, 47, 34, 72, 66,
-	50, 260, 70, 15, 45, 133, 89, 115, 274, 43,
-	118, 6, 280, 29, 136, 41, 119, 244, 77, 122,
-	67, 5, 134, 9, 48, 51, 271, 272, 273, 32,
-	76, 12, 69, -165, -153, 55, 120, 121, -116, -110,
-	124, -110, -116, -110, 274, 119, -51, -51, -109, 124,
-	55, -109, -109, -109, -51, 109, -51, 55, 29, 266,
-	55, 149, 119, 150, 121, -128, -198, -117, -128, -128,
-	-128, 153, 154, -128, -105, 250, 50, -128, -199, 54,
-	-87, 19, 30, -37, -122, -83, -84, -37, -82, -2,
-	-24, 34, -28, 21, 63, 11, -119, 71, 70, 87,
-	-118, 22, -116, 57, 109, -37, -58, 90, 72, 88,
-	89, 74, 92, 91, 102, 95, 96, 97, 98, 99,
-	100, 101, 93, 94, 105, 304, 80, 81, 82, 83,
-	84, 85, 86, -103, -198, -74, -198, 110, 111, -61,
-	-61, -61, -61, -61, -61, -61, -198, -2, -69, -37,
-	-198, -198, -198, -198, -198, -198, -198, -198, -198, -78,
-	-37, -198, -204, -198, -204, -204, -204, -204, -204, -204,
-	-204, -133, 106, 206, 139, 197, -136, -135, 212, 176,
-	177, 178, 179, 180, 181, 182, 183, 184, 185, 205,
-	284, -198, -198, -198, -198, -52, 26, -51, 29, 53,
-	-47, -49, -48, -50, 40, 44, 46, 41, 42, 43,
-	47, -126, 22, -39, -198, -125, 145, -124, 22, -122,
-	57, -51, -46, -200, 53, 11, 51, 53, -94, 173,
-	-95, -99, 256, 258, 80, -121, -116, 57, 28, 29,
-	54, 53, -154, -134, -138, -135, -140, -139, -141, -136,
-	-137, 202, 206, 203, 208, 209, 210, 106, 207, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
-	211, 223, 29, 139, 195, 196, 197, 200, 199, 201,
-	198, 224, 225, 226, 227, 228, 229, 230, 231, 187,
-	188, 190, 191, 192, 194, 193, -116, -51, -182, 51,
-	55, 72, 55, 50, -51, -51, 260, -128, 122, -51,
-	23, 50, -51, 55, 55, -123, -122, -114, -128, -128,
-	-128, -128, -128, -128, -128, -128, -128, -128, -107, 244,
-	251, -51, 9, 90, 53, 18, 109, 53, -85, 24,
-	25, -86, -199, -30, -62, -116, 58, 61, -29, 41,
-	-51, -37, -37, -67, 66, 72, 67, 68, -118, 97,
-	-123, -117, -114, -61, -68, -71, -74, 62, 90, 88,
-	89, 74, -61, -61, -61, -61, -61, -61, -61, -61,
-	-61, -61, -61, -61, -61, -61, -61, -129, 55, 57,
-	-133, 55, -60, -60, -116, -35, 21, -34, -36, -199,
-	53, -199, -2, -34, -34, -37, -37, -75, -116, -122,
-	-75, -34, -28, -76, -77, 76, -75, -199, 204, -34,
-	-35, -34, -34, -90, 145, -51, -93, -97, -75, -40,
-	-41, -41, -40, -41, 40, 40, 40, 45, 40, 45,
-	40, -48, -122, -199, -54, 48, 123, 49, -198, -124,
-	-90, 51, -39, -51, -98, -95, 53, 257, 259, 260,
-	50, 69, -37, -145, 106, 105, -166, -167, -168, -117,
-	57, 58, -153, -155, -157, -156, -169, -158, 127, 125,
-	129, 130, 134, -162, 120, 135, 66, 72, -194, 127,
-	50, 236, 242, 125, 135, 134, 305, 64, 128, 292,
-	294, 28, -148, 307, 232, -146, 239, -142, 52, -142,
-	-142, 204, -142, -142, -142, -142, -142, -144, 206, -144,
-	-144, -144, -144, 52, 52, -142, -142, -142, -142, -150,
-	52, 189, -150, -150, -151, 52, -151, 50, 51, 22,
-	-180, 286, -181, 55, -128, 23, -128, -51, -111, 117,
-	114, 115, -177, 113, 236, 206, 64, 28, 15, 275,
-	145, 291, 55, 146, -51, -51, -51, -128, -106, 11,
-	90, 36, -37, -37, -123, -84, -87, -101, 19, 11,
-	32, 32, -34, 66, 67, 68, 109, -198, -68, -61,
-	-61, -61, -33, 140, 71, -199, -199, -34, 53, -37,
-	-199, -199, -199, 53, 51, 22, 53, 11, 109, 53,
-	11, -199, -34, -79, -77, 78, -37, -199, -199, -199,
-	-199, -199, -59, 29, 32, -2, -198, -198, -55, 53,
-	12, 80, -44, -43, 50, 51, -45, 50, -43, 40,
-	40, 120, 120, 120, -91, -116, -55, -39, -55, -99,
-	-100, 261, 258, 264, 55, 53, -168, 80, 52, 50,
-	-160, -116, 135, -162, -162, 55, -162, 55, 55, 66,
-	-116, 9, 135, 135, -198, 57, -122, -191, 293, 16,
-	52, 57, 58, 59, 66, -143, 65, -57, 233, 265,
-	268, 267, 308, -147, 240, 58, -144, -144, -142, -144,
-	-144, -144, -145, 29, -145, -145, -145, -145, -152, 57,
-	-152, -149, 286, 287, -149, 58, -150, 58, -51, -116,
-	-2, -179, -178, -117, -184, 22, -127, -120, -202, 151,
-	126, 131, 130, 55, 125, 129, 145, -183, 151, 126,
-	127, 131, 130, 55, 120, 135, 125, 129, 145, 134,
-	-112, -113, 122, 22, 120, 135, 145, 117, -128, -108,
-	88, 12, -122, -122, 37, 109, -51, -38, 11, 97,
-	-117, -35, -33, 71, -61, -61, -199, -36, -132, 106,
-	202, 139, 197, 191, 221, 222, 208, 238, 195, 239,
-	-129, -132, -61, -61, -117, -61, -61, 283, -82, 79,
-	-37, 77, -92, 50, -93, -70, -72, -71, -198, -2,
-	-88, -116, -91, -82, -97, -37, -37, -37, 52, -37,
-	-198, -198, -198, -199, 53, -82, -55, 258, 262, 263,
-	-167, -168, -171, -170, -116, 135, 10, 9, 131, 125,
-	55, 55, 55, -193, 134, 302, 303, -194, 305, -143,
-	-37, 52, 22, 28, 58, -186, 304, -198, -142, 52,
-	-142, 52, 54, -145, -145, -144, -145, -145, -145, 55,
-	106, 54, 53, 54, 195, 195, 53, 54, 53, 52,
-	51, 50, 53, 80, -185, 19, 159, 160, -201, 120,
-	135, -127, -116, -127, -116, -51, -127, -116, 127, -157,
-	57, -37, -55, -39, -199, -61, -199, -142, -142, -142,
-	-151, -142, 182, -142, 182, -199, -199, -199, 53, 19,
-	-199, 53, 19, -198, -32, 280, -37, 27, -92, 53,
-	-199, -199, -199, 53, 109, -199, -86, -89, -116, 135,
-	-89, -89, -89, -125, -116, -86, 54, 53, -142, -142,
-	-159, 155, 156, 29, 157, -159, 135, 135, -193, -198,
-	-199, -89, 294, 53, 206, 196, 234, 212, -199, 54,
-	54, -145, -144, 57, -144, 241, 241, 58, 58, -171,
-	-116, -51, -178, -168, 122, 20, 6, 8, 9, 10,
-	-116, 52, 26, -116, -80, 13, -144, 55, -61, -61,
-	-61, -61, -61, -199, 57, 135, -72, 32, -2, -198,
-	-116, -116, 53, 54, -199, -199, -199, -54, -173, 286,
-	-172, 51, 132, 64, 164, 165, 166, 167, 168, 169,
-	170, -170, 50, 66, 158, 50, -160, -116, -193, -37,
-	-190, 157, 54, 52, 58, 204, -149, -145, -145, 54,
-	54, 54, 52, 52, -161, -116, 52, -89, -198, 125,
-	-81, 14, 16, -199, -199, -199, -199, -31, 90, 286,
-	9, -70, -2, 109, -116, -172, 286, 52, 288, 55,
-	-163, 80, 57, 80, 80, 80, 80, 80, 80, 80,
-	9, 10, 52, 52, -199, 281, -192, 54, -55, -171,
-	-171, -187, 53, 51, -171, 54, -175, -176, 145, 135,
-	-37, -69, -199, 284, 47, 289, -93, -199, -116, -174,
-	-172, -116, 58, -195, 50, 69, 58, -195, -195, -195,
-	-195, -195, -159, -159, -161, -171, 54, 172, 296, 297,
-	144, 298, 157, 299, 300, 54, 54, -188, 286, -116,
-	-37, 54, -182, -199, 53, -116, 52, 37, 285, 290,
-	54, 53, 54, 54, 286, 58, 16, 58, 58, 58,
-	58, 297, 144, 299, 16, -55, 305, -180, -176, 32,
-	-171, 37, -172, 128, 286, 58, 58, 301, -122, -37,
-	147, 54, 286, -51, 52, 109, 148, 289, 52, -174,
-	-117, -198, 290, -161, 54, -61, 144, 54, -199, -199,
+	-1000, -212, -1, -2, -6, -7, -8, -9, -10, -11,
+	-12, -13, -14, -15, -17, -18, -19, -22, -23, -24,
+	-21, -20, -3, -4, 6, 7, -28, 9, 10, 28,
+	-16, 112, 113, 115, 114, 143, 116, 136, 47, 172,
+	173, 175, 176, 63, 24, 137, 138, 141, 142, -214,
+	8, 276, 51, -213, 320, -83, 15, -27, 5, -25,
+	-219, -25, -25, -25, -25, -25, -174, 51, -121, 54,
+	-202, 308, 152, 268, 118, 133, 119, 134, 69, -103,
+	121, 123, 119, 119, 120, 121, 268, 118, 119, -52,
+	-123, 54, -116, 159, 293, 19, 172, 185, 186, 177,
+	219, 207, 294, 157, 204, 208, 255, 319, 63, 175,
+	264, 127, 163, 139, 199, 202, 201, 192, 287, 189,
+	26, 225, 300, 191, 130, 226, 230, 256, 288, 291,
+	290, 289, 283, 284, 182, 183, 258, 223, 30, 132,
+	295, 32, 146, 259, 228, 222, 217, 308, 221, 181,
+	216, 36, 195, 232, 231, 233, 254, 210, 158, 235,
+	212, 193, 211, 17, 142, 150, 227, 229, 190, 160,
+	307, 125, 148, 299, 260, 188, 145, 161, 141, 263,
+	156, 176, 257, 184, 266, 35, 240, 203, 179, 194,
+	180, 129, 173, 154, 214, 147, 196, 197, 198, 220,
+	178, 215, 174, 149, 143, 265, 241, 301, 213, 209,
+	205, 206, 155, 121, 152, 153, 247, 248, 249, 250,
+	296, 297, 261, 200, 242, 243, 165, 166, 167, 168,
+	169, 170, 171, 119, 106, 208, 112, 245, 120, 30,
+	148, -132, 119, -105, 153, 247, 248, 249, 250, 54,
+	257, 256, 251, -123, 174, 49, -128, -128, -128, -128,
+	-128, -2, -87, 16, 151, -5, -3, -214, 6, 19,
+	20, -31, 37, 38, -26, -37, 97, -38, -123, -57,
+	71, -62, 27, 54, -116, 22, -61, -58, -76, -74,
+	-75, 106, 107, 95, 96, 103, 72, 108, -66, -64,
+	-65, -67, 56, 55, 64, 57, 58, 59, 60, 65,
+	66, 67, -117, -72, -214, 41, 42, 277, 278, 279,
+	280, 292, 281, 74, 31, 267, 275, 274, 273, 271,
+	272, 269, 270, 318, 124, 268, 101, 276, -103, -40,
+	-41, -42, -43, -54, -75, -214, -52, 11, -47, -52,
+	-95, -131, 174, -99, 257, 256, -118, -97, -117, -115,
+	255, 208, 254, 54, -116, 117, 303, 70, 21, 23,
+	238, 244, 73, 106, 151, 74, 316, 317, 105, 277,
+	112, 45, 269, 270, 267, 279, 280, 268, 245, 27,
+	10, 24, 137, 20, 99, 114, 77, 78, 140, 22,
+	138, 67, 18, 48, 131, 11, 302, 13, 14, 304,
+	124, 123, 90, 120, 43, 8, 108, 25, 86, 39,
+	135, 41, 87, 16, 271, 272, 29, 292, 144, 101,
+	46, 33, 71, 65, 49, 262, 69, 15, 44, 133,
+	89, 115, 276, 42, 118, 6, 282, 28, 136, 305,
+	40, 119, 246, 76, 122, 66, 5, 134, 9, 47,
+	50, 273, 274, 275, 31, 306, 75, 12, 68, -175,
+	-154, 54, 120, -52, 121, 121, -117, -111, 124, -111,
+	-117, -111, 276, 119, -52, -52, -110, 124, 54, -110,
+	-110, -110, -52, 109, -52, 54, 28, 268, 54, 148,
+	119, 149, 121, -129, -214, -118, -129, -129, -129, 154,
+	155, -129, -106, 252, 49, -129, 126, 119, -215, 53,
+	-88, 18, 29, -38, -123, -84, -85, -38, -83, -2,
+	-25, 33, -29, 20, 62, 11, -120, 70, 69, 86,
+	-119, 21, -117, 56, 109, -38, -59, 90, 71, 87,
+	88, 89, 103, 73, 92, 91, 102, 95, 96, 97,
+	98, 99, 100, 101, 93, 94, 105, 318, 79, 80,
+	81, 82, 83, 84, 85, -104, -214, -75, -214, 110,
+	111, -62, -62, -62, -62, -62, -62, -62, -214, -2,
+	-70, -38, -214, -214, -214, -214, -214, -214, -214, -214,
+	-214, -79, -38, -214, -220, -214, -220, -220, -220, -220,
+	-220, -220, -220, -134, 106, 208, 139, 199, -137, -136,
+	214, 54, 177, 178, 179, 180, 181, 182, 183, 184,
+	185, 186, 207, 294, -214, -214, -214, -214, -53, 25,
+	-52, 28, 52, -48, -50, -49, -51, 39, 43, 45,
+	40, 41, 42, 46, -127, 21, -40, -214, -126, 150,
+	-125, 21, -123, 56, -52, -47, -216, 52, 11, 50,
+	52, -95, 174, -96, -100, 258, 260, 79, -122, -117,
+	56, 27, 28, 53, 52, -155, 21, -135, -139, -136,
+	-141, -140, -142, 54, -137, -138, 204, 208, 205, 210,
+	211, 212, 106, 209, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 213, 225, 28, 139, 196,
+	197, 198, 199, 202, 201, 203, 200, 226, 227, 228,
+	229, 230, 231, 232, 233, 188, 189, 191, 192, 193,
+	195, 194, -117, 21, -52, -52, -194, 50, 54, 71,
+	54, 49, -52, -52, 262, -129, 122, -52, 22, 49,
+	-52, 54, 54, -124, -123, -115, -129, -129, -129, -129,
+	-129, -129, -129, -129, -129, -129, -108, 246, 253, -52,
+	-76, -117, -123, -52, 9, 90, 52, 17, 109, 52,
+	-86, 23, 24, -87, -215, -31, -63, -117, 57, 60,
+	-30, 40, -52, -38, -38, -68, 65, 71, 66, 67,
+	-119, 97, -124, -118, -115, -62, -69, -72, -75, 61,
+	90, 87, 89, 73, -62, -62, -62, -62, -62, -62,
+	-62, -62, -62, -62, -62, -62, -62, -62, -62, -62,
+	-62, -130, 54, 56, -134, 54, -61, -61, -117, -36,
+	20, -35, -37, -215, 52, -215, -2, -35, -35, -38,
+	-38, -76, -76, -35, -29, -77, -78, 75, -76, -215,
+	206, -35, -36, -35, -35, -91, 150, -52, -94, -98,
+	-76, -41, -42, -42, -41, -42, 39, 39, 39, 44,
+	39, 44, 39, -49, -123, -215, -55, 47, 123, 48,
+	-214, -125, -91, 50, -40, -52, -99, -96, 52, 259,
+	261, 262, 49, 68, -38, -146, 106, 105, -176, 296,
+	150, 284, -177, -178, -118, 56, 57, -154, -156, -159,
+	-157, -158, -162, -163, -179, -160, 127, 319, 290, 125,
+	129, 130, 134, -172, -161, 120, 135, 65, 71, -210,
+	127, 27, 49, 238, 244, 125, 135, 134, 319, 63,
+	128, 302, 304, 21, -214, -149, 321, 234, -147, 241,
+	-143, 51, -143, -143, 206, -143, -143, -143, -143, -143,
+	-145, 208, -145, -145, -145, -145, 51, 51, -143, -143,
+	-143, -143, -143, -151, 51, 190, -151, -151, -152, 51,
+	-152, 49, 50, -155, 21, 21, -192, 296, -193, 54,
+	-129, 22, -129, -52, -112, 291, 117, 114, 115, -189,
+	113, 238, 208, 63, 27, 15, 277, 150, 301, 54,
+	145, -52, -52, -52, -129, -107, 11, 90, 86, 109,
+	86, 35, -38, -38, -124, -85, -88, -102, 18, 11,
+	31, 31, -35, 65, 66, 67, 109, -214, -69, -62,
+	-62, -62, -34, 140, -34, 70, -215, -215, -35, 52,
+	-38, -215, -215, -215, 52, 50, 21, 52, 11, 52,
+	11, -215, -35, -80, -78, 77, -38, -215, -215, -215,
+	-215, -215, -60, 28, 31, -2, -214, -214, -56, 52,
+	12, 79, -45, -44, 49, 50, -46, 49, -44, 39,
+	39, 120, 120, 120, -92, -117, -56, -40, -56, -100,
+	-101, 263, 260, 266, 54, 52, 51, 151, -214, -178,
+	79, -186, 50, -204, 285, 71, -169, -117, -214, -166,
+	50, 135, -172, -172, 54, -172, 54, 54, 49, 65,
+	-117, -214, 54, 56, 57, 58, 65, -144, 64, -58,
+	235, 267, 270, 269, 9, 135, 135, -214, 56, -123,
+	-206, 303, 151, 51, -214, -38, 322, -148, 242, 57,
+	-145, -145, -143, -145, -145, -145, -146, 28, -146, -146,
+	-146, -146, -153, 56, -153, -150, 296, 297, -150, 57,
+	-151, 57, -52, -117, -2, -2, -191, -190, -118, -196,
+	21, -128, 49, -121, 125, 127, -218, 152, 126, 131,
+	130, 54, 129, 150, -195, 152, 126, 127, 131, 130,
+	54, 120, 135, 125, 129, 150, 134, -113, -114, 122,
+	21, 120, 135, 150, 117, -129, -109, 87, 12, -123,
+	-123, 56, 65, -118, 56, 65, 36, 109, -52, -39,
+	11, 97, -118, -36, -34, 70, -62, -62, -215, -37,
+	-133, 106, 204, 139, 199, 192, 223, 224, 210, 240,
+	196, 241, -130, -133, -62, -62, -62, -62, 293, -83,
+	78, -38, 76, -93, 49, -94, -71, -73, -72, -214,
+	-2, -89, -117, -92, -83, -98, -38, -38, -38, 51,
+	-38, -214, -214, -214, -215, 52, -83, -56, 260, 264,
+	265, -177, -185, -183, 50, 132, 63, 296, 165, 166,
+	167, 168, 169, 170, 171, 54, -117, -47, -178, 51,
+	54, -205, 286, 285, 131, 125, 319, 290, 134, -38,
+	51, 54, 54, 54, 54, -208, 134, 316, 317, 10,
+	9, -210, 319, 27, -38, -214, -198, 318, -214, -143,
+	51, -143, 51, -144, -38, 51, 21, 27, 57, -38,
+	-215, 53, -146, -146, -145, -146, -146, -146, 54, 106,
+	53, 52, 53, 196, 196, 52, 53, 52, 51, 50,
+	49, 52, 79, -197, 18, 160, 161, -117, -217, 120,
+	135, 135, -117, -128, -117, -128, -117, -52, -128, -117,
+	127, -159, 56, -38, -56, -40, -215, -62, -215, -143,
+	-143, -143, -152, -143, 183, -143, 183, -215, -215, -215,
+	52, 18, -215, 52, 18, -214, -33, 282, -38, 26,
+	-93, 52, -215, -215, -215, 52, 109, -215, -87, -90,
+	-117, 135, -90, -90, -90, -126, -117, -87, 53, 52,
+	54, -173, 79, 56, 298, 79, 79, 79, 79, 79,
+	79, 79, 79, -214, -215, -182, -180, -181, -117, -66,
+	135, -214, -123, 287, 288, 135, 135, -214, -166, -209,
+	316, 317, -215, -165, -164, -117, -208, -168, 156, 157,
+	28, 158, -168, -214, -215, -36, -135, 236, -215, 53,
+	53, -215, -90, 304, -214, 52, -215, -199, 305, 306,
+	307, -146, -145, 56, -145, 243, 243, 57, 57, -182,
+	-117, -52, -190, -178, 122, 19, 6, 8, 9, 10,
+	-117, -208, -128, 125, 51, 25, -117, -81, 13, -145,
+	54, -62, -62, -62, -62, -62, -215, 56, 135, -73,
+	31, -2, -214, -117, -117, 52, 53, -215, -215, -215,
+	-55, -176, -183, 57, -117, -211, 49, 68, 57, -211,
+	-211, -211, -211, -211, 57, -211, -58, 58, 56, -70,
+	53, 52, 105, -143, -38, 109, -169, -117, -208, -38,
+	51, 51, -203, 158, 53, 52, 296, 49, 65, 27,
+	159, 49, -38, -215, -149, -203, 53, 51, -38, 57,
+	-199, -146, -146, 53, 53, 53, 51, 51, -170, -117,
+	51, 51, 135, -90, -214, 125, -82, 14, 151, -215,
+	-215, -215, -215, -32, 90, 296, 9, -71, -2, 109,
+	-117, -215, -171, 289, -180, -130, -215, -118, 51, -186,
+	-215, -165, -182, 283, -56, -164, -167, -59, 70, 9,
+	10, -215, -207, -215, 53, -171, -182, -182, -200, 52,
+	50, -182, -182, -208, 53, -187, -188, 150, 135, -38,
+	-70, -215, 294, 46, 299, -94, -215, -117, -184, 296,
+	-183, 51, -170, 51, -203, 53, 53, -168, -168, -203,
+	53, 173, 310, 311, 144, 312, 158, 313, 314, -199,
+	-56, 53, 53, -201, 296, -117, -38, 53, 53, 51,
+	-194, -215, 52, -117, 51, 36, 295, 300, -183, 51,
+	-170, 53, -182, -56, 296, 296, 57, 151, 57, 57,
+	57, 57, 311, 144, 313, 151, -171, 319, -182, -192,
+	-188, 31, -182, 36, -185, 53, 128, 53, 51, 57,
+	57, 315, -123, -56, -38, 53, 146, 53, 296, 53,
+	-52, 296, -184, -185, 109, 147, 299, 51, 51, 53,
+	-118, -214, 300, -170, -185, -62, 144, 53, 53, -215,
+	-215,
 }
 
 var yyDef = [...]int{
 	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 650, 0, 408, 408, 408, 408, 408, 408, 0,
-	-2, 704, 0, 0, 0, 0, -2, 398, 399, 0,
-	401, 402, 964, 964, 964, 964, 964, 0, 33, 34,
-	962, 1, 3, 658, 0, 0, 412, 415, 410, 0,
-	704, 0, 0, 0, 60, 0, 0, 0, 0, 702,
-	702, 0, 702, 83, 0, 0, 0, 705, 0, 700,
-	0, 700, 700, 700, 0, 357, 480, 725, 726, 831,
-	832, 833, 834, 835, 836, 837, 838, 839, 840, 841,
-	842, 843, 844, 845, 846, 847, 848, 849, 850, 851,
-	852, 853, 854, 855, 856, 857, 858, 859, 860, 861,
-	862, 863, 864, 865, 866, 867, 868, 869, 870, 871,
-	872, 873, 874, 875, 876, 877, 878, 879, 880, 881,
-	882, 883, 884, 885, 886, 887, 888, 889, 890, 891,
-	892, 893, 894, 895, 896, 897, 898, 899, 900, 901,
-	902, 903, 904, 905, 906, 907, 908, 909, 910, 911,
-	912, 913, 914, 915, 916, 917, 918, 919, 920, 921,
-	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
-	932, 933, 934, 935, 936, 937, 938, 939, 940, 941,
-	942, 943, 944, 945, 946, 947, 948, 949, 950, 951,
-	952, 953, 954, 955, 956, 957, 958, 959, 960, 961,
-	0, 0, 0, 0, 965, 965, 965, 965, 0, 965,
-	386, 375, 377, 378, 379, 380, 965, 395, 396, 385,
-	397, 400, 403, 404, 405, 406, 407, 27, 662, 0,
-	0, 650, 29, 0, 408, 413, 414, 418, 416, 417,
-	409, 0, 426, 430, 0, 488, 0, 493, 495, -2,
-	-2, 0, 530, 531, 532, 533, 534, 0, 0, 0,
-	0, 0, 0, 0, 558, 559, 560, 561, 635, 636,
-	637, 638, 639, 640, 641, 642, 497, 498, 632, 682,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 623,
-	0, 589, 589, 589, 589, 589, 589, 589, 589, 0,
-	0, 0, 0, 0, 0, 0, 437, 439, 440, 441,
-	461, 0, 463, 0, 0, 41, 45, 0, 931, 686,
-	-2, -2, 0, 0, 723, 724, -2, 843, -2, 721,
-	722, 729, 730, 731, 732, 733, 734, 735, 736, 737,
-	738, 739, 740, 741, 742, 743, 744, 745, 746, 747,
-	748, 749, 750, 751, 752, 753, 754, 755, 756, 757,
-	758, 759, 760, 761, 762, 763, 764, 765, 766, 767,
-	768, 769, 770, 771, 772, 773, 774, 775, 776, 777,
-	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
-	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
-	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
-	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
-	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
-	828, 829, 830, 0, 96, 0, 0, 0, 84, 0,
-	0, 0, 0, 0, 93, 0, 965, 0, 0, 0,
-	0, 0, 0, 0, 356, 0, 358, 965, 965, 965,
-	965, 965, 965, 965, 965, 367, 966, 967, 368, 369,
-	370, 965, 965, 372, 0, 387, 0, 381, 28, 963,
-	22, 0, 0, 659, 0, 651, 652, 655, 658, 27,
-	415, 0, 420, 419, 411, 0, 427, 0, 0, 0,
-	431, 0, 433, 434, 0, 491, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 515, 516, 517, 518,
-	519, 520, 521, 494, 0, 508, 0, 0, 0, 550,
-	551, 552, 553, 554, 555, 0, 422, 27, 0, 528,
-	0, 0, 0, 0, 0, 0, 0, 0, 418, 0,
-	624, 0, 580, 0, 581, 582, 583, 584, 585, 586,
-	587, 588, 616, 0, 618, 619, 620, 621, 622, 165,
-	166, 167, 168, 169, 170, 171, 172, 173, 174, 191,
-	192, 0, 422, 0, 0, 43, 0, 479, 0, 0,
-	0, 0, 0, 0, 468, 0, 0, 471, 0, 0,
-	0, 0, 462, 0, 0, 482, 896, 464, 0, 466,
-	467, -2, 0, 0, 0, 39, 40, 0, 46, 931,
-	48, 49, 0, 0, 0, 246, 695, 696, 697, 693,
-	304, 0, 101, 240, 236, 103, 104, 105, 106, 226,
-	164, 226, 226, 226, 226, 226, 198, 226, 226, 243,
-	243, 243, 243, 243, 207, 208, 209, 210, 211, 212,
-	213, 0, 0, 183, 226, 226, 226, 187, 226, 189,
-	190, 216, 217, 218, 219, 220, 221, 222, 223, 228,
-	228, 228, 230, 230, 181, 182, 0, 0, 87, 0,
-	965, 0, 965, 0, 94, 0, 0, 323, 0, 351,
-	701, 0, 965, 354, 355, 481, 727, 728, 359, 360,
-	361, 362, 363, 364, 365, 366, 371, 374, 388, 382,
-	383, 376, 663, 0, 0, 0, 0, 0, 654, 656,
-	657, 662, 30, 418, 0, 643, 0, 0, 0, 421,
-	25, 489, 490, 492, 509, 0, 511, 513, 432, 428,
-	0, 633, -2, 499, 500, 524, 525, 526, 0, 0,
-	0, 0, 522, 504, 0, 535, 536, 537, 538, 539,
-	540, 541, 542, 543, 544, 545, 546, 549, 600, 601,
-	557, 0, 547, 548, 556, 0, 0, 423, 424, 527,
-	0, 681, 27, 0, 0, 0, 0, 0, 632, 0,
-	0, 0, 0, 630, 627, 0, 0, 590, 617, 0,
-	0, 0, 0, 0, 0, 478, 486, 683, 0, 438,
-	457, 459, 0, 454, 469, 470, 472, 0, 474, 0,
-	476, 477, 442, 443, 444, 0, 0, 0, 0, 465,
-	486, 0, 486, 42, 687, 47, 0, 0, 52, 53,
-	688, 689, 690, 691, 247, 0, 95, 305, 307, 310,
-	311, 312, 97, 98, 99, 100, 0, 285, 300, 0,
-	0, 0, 0, 0, 279, 280, 108, 0, 110, 0,
-	0, 113, 114, 0, 116, 118, 0, 0, 0, 0,
-	0, 0, 107, 0, 242, 238, 237, 163, 0, 243,
-	243, 226, 243, 243, 243, 200, 201, 246, 0, 246,
-	246, 246, 246, 0, 0, 233, 233, 186, 188, 175,
-	0, 228, 177, 178, 179, 0, 180, 0, 0, 0,
-	65, 0, 85, 86, 66, 703, 67, 69, 964, 82,
-	0, 716, 324, 706, 707, 708, 709, 710, 711, 712,
-	713, 714, 715, 0, 0, 350, 965, 353, 391, 0,
-	0, 0, 660, 661, 0, 653, 23, 0, 698, 699,
-	644, 645, 435, 510, 512, 514, 0, 422, 501, 522,
-	505, 0, 502, 0, 0, 496, 562, 0, 0, 529,
-	-2, 565, 566, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 650, 0, 628, 0, 0, 579, 591, 592,
-	593, 594, 675, 0, 0, -2, 0, 0, 650, 0,
-	0, 0, 451, 458, 0, 0, 452, 0, 453, 473,
-	475, 0, 0, 0, 0, 449, 650, 486, 38, 50,
-	51, 0, 0, 57, 248, 0, 308, 0, 0, 0,
-	0, 301, 271, 0, 0, 274, 0, 276, 297, 109,
-	0, 0, 115, 117, 0, 121, 122, 0, 135, 0,
-	0, 158, 128, 129, 130, 131, 132, 133, 0, 226,
-	226, 155, 241, 102, 239, 0, 246, 246, 243, 246,
-	246, 246, 202, 0, 203, 204, 205, 206, 0, 224,
-	0, 184, 0, 0, 185, 0, 176, 0, 0, 0,
-	-2, 88, 89, 0, 72, 0, 313, 0, 964, 0,
-	338, 339, 340, 341, 342, 343, 344, 964, 0, 325,
-	326, 327, 328, 329, 330, 331, 332, 333, 334, 335,
-	0, 964, 717, 718, 719, 720, 0, 0, 352, 373,
-	0, 0, 389, 390, 664, 0, 24, 486, 0, 429,
-	634, 0, 503, 0, 523, 506, 563, 425, 0, 226,
-	226, 605, 226, 230, 608, 609, 226, 611, 226, 614,
-	0, 0, 0, 0, 633, 0, 0, 0, 625, 578,
-	631, 0, 31, 0, 675, 665, 677, 679, 0, 27,
-	0, 671, 0, 658, 684, 487, 685, 455, 0, 460,
-	0, 0, 0, 463, 0, 658, 37, 54, 55, 56,
-	306, 309, 0, 281, 226, 226, 0, 0, 0, 0,
-	272, 273, 275, 277, 297, 298, 299, 111, 0, 112,
-	0, 0, 0, 136, 0, 127, 0, 0, 151, 0,
-	153, 0, 227, 193, 194, 246, 195, 196, 197, 244,
-	245, 243, 0, 243, 0, 0, 0, 231, 0, 0,
-	0, 0, 0, 0, 0, 0, 70, 71, 0, 336,
-	337, 317, 0, 318, 320, 321, 322, 0, 300, 316,
-	392, 393, 646, 436, 564, 507, 567, 602, 243, 606,
-	607, 610, 612, 613, 615, 569, 568, 570, 0, 0,
-	573, 0, 0, 0, 0, 0, 629, 0, 32, 0,
-	680, -2, 0, 0, 0, 44, 35, 0, 446, 447,
-	0, 0, 0, 482, 450, 36, 251, 0, 283, 284,
-	286, 291, 292, 0, 0, 287, 300, 297, 278, 0,
-	156, 0, 124, 0, 0, 233, 161, 162, 134, 152,
-	154, 199, 246, 225, 246, 234, 235, 0, 0, 0,
-	0, 0, 90, 91, 0, 73, 74, 75, 76, 77,
-	0, 0, 0, 301, 648, 0, 603, 604, 0, 0,
-	0, 0, 595, 577, 626, 0, 678, 0, -2, 0,
-	673, 672, 0, 456, 483, 484, 485, 445, 249, 0,
-	252, 0, 267, 0, 0, 0, 0, 0, 0, 0,
-	0, 282, 0, 293, 294, 0, 0, 301, 0, 0,
-	119, 0, 123, 137, 0, 159, 160, 214, 215, 229,
-	232, 486, 0, 0, 78, 302, 0, 0, 0, 0,
-	26, 0, 0, 571, 572, 574, 575, 0, 0, 0,
-	0, 668, 27, 0, 448, 253, 0, 0, 0, 256,
-	0, 268, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 120, 157, 0, 126, 61, 0,
-	0, 80, 0, 0, 0, 84, 0, 346, 0, 0,
-	649, 647, 576, 0, 0, 0, 676, -2, 674, 0,
-	254, 259, 257, 260, 269, 270, 261, 262, 263, 264,
-	265, 266, 288, 289, 0, 0, 125, 0, 0, 0,
-	0, 0, 0, 148, 0, 486, 62, 68, 0, 303,
-	79, 314, 87, 345, 0, 0, 0, 596, 0, 599,
-	250, 0, 0, 295, 0, 139, 0, 141, 142, 143,
-	144, 145, 146, 147, 0, 63, 0, 319, 347, 0,
-	0, 597, 255, 0, 0, 138, 140, 149, 0, 81,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	150, 0, 598, 0, 296, 0, 0, 290, 348, 349,
+	21, 22, 717, 0, 472, 472, 472, 472, 472, 472,
+	0, -2, 771, 0, 0, 0, 0, -2, 458, 459,
+	0, 461, 462, 0, 1042, 1042, 1042, 1042, 1042, 0,
+	34, 35, 1040, 1, 3, 725, 0, 0, 476, 479,
+	474, 0, 771, 0, 0, 0, 61, 0, 0, 0,
+	0, 0, 0, 769, 769, 0, 769, 86, 0, 0,
+	0, 772, 0, 767, 0, 767, 767, 767, 0, 417,
+	544, 792, 793, 900, 901, 902, 903, 904, 905, 906,
+	907, 908, 909, 910, 911, 912, 913, 914, 915, 916,
+	917, 918, 919, 920, 921, 922, 923, 924, 925, 926,
+	927, 928, 929, 930, 931, 932, 933, 934, 935, 936,
+	937, 938, 939, 940, 941, 942, 943, 944, 945, 946,
+	947, 948, 949, 950, 951, 952, 953, 954, 955, 956,
+	957, 958, 959, 960, 961, 962, 963, 964, 965, 966,
+	967, 968, 969, 970, 971, 972, 973, 974, 975, 976,
+	977, 978, 979, 980, 981, 982, 983, 984, 985, 986,
+	987, 988, 989, 990, 991, 992, 993, 994, 995, 996,
+	997, 998, 999, 1000, 1001, 1002, 1003, 1004, 1005, 1006,
+	1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016,
+	1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
+	1037, 1038, 1039, 0, 0, 0, 0, 1043, 1043, 1043,
+	1043, 0, 1043, 446, 435, 437, 438, 439, 440, 1043,
+	455, 456, 445, 457, 460, 0, 467, 468, 469, 470,
+	471, 28, 729, 0, 0, 717, 30, 0, 472, 477,
+	478, 482, 480, 481, 473, 0, 490, 494, 0, 552,
+	0, 557, 559, -2, -2, 0, 596, 597, 598, 599,
+	600, 0, 0, 0, 0, 0, 0, 0, 624, 625,
+	626, 627, 702, 703, 704, 705, 706, 707, 708, 709,
+	561, 562, 699, 749, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 690, 0, 655, 655, 655, 655, 655,
+	655, 655, 655, 0, 0, 0, 0, 0, 0, 0,
+	501, 503, 504, 505, 525, 0, 527, 0, 0, 42,
+	46, 0, 1009, 753, -2, -2, 0, 0, 790, 791,
+	-2, 912, -2, 788, 789, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 820,
+	821, 822, 823, 824, 825, 826, 827, 828, 829, 830,
+	831, 832, 833, 834, 835, 836, 837, 838, 839, 840,
+	841, 842, 843, 844, 845, 846, 847, 848, 849, 850,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
+	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
+	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
+	891, 892, 893, 894, 895, 896, 897, 898, 899, 0,
+	102, 0, 0, 0, 0, 0, 87, 0, 0, 0,
+	0, 0, 96, 0, 1043, 0, 0, 0, 0, 0,
+	0, 0, 416, 0, 418, 1043, 1043, 1043, 1043, 1043,
+	1043, 1043, 1043, 427, 1044, 1045, 428, 429, 430, 1043,
+	1043, 432, 0, 447, 0, 441, 0, 0, 29, 1041,
+	23, 0, 0, 726, 0, 718, 719, 722, 725, 28,
+	479, 0, 484, 483, 475, 0, 491, 0, 0, 0,
+	495, 0, 497, 498, 0, 555, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 581, 582,
+	583, 584, 585, 586, 587, 558, 0, 574, 0, 0,
+	0, 616, 617, 618, 619, 620, 621, 0, 486, 28,
+	0, 594, 0, 0, 0, 0, 0, 0, 0, 0,
+	482, 0, 691, 0, 646, 0, 647, 648, 649, 650,
+	651, 652, 653, 654, 682, 0, 684, 685, 686, 687,
+	688, 689, 182, 183, 184, 185, 186, 187, 188, 189,
+	190, 191, 209, 210, 0, 486, 0, 0, 44, 0,
+	543, 0, 0, 0, 0, 0, 0, 532, 0, 0,
+	535, 0, 0, 0, 0, 526, 0, 0, 546, 972,
+	528, 0, 530, 531, -2, 0, 0, 0, 40, 41,
+	0, 47, 1009, 49, 50, 0, 0, 0, 264, 762,
+	763, 764, 760, 360, 0, 110, 0, 258, 254, 113,
+	114, 115, 116, 117, 244, 181, 244, 244, 244, 244,
+	244, 216, 244, 244, 261, 261, 261, 261, 261, 225,
+	226, 227, 228, 229, 230, 231, 0, 0, 200, 244,
+	244, 244, 244, 205, 244, 207, 208, 234, 235, 236,
+	237, 238, 239, 240, 241, 246, 246, 246, 248, 248,
+	198, 199, 0, 0, 0, 0, 90, 0, 1043, 0,
+	1043, 0, 97, 0, 0, 383, 0, 411, 768, 0,
+	1043, 414, 415, 545, 794, 795, 419, 420, 421, 422,
+	423, 424, 425, 426, 431, 434, 448, 442, 443, 436,
+	0, 699, 0, 0, 730, 0, 0, 0, 0, 0,
+	721, 723, 724, 729, 31, 482, 0, 710, 0, 0,
+	0, 485, 26, 553, 554, 556, 575, 0, 577, 579,
+	496, 492, 0, 700, -2, 563, 564, 590, 591, 592,
+	0, 0, 0, 0, 588, 588, 569, 571, 0, 601,
+	602, 603, 604, 605, 606, 607, 608, 609, 610, 611,
+	612, 615, 666, 667, 623, 0, 613, 614, 622, 0,
+	0, 487, 488, 593, 0, 748, 28, 0, 0, 0,
+	0, 0, 0, 0, 0, 697, 694, 0, 0, 656,
+	683, 0, 0, 0, 0, 0, 0, 542, 550, 750,
+	0, 502, 521, 523, 0, 518, 533, 534, 536, 0,
+	538, 0, 540, 541, 506, 507, 508, 0, 0, 0,
+	0, 529, 550, 0, 550, 43, 754, 48, 0, 0,
+	53, 54, 755, 756, 757, 758, 265, 0, 98, 1027,
+	972, 940, 361, 363, 366, 367, 368, 103, 104, 105,
+	106, 107, 108, 109, 270, 323, 356, 0, 341, 0,
+	0, 0, 0, 0, 317, 306, 307, 119, 0, 121,
+	0, 0, 0, 126, 127, 0, 129, 131, 0, 0,
+	0, 0, 0, 0, 0, 118, 0, 260, 256, 255,
+	180, 0, 261, 261, 244, 261, 261, 261, 218, 219,
+	264, 0, 264, 264, 264, 264, 0, 0, 251, 251,
+	203, 204, 206, 192, 0, 246, 194, 195, 196, 0,
+	197, 0, 0, 65, 0, 0, 68, 0, 88, 89,
+	69, 770, 70, 72, 1042, 0, 85, 0, 783, 384,
+	773, 774, 775, 776, 777, 778, 779, 780, 781, 782,
+	0, 0, 410, 1043, 413, 451, 0, 0, 0, 0,
+	0, 0, 727, 728, 0, 720, 24, 0, 765, 766,
+	711, 712, 499, 576, 578, 580, 0, 486, 565, 588,
+	570, 0, 566, 0, 568, 0, 560, 628, 0, 0,
+	595, -2, 631, 632, 0, 0, 0, 0, 0, 0,
+	0, 0, 717, 0, 695, 0, 0, 645, 657, 658,
+	659, 660, 742, 0, 0, -2, 0, 0, 717, 0,
+	0, 0, 515, 522, 0, 0, 516, 0, 517, 537,
+	539, 0, 0, 0, 0, 513, 717, 550, 39, 51,
+	52, 0, 0, 58, 266, 0, 0, 0, 0, 364,
+	0, 0, 0, 326, 324, 0, 0, 357, 0, 0,
+	0, 298, 0, 0, 301, 0, 303, 350, 0, 120,
+	0, 0, 0, 177, 147, 148, 149, 150, 151, 152,
+	0, 244, 244, 174, 0, 128, 130, 0, 134, 135,
+	0, 154, 0, 0, 0, 0, 259, 112, 257, 0,
+	264, 264, 261, 264, 264, 264, 220, 0, 221, 222,
+	223, 224, 0, 242, 0, 201, 0, 0, 202, 0,
+	193, 0, 0, 0, -2, -2, 91, 92, 0, 75,
+	0, 369, 0, 0, 402, 0, 1042, 0, 398, 399,
+	400, 401, 403, 404, 1042, 0, 385, 386, 387, 388,
+	389, 390, 391, 392, 393, 394, 395, 0, 1042, 784,
+	785, 786, 787, 0, 0, 412, 433, 0, 0, 449,
+	450, 463, 464, 700, 465, 466, 731, 0, 25, 550,
+	0, 493, 701, 0, 567, 0, 589, 572, 629, 489,
+	0, 244, 244, 671, 244, 248, 674, 675, 244, 677,
+	244, 680, 0, 0, 0, 0, 0, 0, 0, 692,
+	644, 698, 0, 32, 0, 742, 732, 744, 746, 0,
+	28, 0, 738, 0, 725, 751, 551, 752, 519, 0,
+	524, 0, 0, 0, 527, 0, 725, 38, 55, 56,
+	57, 362, 0, 276, 0, 294, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 365, 0,
+	271, 316, 0, 325, 0, 0, 0, 341, 353, 0,
+	0, 342, 299, 300, 302, 304, 350, 351, 352, 0,
+	0, 122, 0, 0, 0, 486, 146, 0, 0, 170,
+	0, 172, 0, 125, 0, 0, 0, 155, 0, 0,
+	142, 245, 211, 212, 264, 213, 214, 215, 262, 263,
+	261, 0, 261, 0, 0, 0, 249, 0, 0, 0,
+	0, 0, 0, 0, 0, 73, 74, 370, 0, 396,
+	397, -2, 0, 377, 0, 378, 380, 381, 382, 0,
+	356, 376, 452, 453, 713, 500, 630, 573, 633, 668,
+	261, 672, 673, 676, 678, 679, 681, 635, 634, 636,
+	0, 0, 639, 0, 0, 0, 0, 0, 696, 0,
+	33, 0, 747, -2, 0, 0, 0, 45, 36, 0,
+	510, 511, 0, 0, 0, 546, 514, 37, 360, 0,
+	278, 0, 295, 280, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 308, 310, 312, 313,
+	244, 0, 0, 327, 328, 356, 350, 0, 0, 0,
+	354, 355, 175, 0, 343, 0, 305, 318, 329, 330,
+	0, 0, 319, 0, 123, 0, 258, 179, 153, 171,
+	173, 175, 0, 137, 0, 0, 142, 111, 143, 144,
+	145, 217, 264, 243, 264, 252, 253, 0, 0, 0,
+	0, 0, 93, 94, 0, 76, 77, 78, 79, 80,
+	0, 0, 375, 0, 0, 0, 357, 715, 0, 669,
+	670, 0, 0, 0, 0, 661, 643, 693, 0, 745,
+	0, -2, 0, 740, 739, 0, 520, 547, 548, 549,
+	509, 99, 277, 279, 281, 282, 296, 297, 283, 284,
+	285, 286, 287, 288, 289, 290, 291, 292, 293, 0,
+	272, 0, 0, 314, 0, 0, 0, 357, 270, 0,
+	0, 0, 338, 0, 550, 0, 0, 0, 331, 332,
+	333, 0, 0, 124, 178, 132, 136, 156, 0, 0,
+	141, 232, 233, 247, 250, 272, 0, 0, 81, 358,
+	0, 0, 350, 0, 0, 0, 27, 0, 0, 637,
+	638, 640, 641, 0, 0, 0, 0, 735, 28, 0,
+	512, 100, 269, 0, 309, 311, 315, 0, 0, 0,
+	175, 0, 0, 176, 340, 344, 345, 346, 347, 0,
+	0, 175, 0, 142, 139, 550, 0, 0, 83, 0,
+	0, 0, 0, 0, 87, 0, 406, 0, 0, 716,
+	714, 642, 0, 0, 0, 743, -2, 741, 267, 0,
+	274, 0, 0, 0, 337, 550, 348, 320, 321, 133,
+	138, 0, 0, 0, 0, 0, 0, 167, 0, 140,
+	62, 272, 63, 71, 0, 359, 82, 371, 373, 0,
+	90, 405, 0, 0, 0, 662, 0, 665, 275, 0,
+	0, 0, 0, 339, 0, 0, 158, 0, 160, 161,
+	162, 163, 164, 165, 166, 0, 550, 0, 0, 379,
+	407, 0, 0, 663, 0, 273, 0, 334, 0, 157,
+	159, 168, 0, 64, 84, 374, 0, 372, 0, 268,
+	0, 0, 336, 0, 0, 0, 0, 0, 0, 349,
+	169, 0, 664, 0, 0, 0, 0, 322, 335, 408,
+	409,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 73, 3, 3, 3, 100, 92, 3,
-	52, 54, 97, 95, 53, 96, 109, 98, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 306,
-	81, 80, 82, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 72, 3, 3, 3, 100, 92, 3,
+	51, 53, 97, 95, 52, 96, 109, 98, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 320,
+	80, 79, 81, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 307, 3, 308, 102, 3, 3, 3, 3, 3,
+	3, 321, 3, 322, 102, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 91, 3, 103,
@@ -2966,10 +3170,10 @@ var yyTok2 = [...]int{
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
-	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 54,
 	55, 56, 57, 58, 59, 60, 61, 62, 63, 64,
-	65, 66, 67, 68, 69, 70, 71, 72, 74, 75,
-	76, 77, 78, 79, 83, 84, 85, 86, 87, 88,
+	65, 66, 67, 68, 69, 70, 71, 73, 74, 75,
+	76, 77, 78, 82, 83, 84, 85, 86, 87, 88,
 	89, 90, 93, 94, 99, 101, 104, 105, 106, 107,
 	108, 110, 111, 112, 113, 114, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
@@ -2997,7 +3201,10 @@ var yyTok3 = [...]int{
 	57615, 290, 57616, 291, 57617, 292, 57618, 293, 57619, 294,
 	57620, 295, 57621, 296, 57622, 297, 57623, 298, 57624, 299,
 	57625, 300, 57626, 301, 57627, 302, 57628, 303, 57629, 304,
-	57630, 305, 0,
+	57630, 305, 57631, 306, 57632, 307, 57633, 308, 57634, 309,
+	57635, 310, 57636, 311, 57637, 312, 57638, 313, 57639, 314,
+	57640, 315, 57641, 316, 57642, 317, 57643, 318, 57644, 319,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3339,29 +3546,29 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:336
+//line sqlparser/parser.y:362
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:341
+//line sqlparser/parser.y:367
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:342
+//line sqlparser/parser.y:368
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:346
+//line sqlparser/parser.y:372
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 22:
+	case 23:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:369
+//line sqlparser/parser.y:396
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3369,57 +3576,57 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:377
+//line sqlparser/parser.y:404
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:381
+//line sqlparser/parser.y:408
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 25:
+	case 26:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:387
+//line sqlparser/parser.y:414
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 26:
+	case 27:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sqlparser/parser.y:394
+//line sqlparser/parser.y:421
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 27:
+	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:400
+//line sqlparser/parser.y:427
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:404
+//line sqlparser/parser.y:431
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:410
+//line sqlparser/parser.y:437
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:414
+//line sqlparser/parser.y:441
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:421
+//line sqlparser/parser.y:448
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3431,9 +3638,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:433
+//line sqlparser/parser.y:460
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3443,193 +3650,194 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:445
+//line sqlparser/parser.y:472
 		{
 			yyVAL.str = InsertStr
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:449
+//line sqlparser/parser.y:476
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:455
+//line sqlparser/parser.y:482
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:461
+//line sqlparser/parser.y:488
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:465
+//line sqlparser/parser.y:492
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:469
+//line sqlparser/parser.y:496
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:474
+//line sqlparser/parser.y:501
 		{
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:475
+//line sqlparser/parser.y:502
 		{
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:479
+//line sqlparser/parser.y:506
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:483
+//line sqlparser/parser.y:510
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:488
+//line sqlparser/parser.y:515
 		{
 			yyVAL.partitions = nil
 		}
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:492
+//line sqlparser/parser.y:519
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:498
+//line sqlparser/parser.y:525
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:502
+//line sqlparser/parser.y:529
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:506
+//line sqlparser/parser.y:533
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:510
+//line sqlparser/parser.y:537
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:516
+//line sqlparser/parser.y:543
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:520
+//line sqlparser/parser.y:547
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:526
+//line sqlparser/parser.y:553
 		{
 			yyVAL.setExpr = yyDollar[3].setExpr
 		}
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:530
+//line sqlparser/parser.y:557
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
 		}
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:534
+//line sqlparser/parser.y:561
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
 		}
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:540
+//line sqlparser/parser.y:567
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
 		}
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:544
+//line sqlparser/parser.y:571
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
 		}
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:548
+//line sqlparser/parser.y:575
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
 		}
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:552
+//line sqlparser/parser.y:579
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
 		}
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:558
+//line sqlparser/parser.y:585
 		{
 			yyVAL.str = SessionStr
 		}
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:562
+//line sqlparser/parser.y:589
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:568
+//line sqlparser/parser.y:595
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 61:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sqlparser/parser.y:573
+	case 62:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sqlparser/parser.y:600
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndexStr,
 				Table:   yyDollar[6].tableName,
 				NewName: yyDollar[6].tableName,
 				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   NewColIdent(""),
-					Unique: bool(yyDollar[2].boolVal),
-					Where:  NewWhere(WhereStr, yyDollar[10].expr),
+					Name:    yyDollar[4].colIdent,
+					Type:    NewColIdent(""),
+					Unique:  bool(yyDollar[2].boolVal),
+					Include: yyDollar[10].colIdents,
+					Where:   NewWhere(WhereStr, yyDollar[11].expr),
 				},
 				IndexCols: yyDollar[8].indexColumns,
 			}
 		}
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sqlparser/parser.y:589
+//line sqlparser/parser.y:617
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndexStr,
@@ -3643,26 +3851,46 @@ yydefault:
 				IndexCols: yyDollar[10].indexColumns,
 			}
 		}
-	case 63:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line sqlparser/parser.y:604
+	case 64:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sqlparser/parser.y:632
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndexStr,
 				Table:   yyDollar[6].tableName,
 				NewName: yyDollar[6].tableName,
 				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   yyDollar[8].colIdent,
-					Unique: bool(yyDollar[2].boolVal),
-					Where:  NewWhere(WhereStr, yyDollar[12].expr),
+					Name:    yyDollar[4].colIdent,
+					Type:    yyDollar[8].colIdent,
+					Unique:  bool(yyDollar[2].boolVal),
+					Include: yyDollar[12].colIdents,
+					Where:   NewWhere(WhereStr, yyDollar[13].expr),
 				},
 				IndexCols: yyDollar[10].indexColumns,
 			}
 		}
-	case 64:
+	case 65:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sqlparser/parser.y:649
+		{
+			// TYPE and DOMAIN are not keywords to keep them available as column names
+			switch NewColIdent(string(yyDollar[2].bytes)).Lowered() {
+			case "type":
+				if yyDollar[5].columnType.Type != "enum" {
+					yylex.Error("syntax error")
+					return 1
+				}
+				yyVAL.statement = &DDL{Action: CreateTypeStr, Type: &Type{Name: yyDollar[3].tableName, EnumValues: yyDollar[5].columnType.EnumValues}}
+			case "domain":
+				yyVAL.statement = &DDL{Action: CreateDomainStr, Domain: &Domain{Name: yyDollar[3].tableName, Type: yyDollar[5].columnType}}
+			default:
+				yylex.Error("syntax error")
+				return 1
+			}
+		}
+	case 66:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:619
+//line sqlparser/parser.y:666
 		{
 			yyVAL.statement = &DDL{Action: CreateViewStr, View: &View{
 				Action:     CreateViewStr,
@@ -3670,9 +3898,20 @@ yydefault:
 				Definition: yyDollar[6].selStmt,
 			}}
 		}
-	case 65:
+	case 67:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:675
+		{
+			yyVAL.statement = &DDL{Action: CreateViewStr, View: &View{
+				Action:       CreateViewStr,
+				Name:         yyDollar[4].tableName.ToViewName(),
+				Definition:   yyDollar[6].selStmt,
+				Materialized: true,
+			}}
+		}
+	case 68:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:627
+//line sqlparser/parser.y:684
 		{
 			yyVAL.statement = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
 				Name:   yyDollar[3].colIdent,
@@ -3680,21 +3919,21 @@ yydefault:
 				Params: yyDollar[5].vindexParams,
 			}}
 		}
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:635
+//line sqlparser/parser.y:692
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:639
+//line sqlparser/parser.y:696
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sqlparser/parser.y:643
+//line sqlparser/parser.y:700
 		{
 			yyVAL.statement = &DDL{Action: CreatePolicyStr, Table: yyDollar[5].tableName, Policy: &Policy{
 				Name:       yyDollar[3].colIdent,
@@ -3705,220 +3944,276 @@ yydefault:
 				WithCheck:  NewWhere(WhereStr, yyDollar[11].expr),
 			}}
 		}
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:655
+//line sqlparser/parser.y:712
 		{
 			yyVAL.bytes = nil
 		}
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:659
+//line sqlparser/parser.y:716
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:663
+//line sqlparser/parser.y:720
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:668
+//line sqlparser/parser.y:725
 		{
 			yyVAL.bytes = nil
 		}
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:672
+//line sqlparser/parser.y:729
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:676
+//line sqlparser/parser.y:733
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:680
+//line sqlparser/parser.y:737
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:684
+//line sqlparser/parser.y:741
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:688
+//line sqlparser/parser.y:745
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:693
+//line sqlparser/parser.y:750
 		{
 			yyVAL.expr = nil
 		}
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:697
+//line sqlparser/parser.y:754
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:702
+//line sqlparser/parser.y:759
 		{
 			yyVAL.expr = nil
 		}
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:706
+//line sqlparser/parser.y:763
 		{
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:711
+//line sqlparser/parser.y:768
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:715
+//line sqlparser/parser.y:772
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:720
+//line sqlparser/parser.y:777
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:724
+//line sqlparser/parser.y:781
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:730
+//line sqlparser/parser.y:787
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:735
+//line sqlparser/parser.y:792
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:740
+//line sqlparser/parser.y:797
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:746
+//line sqlparser/parser.y:803
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:751
+//line sqlparser/parser.y:808
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:757
+//line sqlparser/parser.y:814
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:762
+//line sqlparser/parser.y:819
 		{
 			yyVAL.bytes = nil
 		}
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:766
+//line sqlparser/parser.y:823
 		{
 			yyVAL.bytes = nil
 		}
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:772
+//line sqlparser/parser.y:829
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:779
+//line sqlparser/parser.y:836
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 96:
+	case 99:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sqlparser/parser.y:842
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.StorageParameters = yyDollar[6].indexOptions
+			yyVAL.TableSpec.Options = yyDollar[8].str
+		}
+	case 100:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sqlparser/parser.y:849
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.PartitionBy = &PartitionBy{Type: yyDollar[6].colIdent.Lowered(), Exprs: yyDollar[8].exprs}
+		}
+	case 101:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sqlparser/parser.y:855
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Inherits = yyDollar[6].tableNames
+		}
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:786
+//line sqlparser/parser.y:862
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 97:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:791
+//line sqlparser/parser.y:867
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:795
+//line sqlparser/parser.y:871
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:799
+//line sqlparser/parser.y:875
 		{
 			yyVAL.TableSpec.AddForeignKey(yyDollar[3].foreignKeyDefinition)
 		}
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:803
+//line sqlparser/parser.y:879
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 101:
+	case 107:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:883
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 108:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:887
+		{
+			yyVAL.TableSpec.AddCheck(yyDollar[3].checkDefinition)
+		}
+	case 109:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:891
+		{
+			yyVAL.TableSpec.AddExclusion(yyDollar[3].exclusionDefinition)
+		}
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:809
+//line sqlparser/parser.y:897
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 102:
+	case 111:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:902
+		{
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: ColumnType{Generated: &GeneratedColumn{Expr: yyDollar[4].expr, Type: string(yyDollar[6].bytes)}}}
+		}
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:814
+//line sqlparser/parser.y:907
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 107:
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:918
+		{
+			if yylex.(*Tokenizer).mode != ParserModePostgres {
+				yylex.Error("syntax error")
+				return 1
+			}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:825
+//line sqlparser/parser.y:928
 		{
 			yyDollar[1].columnType.NotNull = nil
 			yyDollar[1].columnType.Default = nil
@@ -3930,1288 +4225,1571 @@ yydefault:
 			yyDollar[1].columnType.Array = yyDollar[2].boolVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 108:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:837
+//line sqlparser/parser.y:940
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(false)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 109:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:842
+//line sqlparser/parser.y:945
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 110:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:847
+//line sqlparser/parser.y:950
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{Value: yyDollar[2].optVal}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 111:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:852
+//line sqlparser/parser.y:955
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{ConstraintName: yyDollar[3].colIdent, Value: yyDollar[4].optVal}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 112:
+	case 123:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sqlparser/parser.y:960
+		{
+			yyDollar[1].columnType.Default = &DefaultDefinition{Expr: yyDollar[4].expr}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 124:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:966
+		{
+			yyDollar[1].columnType.Default = &DefaultDefinition{Expr: &FuncExpr{Name: NewColIdent(string(yyDollar[3].bytes)), Exprs: yyDollar[5].selectExprs}}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:857
+//line sqlparser/parser.y:971
 		{
 			yyDollar[1].columnType.OnUpdate = yyDollar[4].optVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 113:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:862
+//line sqlparser/parser.y:976
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 114:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:867
+//line sqlparser/parser.y:981
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 115:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:872
+//line sqlparser/parser.y:986
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyPrimary
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 116:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:877
+//line sqlparser/parser.y:991
 		{
 			yyDollar[1].columnType.KeyOpt = colKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 117:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:882
+//line sqlparser/parser.y:996
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUniqueKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 118:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:887
+//line sqlparser/parser.y:1001
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUnique
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 119:
+	case 132:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:892
+//line sqlparser/parser.y:1006
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{Where: *NewWhere(WhereStr, yyDollar[4].expr)}
+			yyDollar[1].columnType.Checks = append(yyDollar[1].columnType.Checks, yyDollar[1].columnType.Check)
 			yyDollar[1].columnType.CheckNoInherit = yyDollar[6].boolVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 120:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:898
+	case 133:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sqlparser/parser.y:1013
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{Where: *NewWhere(WhereStr, yyDollar[6].expr), ConstraintName: yyDollar[3].colIdent}
+			yyDollar[1].columnType.Checks = append(yyDollar[1].columnType.Checks, yyDollar[1].columnType.Check)
+			yyDollar[1].columnType.CheckNoInherit = yyDollar[8].boolVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 121:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:903
+//line sqlparser/parser.y:1020
 		{
-			yyDollar[1].columnType.Comment = NewStrVal(yyDollar[3].bytes)
+			yyDollar[1].columnType.Comment = newStringVal(yylex, yyDollar[3].bytes)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 122:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:908
+//line sqlparser/parser.y:1025
 		{
 			yyDollar[1].columnType.References = yyDollar[3].tableIdent.v
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 123:
+	case 136:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:913
+//line sqlparser/parser.y:1030
 		{
 			yyDollar[1].columnType.References = yyDollar[3].tableIdent.v
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 124:
+	case 137:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:919
+//line sqlparser/parser.y:1036
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 125:
+	case 138:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:925
+//line sqlparser/parser.y:1042
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str, Sequence: yyDollar[7].sequence}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 126:
+	case 139:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:931
+//line sqlparser/parser.y:1048
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Sequence: &Sequence{StartWith: NewIntVal(yyDollar[4].bytes), IncrementBy: NewIntVal(yyDollar[6].bytes)}}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 127:
+	case 140:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sqlparser/parser.y:1053
+		{
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, Type: string(yyDollar[8].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 141:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:1058
+		{
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, Type: string(yyDollar[6].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 142:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1064
+		{
+			yyVAL.bytes = nil
+		}
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:938
+//line sqlparser/parser.y:1073
 		{
-			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
+			yyVAL.optVal = newStringVal(yylex, yyDollar[2].bytes)
 		}
-	case 128:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:942
+//line sqlparser/parser.y:1077
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 129:
+	case 148:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:946
+//line sqlparser/parser.y:1081
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 130:
+	case 149:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:950
+//line sqlparser/parser.y:1085
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 131:
+	case 150:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:954
+//line sqlparser/parser.y:1089
 		{
 			yyVAL.optVal = yyDollar[2].optVal
 		}
-	case 132:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:958
+//line sqlparser/parser.y:1093
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
 		}
-	case 133:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:962
+//line sqlparser/parser.y:1097
 		{
 			yyVAL.optVal = NewBoolSQLVal(bool(yyDollar[2].boolVal))
 		}
-	case 134:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:966
+//line sqlparser/parser.y:1101
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[2].bytes)
 		}
-	case 135:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:972
+//line sqlparser/parser.y:1107
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 136:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:976
+//line sqlparser/parser.y:1111
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 137:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:981
+//line sqlparser/parser.y:1116
 		{
 			yyVAL.sequence = &Sequence{}
 		}
-	case 138:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:985
+//line sqlparser/parser.y:1120
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 139:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:990
+//line sqlparser/parser.y:1125
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 140:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:995
+//line sqlparser/parser.y:1130
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 141:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1000
+//line sqlparser/parser.y:1135
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 142:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1005
+//line sqlparser/parser.y:1140
 		{
 			yyDollar[1].sequence.MinValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 143:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1010
+//line sqlparser/parser.y:1145
 		{
 			yyDollar[1].sequence.MaxValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 144:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1015
+//line sqlparser/parser.y:1150
 		{
 			yyDollar[1].sequence.Cache = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 145:
+	case 164:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1020
+//line sqlparser/parser.y:1155
 		{
 			yyDollar[1].sequence.NoMinValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 146:
+	case 165:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1025
+//line sqlparser/parser.y:1160
 		{
 			yyDollar[1].sequence.NoMaxValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 147:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1030
+//line sqlparser/parser.y:1165
 		{
 			yyDollar[1].sequence.NoCycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 148:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1035
+//line sqlparser/parser.y:1170
 		{
 			yyDollar[1].sequence.Cycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 149:
+	case 168:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1040
+//line sqlparser/parser.y:1175
 		{
 			yyDollar[1].sequence.OwnedBy = "NONE"
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 150:
+	case 169:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:1045
+//line sqlparser/parser.y:1180
 		{
 			yyDollar[1].sequence.OwnedBy = string(yyDollar[4].tableIdent.v) + "." + string(yyDollar[6].colIdent.val)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 151:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1052
+//line sqlparser/parser.y:1187
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
-	case 152:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1056
+//line sqlparser/parser.y:1191
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 153:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1060
+//line sqlparser/parser.y:1195
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
-	case 154:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1064
+//line sqlparser/parser.y:1199
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 155:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1068
+//line sqlparser/parser.y:1203
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 156:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1073
+//line sqlparser/parser.y:1208
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 157:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1077
+//line sqlparser/parser.y:1212
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 158:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1082
+//line sqlparser/parser.y:1217
 		{
 			yyVAL.bytes = nil
 		}
-	case 163:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1092
+//line sqlparser/parser.y:1225
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 164:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1097
+//line sqlparser/parser.y:1230
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 165:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1103
+//line sqlparser/parser.y:1236
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 166:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1107
+//line sqlparser/parser.y:1240
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 167:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1111
+//line sqlparser/parser.y:1244
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 168:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1115
+//line sqlparser/parser.y:1248
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 169:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1119
+//line sqlparser/parser.y:1252
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 170:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1123
+//line sqlparser/parser.y:1256
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 171:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1127
+//line sqlparser/parser.y:1260
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 172:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1131
+//line sqlparser/parser.y:1264
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 173:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1135
+//line sqlparser/parser.y:1268
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 174:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1139
+//line sqlparser/parser.y:1272
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 175:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1145
+//line sqlparser/parser.y:1278
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 176:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1151
+//line sqlparser/parser.y:1284
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
 			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
 		}
-	case 177:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1157
+//line sqlparser/parser.y:1290
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 178:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1163
+//line sqlparser/parser.y:1296
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 179:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1169
+//line sqlparser/parser.y:1302
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 180:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1175
+//line sqlparser/parser.y:1308
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 181:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1181
+//line sqlparser/parser.y:1314
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 182:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1185
+//line sqlparser/parser.y:1318
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 183:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1191
+//line sqlparser/parser.y:1324
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 184:
+	case 201:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1195
+//line sqlparser/parser.y:1328
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
-	case 185:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1199
+//line sqlparser/parser.y:1332
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
-	case 186:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1203
+//line sqlparser/parser.y:1336
+		{
+			yyVAL.columnType = ColumnType{Type: "timestamp", Length: yyDollar[2].optVal, Timezone: BoolVal(true)}
+		}
+	case 204:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1340
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 187:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1207
+//line sqlparser/parser.y:1344
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 188:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1211
+//line sqlparser/parser.y:1348
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 189:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1215
+//line sqlparser/parser.y:1352
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 190:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1219
+//line sqlparser/parser.y:1356
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 191:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1225
+//line sqlparser/parser.y:1362
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 192:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1229
+//line sqlparser/parser.y:1366
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 193:
+	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1235
+//line sqlparser/parser.y:1372
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 194:
+	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1239
+//line sqlparser/parser.y:1376
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 195:
+	case 213:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1243
+//line sqlparser/parser.y:1380
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 196:
+	case 214:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1247
+//line sqlparser/parser.y:1384
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 197:
+	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1251
+//line sqlparser/parser.y:1388
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 198:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1255
+//line sqlparser/parser.y:1392
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 199:
+	case 217:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1259
+//line sqlparser/parser.y:1396
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].optVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 200:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1263
+//line sqlparser/parser.y:1400
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 201:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1267
+//line sqlparser/parser.y:1404
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 202:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1271
+//line sqlparser/parser.y:1408
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 203:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1275
+//line sqlparser/parser.y:1412
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 204:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1279
+//line sqlparser/parser.y:1416
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 205:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1283
+//line sqlparser/parser.y:1420
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 206:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1287
+//line sqlparser/parser.y:1424
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 207:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1291
+//line sqlparser/parser.y:1428
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 208:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1295
+//line sqlparser/parser.y:1432
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 209:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1299
+//line sqlparser/parser.y:1436
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 210:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1303
+//line sqlparser/parser.y:1440
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 211:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1307
+//line sqlparser/parser.y:1444
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 212:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1311
+//line sqlparser/parser.y:1448
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 213:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1315
+//line sqlparser/parser.y:1452
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 214:
+	case 232:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:1319
+//line sqlparser/parser.y:1456
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 215:
+	case 233:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:1324
+//line sqlparser/parser.y:1461
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 216:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1330
+//line sqlparser/parser.y:1467
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 217:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1334
+//line sqlparser/parser.y:1471
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 218:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1338
+//line sqlparser/parser.y:1475
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 219:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1342
+//line sqlparser/parser.y:1479
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 220:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1346
+//line sqlparser/parser.y:1483
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 221:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1350
+//line sqlparser/parser.y:1487
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 222:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1354
+//line sqlparser/parser.y:1491
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 223:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1358
+//line sqlparser/parser.y:1495
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 224:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1364
+//line sqlparser/parser.y:1501
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 225:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1369
+//line sqlparser/parser.y:1506
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 226:
+	case 244:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1374
+//line sqlparser/parser.y:1511
 		{
 			yyVAL.optVal = nil
 		}
-	case 227:
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1378
+//line sqlparser/parser.y:1515
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 228:
+	case 246:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1383
+//line sqlparser/parser.y:1520
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 229:
+	case 247:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1387
+//line sqlparser/parser.y:1524
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 230:
+	case 248:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1395
+//line sqlparser/parser.y:1532
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 231:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1399
+//line sqlparser/parser.y:1536
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 232:
+	case 250:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1405
+//line sqlparser/parser.y:1542
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 233:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1413
+//line sqlparser/parser.y:1550
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 234:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1417
+//line sqlparser/parser.y:1554
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 235:
+	case 253:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1421
+//line sqlparser/parser.y:1558
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 236:
+	case 254:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1426
+//line sqlparser/parser.y:1563
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 237:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1430
+//line sqlparser/parser.y:1567
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 238:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1435
+//line sqlparser/parser.y:1572
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 239:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1439
+//line sqlparser/parser.y:1576
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 240:
+	case 258:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1444
+//line sqlparser/parser.y:1581
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 241:
+	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1448
+//line sqlparser/parser.y:1585
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 242:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1452
+//line sqlparser/parser.y:1589
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 243:
+	case 261:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1457
+//line sqlparser/parser.y:1594
 		{
 			yyVAL.str = ""
 		}
-	case 244:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1461
+//line sqlparser/parser.y:1598
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 245:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1465
+//line sqlparser/parser.y:1602
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 246:
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1470
+//line sqlparser/parser.y:1607
 		{
 			yyVAL.str = ""
 		}
-	case 247:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1474
+//line sqlparser/parser.y:1611
 		{
 			yyVAL.str = string(yyDollar[1].bytes) // Set pseudo collation "binary" for BINARY attribute (deprecated in future MySQL versions)
 		}
-	case 248:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1478
+//line sqlparser/parser.y:1615
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 249:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1484
+	case 267:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sqlparser/parser.y:1621
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[4].indexColumns, Include: yyDollar[6].colIdents, Options: append(yyDollar[2].indexOptions, yyDollar[7].indexOptions...)}
 		}
-	case 250:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:1488
+	case 268:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sqlparser/parser.y:1625
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[7].indexOptions}
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[4].indexColumns, Include: yyDollar[6].colIdents, Options: append(yyDollar[2].indexOptions, yyDollar[9].indexOptions...)}
 		}
-	case 251:
+	case 269:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:1629
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[4].indexColumns, Include: yyDollar[6].colIdents, Options: yyDollar[2].indexOptions}
+		}
+	case 270:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1635
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1639
+		{
+			yyVAL.indexOptions = []*IndexOption{&IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}}
+		}
+	case 272:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1645
+		{
+			yyVAL.colIdents = nil
+		}
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1492
+//line sqlparser/parser.y:1649
 		{
-			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+			yyVAL.colIdents = yyDollar[3].colIdents
 		}
-	case 252:
+	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1498
+//line sqlparser/parser.y:1655
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 253:
+	case 275:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1502
+//line sqlparser/parser.y:1659
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 254:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1508
+//line sqlparser/parser.y:1665
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 255:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1512
+//line sqlparser/parser.y:1669
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[3].indexOption)
 		}
-	case 256:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1518
+//line sqlparser/parser.y:1675
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 257:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1522
+//line sqlparser/parser.y:1679
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 258:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1527
+//line sqlparser/parser.y:1684
 		{
-			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: newStringVal(yylex, yyDollar[2].bytes)}
 		}
-	case 259:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1531
+//line sqlparser/parser.y:1688
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[2].bytes), Value: NewStrVal([]byte(yyDollar[3].colIdent.String()))}
 		}
-	case 260:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1535
+//line sqlparser/parser.y:1692
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 261:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1539
+//line sqlparser/parser.y:1696
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 262:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1543
+//line sqlparser/parser.y:1700
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 263:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1547
+//line sqlparser/parser.y:1704
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 264:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1551
+//line sqlparser/parser.y:1708
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 265:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1555
+//line sqlparser/parser.y:1712
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 266:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1559
+//line sqlparser/parser.y:1716
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 267:
+	case 289:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1721
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 290:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1725
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
+		}
+	case 291:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1729
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewBoolSQLVal(bool(yyDollar[3].boolVal))}
+		}
+	case 292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1733
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewFloatVal(yyDollar[3].bytes)}
+		}
+	case 293:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1737
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: newStringVal(yylex, yyDollar[3].bytes)}
+		}
+	case 294:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1565
+//line sqlparser/parser.y:1743
 		{
 			yyVAL.str = ""
 		}
-	case 268:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1569
+//line sqlparser/parser.y:1747
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 269:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1575
+//line sqlparser/parser.y:1753
 		{
 			yyVAL.optVal = NewBoolSQLVal(true)
 		}
-	case 270:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1579
+//line sqlparser/parser.y:1757
 		{
 			yyVAL.optVal = NewBoolSQLVal(false)
 		}
-	case 271:
+	case 298:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1585
+//line sqlparser/parser.y:1763
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 272:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1589
+//line sqlparser/parser.y:1767
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
 		}
-	case 273:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1593
+//line sqlparser/parser.y:1771
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Fulltext: true}
 		}
-	case 274:
+	case 301:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1597
+//line sqlparser/parser.y:1775
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Fulltext: true}
 		}
-	case 275:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1601
+//line sqlparser/parser.y:1779
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 276:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1605
+//line sqlparser/parser.y:1783
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 277:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1609
+//line sqlparser/parser.y:1787
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false, Clustered: yyDollar[3].boolVal}
 		}
-	case 278:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1613
+//line sqlparser/parser.y:1791
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true, Clustered: yyDollar[4].boolVal}
 		}
-	case 279:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1619
+//line sqlparser/parser.y:1797
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 280:
+	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1623
+//line sqlparser/parser.y:1801
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 281:
+	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1629
+//line sqlparser/parser.y:1807
 		{
 			yyVAL.indexColumns = []IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 282:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1633
+//line sqlparser/parser.y:1811
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 283:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1639
+	case 311:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1819
 		{
-			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
+			yyDollar[1].indexColumn.Collate = yyDollar[3].str
+			yyVAL.indexColumn = yyDollar[1].indexColumn
 		}
-	case 284:
+	case 312:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:1826
+		{
+			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent}
+		}
+	case 313:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:1831
+		{
+			yyVAL.indexColumn = newIndexColumn(yyDollar[1].expr)
+		}
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1644
+//line sqlparser/parser.y:1836
 		{
 			yyVAL.indexColumn = IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].optVal}
 		}
-	case 286:
+	case 315:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1841
+		{
+			yyVAL.indexColumn = IndexColumn{Expression: yyDollar[2].expr}
+		}
+	case 316:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:1847
+		{
+			yyDollar[1].foreignKeyDefinition.Deferrable = yyDollar[2].boolVal
+			yyDollar[1].foreignKeyDefinition.InitiallyDeferred = yyDollar[3].boolVal
+			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
+		}
+	case 318:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1651
+//line sqlparser/parser.y:1856
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = NewColIdent("")
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 287:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1657
+//line sqlparser/parser.y:1862
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = NewColIdent("")
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 288:
+	case 320:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1663
+//line sqlparser/parser.y:1868
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[7].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 289:
+	case 321:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1669
+//line sqlparser/parser.y:1874
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[7].colIdent
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 290:
+	case 322:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sqlparser/parser.y:1677
+//line sqlparser/parser.y:1882
 		{
 			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{
 				ConstraintName:   yyDollar[2].colIdent,
 				IndexName:        yyDollar[5].colIdent,
 				IndexColumns:     yyDollar[7].colIdents,
-				ReferenceName:    NewColIdent(yyDollar[10].tableName.Name.String()),
+				ReferenceName:    NewColIdent(yyDollar[10].tableName.QualifiedName()),
 				ReferenceColumns: yyDollar[12].colIdents,
 			}
 		}
-	case 291:
+	case 323:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1894
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:1898
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 325:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1902
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 326:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1907
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 327:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1911
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 328:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1915
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1689
+//line sqlparser/parser.y:1921
 		{
 			yyVAL.colIdent = NewColIdent("RESTRICT")
 		}
-	case 292:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1693
+//line sqlparser/parser.y:1925
 		{
 			yyVAL.colIdent = NewColIdent("CASCADE")
 		}
-	case 293:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1697
+//line sqlparser/parser.y:1929
 		{
 			yyVAL.colIdent = NewColIdent("SET NULL")
 		}
-	case 294:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1701
+//line sqlparser/parser.y:1933
+		{
+			yyVAL.colIdent = NewColIdent("SET DEFAULT")
+		}
+	case 333:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1937
 		{
 			yyVAL.colIdent = NewColIdent("NO ACTION")
 		}
-	case 295:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:1707
+	case 334:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sqlparser/parser.y:1943
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:    &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: yyDollar[2].colIdent, Primary: true, Unique: true, Clustered: yyDollar[5].boolVal},
-				Columns: yyDollar[7].indexColumns,
+				Columns: yyDollar[8].indexColumns, Options: yyDollar[6].indexOptions,
 			}
 		}
-	case 296:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line sqlparser/parser.y:1714
+	case 335:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sqlparser/parser.y:1950
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:    &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: yyDollar[2].colIdent, Primary: true, Unique: true, Clustered: yyDollar[5].boolVal},
-				Columns: yyDollar[7].indexColumns, Options: yyDollar[11].indexOptions,
+				Columns: yyDollar[8].indexColumns, Options: append(yyDollar[6].indexOptions, yyDollar[12].indexOptions...),
 			}
 		}
-	case 297:
+	case 336:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sqlparser/parser.y:1958
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:    &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: yyDollar[2].colIdent, Primary: true, Unique: true, Clustered: yyDollar[5].boolVal},
+				Columns: yyDollar[8].indexColumns, Options: append(yyDollar[6].indexOptions, yyDollar[10].indexOptions...),
+			}
+		}
+	case 337:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sqlparser/parser.y:1967
+		{
+			yyVAL.checkDefinition = &CheckDefinition{Where: *NewWhere(WhereStr, yyDollar[5].expr), ConstraintName: yyDollar[2].colIdent, NoInherit: yyDollar[7].boolVal}
+		}
+	case 338:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sqlparser/parser.y:1971
+		{
+			yyVAL.checkDefinition = &CheckDefinition{Where: *NewWhere(WhereStr, yyDollar[3].expr), NoInherit: yyDollar[5].boolVal}
+		}
+	case 339:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sqlparser/parser.y:1978
+		{
+			yyVAL.exclusionDefinition = &ExclusionDefinition{ConstraintName: yyDollar[2].colIdent, IndexType: yyDollar[4].str, Elements: yyDollar[6].exclusionElements, Where: yyDollar[8].expr}
+		}
+	case 340:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:1982
+		{
+			yyVAL.exclusionDefinition = &ExclusionDefinition{IndexType: yyDollar[2].str, Elements: yyDollar[4].exclusionElements, Where: yyDollar[6].expr}
+		}
+	case 341:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:1987
+		{
+			yyVAL.str = ""
+		}
+	case 342:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sqlparser/parser.y:1991
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 343:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:1997
+		{
+			yyVAL.exclusionElements = []ExclusionElement{yyDollar[1].exclusionElement}
+		}
+	case 344:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:2001
+		{
+			yyVAL.exclusionElements = append(yyDollar[1].exclusionElements, yyDollar[3].exclusionElement)
+		}
+	case 345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:2007
+		{
+			yyVAL.exclusionElement = ExclusionElement{Column: yyDollar[1].colIdent, Operator: yyDollar[3].str}
+		}
+	case 346:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:2013
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 347:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:2017
+		{
+			yyVAL.str = "&&"
+		}
+	case 348:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sqlparser/parser.y:2024
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:    &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Unique: true, Constraint: true, Clustered: yyDollar[4].boolVal},
+				Columns: yyDollar[6].indexColumns,
+			}
+		}
+	case 349:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sqlparser/parser.y:2031
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:    &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Unique: true, Constraint: true, Clustered: yyDollar[4].boolVal},
+				Columns: yyDollar[6].indexColumns, Options: yyDollar[10].indexOptions,
+			}
+		}
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1723
+//line sqlparser/parser.y:2040
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 298:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1727
+//line sqlparser/parser.y:2044
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 299:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1731
+//line sqlparser/parser.y:2048
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 300:
+	case 353:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sqlparser/parser.y:2054
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:2058
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:2062
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1736
+//line sqlparser/parser.y:2067
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 302:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1743
+//line sqlparser/parser.y:2074
 		{
 			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
-	case 303:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1747
+//line sqlparser/parser.y:2078
 		{
 			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
-	case 304:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:1752
+//line sqlparser/parser.y:2083
 		{
 			yyVAL.str = ""
 		}
-	case 305:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1756
+//line sqlparser/parser.y:2087
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 306:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1760
+//line sqlparser/parser.y:2091
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 307:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1768
+//line sqlparser/parser.y:2099
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 308:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1772
+//line sqlparser/parser.y:2103
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 309:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1776
+//line sqlparser/parser.y:2107
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 310:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1782
+//line sqlparser/parser.y:2113
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 311:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1786
+//line sqlparser/parser.y:2117
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 312:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1790
+//line sqlparser/parser.y:2121
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 313:
+	case 369:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:1796
+//line sqlparser/parser.y:2127
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 314:
+	case 370:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sqlparser/parser.y:2132
+		{
+			yyVAL.statement = &DDL{Action: ClusterOnStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName, IndexSpec: &IndexSpec{Name: yyDollar[7].colIdent}}
+		}
+	case 371:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sqlparser/parser.y:1800
+//line sqlparser/parser.y:2136
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndexStr,
@@ -5225,9 +5803,9 @@ yydefault:
 				IndexCols: yyDollar[10].indexColumns,
 			}
 		}
-	case 315:
+	case 372:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sqlparser/parser.y:1814
+//line sqlparser/parser.y:2150
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddPrimaryKeyStr,
@@ -5241,9 +5819,49 @@ yydefault:
 				IndexCols: yyDollar[12].indexColumns,
 			}
 		}
-	case 316:
+	case 373:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sqlparser/parser.y:2164
+		{
+			yyVAL.statement = &DDL{
+				Action:  AddPrimaryKeyStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      NewColIdent("PRIMARY"),
+					Unique:    false,
+					Primary:   true,
+					Clustered: yyDollar[8].boolVal,
+				},
+				IndexCols: yyDollar[10].indexColumns,
+			}
+		}
+	case 374:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sqlparser/parser.y:2179
+		{
+			yyVAL.statement = &DDL{
+				Action:  AddPrimaryKeyStr,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name:      yyDollar[7].colIdent,
+					Unique:    false,
+					Primary:   true,
+					Clustered: yyDollar[10].boolVal,
+				},
+				IndexCols: yyDollar[12].indexColumns,
+			}
+		}
+	case 375:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sqlparser/parser.y:2194
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
+		}
+	case 376:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1828
+//line sqlparser/parser.y:2198
 		{
 			yyVAL.statement = &DDL{
 				Action:     AddForeignKeyStr,
@@ -5252,21 +5870,21 @@ yydefault:
 				ForeignKey: yyDollar[7].foreignKeyDefinition,
 			}
 		}
-	case 317:
+	case 377:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1837
+//line sqlparser/parser.y:2207
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 318:
+	case 378:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1841
+//line sqlparser/parser.y:2211
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 319:
+	case 379:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sqlparser/parser.y:1845
+//line sqlparser/parser.y:2215
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -5279,9 +5897,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 320:
+	case 380:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1858
+//line sqlparser/parser.y:2228
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -5291,71 +5909,71 @@ yydefault:
 				},
 			}
 		}
-	case 321:
+	case 381:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1868
+//line sqlparser/parser.y:2238
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 322:
+	case 382:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1873
+//line sqlparser/parser.y:2243
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 323:
+	case 383:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1878
+//line sqlparser/parser.y:2248
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 324:
+	case 384:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1882
+//line sqlparser/parser.y:2252
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 345:
+	case 405:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:1914
+//line sqlparser/parser.y:2284
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 346:
+	case 406:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:1920
+//line sqlparser/parser.y:2290
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 347:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1924
+//line sqlparser/parser.y:2294
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 348:
+	case 408:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:1930
+//line sqlparser/parser.y:2300
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 349:
+	case 409:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:1934
+//line sqlparser/parser.y:2304
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 350:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1940
+//line sqlparser/parser.y:2310
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 351:
+	case 411:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1946
+//line sqlparser/parser.y:2316
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5363,16 +5981,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 352:
+	case 412:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:1954
+//line sqlparser/parser.y:2324
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 353:
+	case 413:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:1959
+//line sqlparser/parser.y:2329
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5380,123 +5998,123 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 354:
+	case 414:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1967
+//line sqlparser/parser.y:2337
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 355:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1971
+//line sqlparser/parser.y:2341
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 356:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1977
+//line sqlparser/parser.y:2347
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 357:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:1981
+//line sqlparser/parser.y:2351
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 358:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:1986
+//line sqlparser/parser.y:2356
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 359:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1992
+//line sqlparser/parser.y:2362
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 360:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:1996
+//line sqlparser/parser.y:2366
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 361:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2000
+//line sqlparser/parser.y:2370
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 362:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2005
+//line sqlparser/parser.y:2375
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 363:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2009
+//line sqlparser/parser.y:2379
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 364:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2013
+//line sqlparser/parser.y:2383
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 365:
+	case 425:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2017
+//line sqlparser/parser.y:2387
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 366:
+	case 426:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2021
+//line sqlparser/parser.y:2391
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 367:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2025
+//line sqlparser/parser.y:2395
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 368:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2029
+//line sqlparser/parser.y:2399
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 369:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2033
+//line sqlparser/parser.y:2403
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 370:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2037
+//line sqlparser/parser.y:2407
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 371:
+	case 431:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2041
+//line sqlparser/parser.y:2411
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 372:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2045
+//line sqlparser/parser.y:2415
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 373:
+	case 433:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2049
+//line sqlparser/parser.y:2419
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[4].str == "processlist" {
@@ -5506,604 +6124,628 @@ yydefault:
 				yyVAL.statement = &Show{Type: yyDollar[4].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 374:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2059
+//line sqlparser/parser.y:2429
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 375:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2063
+//line sqlparser/parser.y:2433
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 376:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2067
+//line sqlparser/parser.y:2437
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), OnTable: yyDollar[4].tableName}
 		}
-	case 377:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2071
+//line sqlparser/parser.y:2441
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 378:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2075
+//line sqlparser/parser.y:2445
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 379:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2079
+//line sqlparser/parser.y:2449
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 380:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2083
+//line sqlparser/parser.y:2453
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 381:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2093
+//line sqlparser/parser.y:2463
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 382:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2099
+//line sqlparser/parser.y:2469
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 383:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2103
+//line sqlparser/parser.y:2473
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 384:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2109
+//line sqlparser/parser.y:2479
 		{
 			yyVAL.str = ""
 		}
-	case 385:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2113
+//line sqlparser/parser.y:2483
 		{
 			yyVAL.str = "extended "
 		}
-	case 386:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2119
+//line sqlparser/parser.y:2489
 		{
 			yyVAL.str = ""
 		}
-	case 387:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2123
+//line sqlparser/parser.y:2493
 		{
 			yyVAL.str = "full "
 		}
-	case 388:
+	case 448:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2129
+//line sqlparser/parser.y:2499
 		{
 			yyVAL.str = ""
 		}
-	case 389:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2133
+//line sqlparser/parser.y:2503
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 390:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2137
+//line sqlparser/parser.y:2507
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 391:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2143
+//line sqlparser/parser.y:2513
 		{
 			yyVAL.showFilter = nil
 		}
-	case 392:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2147
+//line sqlparser/parser.y:2517
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 393:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2151
+//line sqlparser/parser.y:2521
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 394:
+	case 454:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2157
+//line sqlparser/parser.y:2527
 		{
 			yyVAL.str = ""
 		}
-	case 395:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2161
+//line sqlparser/parser.y:2531
 		{
 			yyVAL.str = SessionStr
 		}
-	case 396:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2165
+//line sqlparser/parser.y:2535
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 397:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2171
+//line sqlparser/parser.y:2541
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 398:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2175
+//line sqlparser/parser.y:2545
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 399:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2181
+//line sqlparser/parser.y:2551
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 400:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2185
+//line sqlparser/parser.y:2555
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 401:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2191
+//line sqlparser/parser.y:2561
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 402:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2197
+//line sqlparser/parser.y:2567
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 403:
+	case 463:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:2574
+		{
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].colName.Qualifier, ColumnComment: &ColumnComment{Column: yyDollar[4].colName.Name, Comment: newStringVal(yylex, yyDollar[6].bytes)}}
+		}
+	case 464:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:2578
+		{
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].colName.Qualifier, ColumnComment: &ColumnComment{Column: yyDollar[4].colName.Name}}
+		}
+	case 465:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:2582
+		{
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].tableName, TableComment: &TableComment{Comment: newStringVal(yylex, yyDollar[6].bytes)}}
+		}
+	case 466:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sqlparser/parser.y:2586
+		{
+			yyVAL.statement = &DDL{Action: CommentStr, Table: yyDollar[4].tableName, TableComment: &TableComment{}}
+		}
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2203
+//line sqlparser/parser.y:2592
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 404:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2207
+//line sqlparser/parser.y:2596
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 405:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2211
+//line sqlparser/parser.y:2600
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 406:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2215
+//line sqlparser/parser.y:2604
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 407:
+	case 471:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2219
+//line sqlparser/parser.y:2608
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 408:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2224
+//line sqlparser/parser.y:2613
 		{
 			setAllowComments(yylex, true)
 		}
-	case 409:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2228
+//line sqlparser/parser.y:2617
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 410:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2234
+//line sqlparser/parser.y:2623
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 411:
+	case 475:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2238
+//line sqlparser/parser.y:2627
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 412:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2244
+//line sqlparser/parser.y:2633
 		{
 			yyVAL.str = UnionStr
 		}
-	case 413:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2248
+//line sqlparser/parser.y:2637
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 414:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2252
+//line sqlparser/parser.y:2641
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 415:
+	case 479:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2257
+//line sqlparser/parser.y:2646
 		{
 			yyVAL.str = ""
 		}
-	case 416:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2261
+//line sqlparser/parser.y:2650
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 417:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2265
+//line sqlparser/parser.y:2654
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 418:
+	case 482:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2270
+//line sqlparser/parser.y:2659
 		{
 			yyVAL.str = ""
 		}
-	case 419:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2274
+//line sqlparser/parser.y:2663
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 420:
+	case 484:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2279
+//line sqlparser/parser.y:2668
 		{
 			yyVAL.str = ""
 		}
-	case 421:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2283
+//line sqlparser/parser.y:2672
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 422:
+	case 486:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2288
+//line sqlparser/parser.y:2677
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 423:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2292
+//line sqlparser/parser.y:2681
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 424:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2298
+//line sqlparser/parser.y:2687
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 425:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2302
+//line sqlparser/parser.y:2691
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 426:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2308
+//line sqlparser/parser.y:2697
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 427:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2312
+//line sqlparser/parser.y:2701
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 428:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2316
+//line sqlparser/parser.y:2705
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 429:
+	case 493:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2320
+//line sqlparser/parser.y:2709
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 430:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2325
+//line sqlparser/parser.y:2714
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 431:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2329
+//line sqlparser/parser.y:2718
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 432:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2333
+//line sqlparser/parser.y:2722
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 434:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2340
+//line sqlparser/parser.y:2729
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 435:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2345
+//line sqlparser/parser.y:2734
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 436:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2349
+//line sqlparser/parser.y:2738
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 437:
+	case 501:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2355
+//line sqlparser/parser.y:2744
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 438:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2359
+//line sqlparser/parser.y:2748
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 441:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2369
+//line sqlparser/parser.y:2758
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 442:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2373
+//line sqlparser/parser.y:2762
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 443:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2377
+//line sqlparser/parser.y:2766
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 444:
+	case 508:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2383
+//line sqlparser/parser.y:2772
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 445:
+	case 509:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:2387
+//line sqlparser/parser.y:2776
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 446:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2393
+//line sqlparser/parser.y:2782
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 447:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2398
+//line sqlparser/parser.y:2787
 		{
 			yyVAL.columns = Columns{NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 448:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2402
+//line sqlparser/parser.y:2791
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 449:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2408
+//line sqlparser/parser.y:2797
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 450:
+	case 514:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2412
+//line sqlparser/parser.y:2801
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 451:
+	case 515:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2425
+//line sqlparser/parser.y:2814
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 452:
+	case 516:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2429
+//line sqlparser/parser.y:2818
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 453:
+	case 517:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2433
+//line sqlparser/parser.y:2822
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 454:
+	case 518:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2437
+//line sqlparser/parser.y:2826
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 455:
+	case 519:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2443
+//line sqlparser/parser.y:2832
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 456:
+	case 520:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2445
+//line sqlparser/parser.y:2834
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 457:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2449
+//line sqlparser/parser.y:2838
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 458:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2451
+//line sqlparser/parser.y:2840
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 459:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2455
+//line sqlparser/parser.y:2844
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 460:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2457
+//line sqlparser/parser.y:2846
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 461:
+	case 525:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2460
+//line sqlparser/parser.y:2849
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 462:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2462
+//line sqlparser/parser.y:2851
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 463:
+	case 527:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2465
+//line sqlparser/parser.y:2854
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 464:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2469
+//line sqlparser/parser.y:2858
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 465:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2473
+//line sqlparser/parser.y:2862
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 467:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2480
+//line sqlparser/parser.y:2869
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 468:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2486
+//line sqlparser/parser.y:2875
 		{
 			yyVAL.str = JoinStr
 		}
-	case 469:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2490
+//line sqlparser/parser.y:2879
 		{
 			yyVAL.str = JoinStr
 		}
-	case 470:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2494
+//line sqlparser/parser.y:2883
 		{
 			yyVAL.str = JoinStr
 		}
-	case 471:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2500
+//line sqlparser/parser.y:2889
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 472:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2506
+//line sqlparser/parser.y:2895
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 473:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2510
+//line sqlparser/parser.y:2899
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 474:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2514
+//line sqlparser/parser.y:2903
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 475:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2518
+//line sqlparser/parser.y:2907
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 476:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2524
+//line sqlparser/parser.y:2913
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 477:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2528
+//line sqlparser/parser.y:2917
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6111,453 +6753,465 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 478:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2538
+//line sqlparser/parser.y:2927
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 479:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2542
+//line sqlparser/parser.y:2931
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 480:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2548
+//line sqlparser/parser.y:2937
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 481:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2552
+//line sqlparser/parser.y:2941
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 482:
+	case 546:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2557
+//line sqlparser/parser.y:2946
 		{
 			yyVAL.indexHints = nil
 		}
-	case 483:
+	case 547:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2561
+//line sqlparser/parser.y:2950
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 484:
+	case 548:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2565
+//line sqlparser/parser.y:2954
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 485:
+	case 549:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2569
+//line sqlparser/parser.y:2958
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 486:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2574
+//line sqlparser/parser.y:2963
 		{
 			yyVAL.expr = nil
 		}
-	case 487:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2578
+//line sqlparser/parser.y:2967
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 488:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2584
+//line sqlparser/parser.y:2973
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 489:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2588
+//line sqlparser/parser.y:2977
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 490:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2592
+//line sqlparser/parser.y:2981
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 491:
+	case 555:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2596
+//line sqlparser/parser.y:2985
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 492:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2600
+//line sqlparser/parser.y:2989
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 493:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2604
+//line sqlparser/parser.y:2993
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 494:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2608
+//line sqlparser/parser.y:2997
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 495:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2614
+//line sqlparser/parser.y:3003
 		{
 			yyVAL.str = ""
 		}
-	case 496:
+	case 560:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2618
+//line sqlparser/parser.y:3007
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 497:
+	case 561:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2624
+//line sqlparser/parser.y:3013
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 498:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2628
+//line sqlparser/parser.y:3017
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 499:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2634
+//line sqlparser/parser.y:3023
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 500:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2638
+//line sqlparser/parser.y:3027
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 501:
+	case 565:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2642
+//line sqlparser/parser.y:3031
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 502:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2646
+//line sqlparser/parser.y:3035
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 503:
+	case 567:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2650
+//line sqlparser/parser.y:3039
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 504:
+	case 568:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sqlparser/parser.y:3043
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2654
+//line sqlparser/parser.y:3047
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 505:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2658
+//line sqlparser/parser.y:3051
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 506:
+	case 571:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sqlparser/parser.y:3055
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: PosixRegexpStr, Right: yyDollar[3].expr}
+		}
+	case 572:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2662
+//line sqlparser/parser.y:3059
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 507:
+	case 573:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2666
+//line sqlparser/parser.y:3063
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 508:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2670
+//line sqlparser/parser.y:3067
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 509:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2676
+//line sqlparser/parser.y:3073
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 510:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2680
+//line sqlparser/parser.y:3077
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 511:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2684
+//line sqlparser/parser.y:3081
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 512:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2688
+//line sqlparser/parser.y:3085
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 513:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2692
+//line sqlparser/parser.y:3089
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 514:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2696
+//line sqlparser/parser.y:3093
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 515:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2702
+//line sqlparser/parser.y:3099
 		{
 			yyVAL.str = EqualStr
 		}
-	case 516:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2706
+//line sqlparser/parser.y:3103
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 517:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2710
+//line sqlparser/parser.y:3107
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 518:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2714
+//line sqlparser/parser.y:3111
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 519:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2718
+//line sqlparser/parser.y:3115
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 520:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2722
+//line sqlparser/parser.y:3119
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 521:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2726
+//line sqlparser/parser.y:3123
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 522:
+	case 588:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:2731
+//line sqlparser/parser.y:3128
 		{
 			yyVAL.expr = nil
 		}
-	case 523:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2735
+//line sqlparser/parser.y:3132
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 524:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2741
+//line sqlparser/parser.y:3138
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 525:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2745
+//line sqlparser/parser.y:3142
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 526:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2749
+//line sqlparser/parser.y:3146
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 527:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2755
+//line sqlparser/parser.y:3152
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 528:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2761
+//line sqlparser/parser.y:3158
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 529:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2765
+//line sqlparser/parser.y:3162
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 530:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2771
+//line sqlparser/parser.y:3168
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 531:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2775
+//line sqlparser/parser.y:3172
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 532:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2779
+//line sqlparser/parser.y:3176
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 533:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2783
+//line sqlparser/parser.y:3180
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 534:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:2787
+//line sqlparser/parser.y:3184
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 535:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2791
+//line sqlparser/parser.y:3188
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 536:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2795
+//line sqlparser/parser.y:3192
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 537:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2799
+//line sqlparser/parser.y:3196
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 538:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2803
+//line sqlparser/parser.y:3200
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 539:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2807
+//line sqlparser/parser.y:3204
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 540:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2811
+//line sqlparser/parser.y:3208
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 541:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2815
+//line sqlparser/parser.y:3212
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 542:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2819
+//line sqlparser/parser.y:3216
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 543:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2823
+//line sqlparser/parser.y:3220
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 544:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2827
+//line sqlparser/parser.y:3224
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 545:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2831
+//line sqlparser/parser.y:3228
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 546:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2835
+//line sqlparser/parser.y:3232
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 547:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2839
+//line sqlparser/parser.y:3236
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 548:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2843
+//line sqlparser/parser.y:3240
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 549:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2847
+//line sqlparser/parser.y:3244
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 550:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2851
+//line sqlparser/parser.y:3248
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 551:
+	case 617:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2855
+//line sqlparser/parser.y:3252
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 552:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2859
+//line sqlparser/parser.y:3256
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6565,9 +7219,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 553:
+	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2867
+//line sqlparser/parser.y:3264
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6581,21 +7235,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 554:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2881
+//line sqlparser/parser.y:3278
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 555:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2885
+//line sqlparser/parser.y:3282
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 556:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2889
+//line sqlparser/parser.y:3286
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6603,491 +7257,497 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 557:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:2897
+//line sqlparser/parser.y:3294
 		{
-			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType, TypeCast: true}
 		}
-	case 562:
+	case 628:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2911
+//line sqlparser/parser.y:3308
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 563:
+	case 629:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2915
+//line sqlparser/parser.y:3312
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 564:
+	case 630:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2919
+//line sqlparser/parser.y:3316
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 565:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2929
+//line sqlparser/parser.y:3326
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 566:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2933
+//line sqlparser/parser.y:3330
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 567:
+	case 633:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2937
+//line sqlparser/parser.y:3334
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 568:
+	case 634:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2941
+//line sqlparser/parser.y:3338
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 569:
+	case 635:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2945
+//line sqlparser/parser.y:3342
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 570:
+	case 636:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2949
+//line sqlparser/parser.y:3346
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 571:
+	case 637:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:2953
+//line sqlparser/parser.y:3350
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 572:
+	case 638:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:2957
+//line sqlparser/parser.y:3354
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 573:
+	case 639:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:2961
+//line sqlparser/parser.y:3358
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: nil}
 		}
-	case 574:
+	case 640:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:2965
+//line sqlparser/parser.y:3362
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 575:
+	case 641:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sqlparser/parser.y:2969
+//line sqlparser/parser.y:3366
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 576:
+	case 642:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sqlparser/parser.y:2973
+//line sqlparser/parser.y:3370
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 577:
+	case 643:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:2977
+//line sqlparser/parser.y:3374
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 578:
+	case 644:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:2981
+//line sqlparser/parser.y:3378
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 579:
+	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:2985
+//line sqlparser/parser.y:3382
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 580:
+	case 646:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2995
+//line sqlparser/parser.y:3392
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 581:
+	case 647:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:2999
+//line sqlparser/parser.y:3396
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 582:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3003
+//line sqlparser/parser.y:3400
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 583:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3007
+//line sqlparser/parser.y:3404
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 584:
+	case 650:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3012
+//line sqlparser/parser.y:3409
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 585:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3017
+//line sqlparser/parser.y:3414
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 586:
+	case 652:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3022
+//line sqlparser/parser.y:3419
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 587:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3027
+//line sqlparser/parser.y:3424
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 588:
+	case 654:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3031
+//line sqlparser/parser.y:3428
 		{
 			yyVAL.expr = &ConvertExpr{Type: yyDollar[2].convertType}
 		}
-	case 591:
+	case 657:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3045
+//line sqlparser/parser.y:3442
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 592:
+	case 658:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3049
+//line sqlparser/parser.y:3446
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 593:
+	case 659:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3053
+//line sqlparser/parser.y:3450
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 594:
+	case 660:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3057
+//line sqlparser/parser.y:3454
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 595:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3063
+//line sqlparser/parser.y:3460
 		{
 			yyVAL.str = ""
 		}
-	case 596:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3067
+//line sqlparser/parser.y:3464
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 597:
+	case 663:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3071
+//line sqlparser/parser.y:3468
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 598:
+	case 664:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sqlparser/parser.y:3075
+//line sqlparser/parser.y:3472
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 599:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3079
+//line sqlparser/parser.y:3476
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 600:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3085
+//line sqlparser/parser.y:3482
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 601:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3089
+//line sqlparser/parser.y:3486
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 602:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3095
+//line sqlparser/parser.y:3492
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 603:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3099
+//line sqlparser/parser.y:3496
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 604:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3103
+//line sqlparser/parser.y:3500
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 605:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3107
+//line sqlparser/parser.y:3504
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 606:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3111
+//line sqlparser/parser.y:3508
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 607:
+	case 673:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3115
+//line sqlparser/parser.y:3512
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 608:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3121
+//line sqlparser/parser.y:3518
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 609:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3125
+//line sqlparser/parser.y:3522
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 610:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3129
+//line sqlparser/parser.y:3526
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 611:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3133
+//line sqlparser/parser.y:3530
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 612:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3137
+//line sqlparser/parser.y:3534
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 613:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3141
+//line sqlparser/parser.y:3538
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 614:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3145
+//line sqlparser/parser.y:3542
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 615:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3149
+//line sqlparser/parser.y:3546
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 616:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3155
+//line sqlparser/parser.y:3552
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 617:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3159
+//line sqlparser/parser.y:3556
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
 		}
-	case 618:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3163
+//line sqlparser/parser.y:3560
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 619:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3167
+//line sqlparser/parser.y:3564
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 620:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3171
+//line sqlparser/parser.y:3568
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
-	case 621:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3175
+//line sqlparser/parser.y:3572
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
-	case 622:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3179
+//line sqlparser/parser.y:3576
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 623:
+	case 689:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sqlparser/parser.y:3581
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 690:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3184
+//line sqlparser/parser.y:3586
 		{
 			yyVAL.expr = nil
 		}
-	case 624:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3188
+//line sqlparser/parser.y:3590
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 625:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3193
+//line sqlparser/parser.y:3595
 		{
 			yyVAL.str = string("")
 		}
-	case 626:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3197
+//line sqlparser/parser.y:3599
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 627:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3203
+//line sqlparser/parser.y:3605
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 628:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3207
+//line sqlparser/parser.y:3609
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 629:
+	case 696:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3213
+//line sqlparser/parser.y:3615
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 630:
+	case 697:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3218
+//line sqlparser/parser.y:3620
 		{
 			yyVAL.expr = nil
 		}
-	case 631:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3222
+//line sqlparser/parser.y:3624
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 632:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3228
+//line sqlparser/parser.y:3630
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 633:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3232
+//line sqlparser/parser.y:3634
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 634:
+	case 701:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:3236
+//line sqlparser/parser.y:3638
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 635:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3242
+//line sqlparser/parser.y:3644
 		{
-			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+			yyVAL.expr = newStringVal(yylex, yyDollar[1].bytes)
 		}
-	case 636:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3246
+//line sqlparser/parser.y:3648
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 637:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3250
+//line sqlparser/parser.y:3652
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 638:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3254
+//line sqlparser/parser.y:3656
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 639:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3258
+//line sqlparser/parser.y:3660
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 640:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3262
+//line sqlparser/parser.y:3664
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 641:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3266
+//line sqlparser/parser.y:3668
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 642:
+	case 709:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3270
+//line sqlparser/parser.y:3672
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 643:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3276
+//line sqlparser/parser.y:3678
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -7096,239 +7756,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 644:
+	case 711:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3285
+//line sqlparser/parser.y:3687
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 645:
+	case 712:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3289
+//line sqlparser/parser.y:3691
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 646:
+	case 713:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3294
+//line sqlparser/parser.y:3696
 		{
 			yyVAL.exprs = nil
 		}
-	case 647:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3298
+//line sqlparser/parser.y:3700
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 648:
+	case 715:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3303
+//line sqlparser/parser.y:3705
 		{
 			yyVAL.expr = nil
 		}
-	case 649:
+	case 716:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3307
+//line sqlparser/parser.y:3709
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 650:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3312
+//line sqlparser/parser.y:3714
 		{
 			yyVAL.orderBy = nil
 		}
-	case 651:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3316
+//line sqlparser/parser.y:3718
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 652:
+	case 719:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3322
+//line sqlparser/parser.y:3724
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 653:
+	case 720:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3326
+//line sqlparser/parser.y:3728
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 654:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3332
+//line sqlparser/parser.y:3734
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 655:
+	case 722:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3337
+//line sqlparser/parser.y:3739
 		{
 			yyVAL.str = AscScr
 		}
-	case 656:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3341
+//line sqlparser/parser.y:3743
 		{
 			yyVAL.str = AscScr
 		}
-	case 657:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3345
+//line sqlparser/parser.y:3747
 		{
 			yyVAL.str = DescScr
 		}
-	case 658:
+	case 725:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3350
+//line sqlparser/parser.y:3752
 		{
 			yyVAL.limit = nil
 		}
-	case 659:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3354
+//line sqlparser/parser.y:3756
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 660:
+	case 727:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3358
+//line sqlparser/parser.y:3760
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 661:
+	case 728:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3362
+//line sqlparser/parser.y:3764
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 662:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3367
+//line sqlparser/parser.y:3769
 		{
 			yyVAL.str = ""
 		}
-	case 663:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3371
+//line sqlparser/parser.y:3773
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 664:
+	case 731:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3375
+//line sqlparser/parser.y:3777
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 665:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3388
+//line sqlparser/parser.y:3790
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 666:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3392
+//line sqlparser/parser.y:3794
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 667:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3396
+//line sqlparser/parser.y:3798
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 668:
+	case 735:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:3401
+//line sqlparser/parser.y:3803
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 669:
+	case 736:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sqlparser/parser.y:3405
+//line sqlparser/parser.y:3807
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 670:
+	case 737:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sqlparser/parser.y:3409
+//line sqlparser/parser.y:3811
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 671:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3416
+//line sqlparser/parser.y:3818
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 672:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3420
+//line sqlparser/parser.y:3822
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 673:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3424
+//line sqlparser/parser.y:3826
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 674:
+	case 741:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:3428
+//line sqlparser/parser.y:3830
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 675:
+	case 742:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3433
+//line sqlparser/parser.y:3835
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 676:
+	case 743:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sqlparser/parser.y:3437
+//line sqlparser/parser.y:3839
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 677:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3443
+//line sqlparser/parser.y:3845
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 678:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3447
+//line sqlparser/parser.y:3849
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 679:
+	case 746:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3453
+//line sqlparser/parser.y:3855
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 680:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3457
+//line sqlparser/parser.y:3859
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 681:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3463
+//line sqlparser/parser.y:3865
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 682:
+	case 749:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3469
+//line sqlparser/parser.y:3871
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7336,282 +7996,282 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 683:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3479
+//line sqlparser/parser.y:3881
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 684:
+	case 751:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3483
+//line sqlparser/parser.y:3885
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 685:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3489
+//line sqlparser/parser.y:3891
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 686:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3495
+//line sqlparser/parser.y:3897
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 687:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3499
+//line sqlparser/parser.y:3901
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 688:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3505
+//line sqlparser/parser.y:3907
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 689:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3509
+//line sqlparser/parser.y:3911
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 690:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3513
+//line sqlparser/parser.y:3915
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 691:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3517
+//line sqlparser/parser.y:3919
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 693:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3524
+//line sqlparser/parser.y:3926
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 695:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3531
+//line sqlparser/parser.y:3933
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 696:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3535
+//line sqlparser/parser.y:3937
 		{
-			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+			yyVAL.expr = newStringVal(yylex, yyDollar[1].bytes)
 		}
-	case 697:
+	case 764:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3539
+//line sqlparser/parser.y:3941
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 700:
+	case 767:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3548
+//line sqlparser/parser.y:3950
 		{
 			yyVAL.byt = 0
 		}
-	case 701:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sqlparser/parser.y:3550
+//line sqlparser/parser.y:3952
 		{
 			yyVAL.byt = 1
 		}
-	case 702:
+	case 769:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3553
+//line sqlparser/parser.y:3955
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 703:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sqlparser/parser.y:3555
+//line sqlparser/parser.y:3957
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 704:
+	case 771:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3558
+//line sqlparser/parser.y:3960
 		{
 			yyVAL.str = ""
 		}
-	case 705:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3560
+//line sqlparser/parser.y:3962
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 706:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3564
+//line sqlparser/parser.y:3966
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 707:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3566
+//line sqlparser/parser.y:3968
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 708:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3568
+//line sqlparser/parser.y:3970
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 709:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3570
+//line sqlparser/parser.y:3972
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 710:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3572
+//line sqlparser/parser.y:3974
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 711:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3574
+//line sqlparser/parser.y:3976
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 712:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3576
+//line sqlparser/parser.y:3978
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 713:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3578
+//line sqlparser/parser.y:3980
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 714:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3580
+//line sqlparser/parser.y:3982
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 715:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3582
+//line sqlparser/parser.y:3984
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 716:
+	case 783:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3585
+//line sqlparser/parser.y:3987
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 717:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3587
+//line sqlparser/parser.y:3989
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 718:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3589
+//line sqlparser/parser.y:3991
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 719:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3593
+//line sqlparser/parser.y:3995
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 720:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3595
+//line sqlparser/parser.y:3997
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 721:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3599
+//line sqlparser/parser.y:4001
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 722:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3603
+//line sqlparser/parser.y:4005
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 724:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3610
+//line sqlparser/parser.y:4012
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 725:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3616
+//line sqlparser/parser.y:4018
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 726:
+	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3620
+//line sqlparser/parser.y:4022
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 728:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3627
+//line sqlparser/parser.y:4029
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 962:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3886
+//line sqlparser/parser.y:4299
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 963:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3895
+//line sqlparser/parser.y:4308
 		{
 			decNesting(yylex)
 		}
-	case 964:
+	case 1042:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3900
+//line sqlparser/parser.y:4313
 		{
 			forceEOF(yylex)
 		}
-	case 965:
+	case 1043:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sqlparser/parser.y:3905
+//line sqlparser/parser.y:4318
 		{
 			forceEOF(yylex)
 		}
-	case 966:
+	case 1044:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3909
+//line sqlparser/parser.y:4322
 		{
 			forceEOF(yylex)
 		}
-	case 967:
+	case 1045:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sqlparser/parser.y:3913
+//line sqlparser/parser.y:4326
 		{
 			forceEOF(yylex)
 		}
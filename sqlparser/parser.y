@@ -41,6 +41,13 @@ func decNesting(yylex interface{}) {
   yylex.(*Tokenizer).nesting--
 }
 
+// PostgreSQL's standard string doesn't escape a backslash. Keep it as is when the string is formatted.
+func newStringVal(yylex interface{}, in []byte) *SQLVal {
+  val := NewStrVal(in)
+  val.NoBackslashEscapes = yylex.(*Tokenizer).mode == ParserModePostgres
+  return val
+}
+
 // forceEOF forces the lexer to end prematurely. Not all SQL statements
 // are supported by the Parser, thus calling forceEOF will make the lexer
 // return EOF early.
@@ -106,6 +113,10 @@ func forceEOF(yylex interface{}) {
   indexColumn   IndexColumn
   indexColumns  []IndexColumn
   foreignKeyDefinition *ForeignKeyDefinition
+  checkDefinition *CheckDefinition
+  exclusionDefinition *ExclusionDefinition
+  exclusionElement ExclusionElement
+  exclusionElements []ExclusionElement
   partDefs      []*PartitionDefinition
   partDef       *PartitionDefinition
   partSpec      *PartitionSpec
@@ -117,7 +128,7 @@ func forceEOF(yylex interface{}) {
 
 %token LEX_ERROR
 %left <bytes> UNION
-%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
+%token <bytes> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER LIMIT OFFSET FOR
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK KEYS
 %token <bytes> VALUES LAST_INSERT_ID
 %token <bytes> NEXT VALUE SHARE MODE
@@ -137,7 +148,7 @@ func forceEOF(yylex interface{}) {
 %left <bytes> AND
 %right <bytes> NOT '!'
 %left <bytes> BETWEEN CASE WHEN THEN ELSE END
-%left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN
+%left <bytes> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE NOT_LIKE REGEXP IN
 %left <bytes> '|'
 %left <bytes> '&'
 %left <bytes> SHIFT_LEFT SHIFT_RIGHT
@@ -160,7 +171,11 @@ func forceEOF(yylex interface{}) {
 %token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT REFERENCES SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE POLICY
 %right <bytes> UNIQUE KEY
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <bytes> MAXVALUE REORGANIZE LESS THAN PROCEDURE TRIGGER
+// `PARTITION BY` after CREATE TABLE's columns can also be read as table options.
+// Declaring BY above PARTITION makes the parser choose the PARTITION BY clause.
+%nonassoc <bytes> PARTITION
+%nonassoc <bytes> BY
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES
 %token <bytes> RESTRICT CASCADE NO ACTION
@@ -173,7 +188,7 @@ func forceEOF(yylex interface{}) {
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT SMALLSERIAL MEDIUMINT INT INTEGER SERIAL BIGINT BIGSERIAL INTNUM
 %token <bytes> REAL DOUBLE PRECISION FLOAT_TYPE DECIMAL NUMERIC SMALLMONEY MONEY
-%token <bytes> TIME TIMESTAMP DATETIME YEAR DATETIMEOFFSET DATETIME2 SMALLDATETIME
+%token <bytes> TIME TIMESTAMP TIMESTAMPTZ DATETIME YEAR DATETIMEOFFSET DATETIME2 SMALLDATETIME
 %token <bytes> CHAR VARCHAR VARYING BOOL CHARACTER VARBINARY NCHAR NVARCHAR NTEXT UUID
 %token <bytes> TEXT TINYTEXT MEDIUMTEXT LONGTEXT CITEXT
 %token <bytes> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON JSONB ENUM
@@ -199,7 +214,11 @@ func forceEOF(yylex interface{}) {
 %token <bytes> CONVERT CAST
 %token <bytes> SUBSTR SUBSTRING
 %token <bytes> GROUP_CONCAT SEPARATOR
-%token <bytes> INHERIT
+%token <bytes> INHERIT INHERITS
+%token <bytes> DEFERRABLE INITIALLY DEFERRED IMMEDIATE
+%token <bytes> INCLUDE
+%token <bytes> EXCLUDE
+%token <bytes> CLUSTER
 
 // Match
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH WITHOUT PARSER QUERY EXPANSION
@@ -209,6 +228,8 @@ func forceEOF(yylex interface{}) {
 
 // Postgres GENERATED AS IDENTITY
 %token <bytes> GENERATED ALWAYS IDENTITY
+// Generated columns
+%token <bytes> STORED VIRTUAL PERSISTED MATERIALIZED
 // sequence
 %token <bytes> SEQUENCE INCREMENT MINVALUE CACHE CYCLE OWNED NONE
 
@@ -220,7 +241,7 @@ func forceEOF(yylex interface{}) {
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement
 %type <ddl> create_table_prefix
-%type <statement> analyze_statement show_statement use_statement other_statement
+%type <statement> analyze_statement show_statement use_statement other_statement comment_statement
 %type <statement> begin_statement commit_statement rollback_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
@@ -295,20 +316,25 @@ func forceEOF(yylex interface{}) {
 %type <strs> enum_values
 %type <columnDefinition> column_definition
 %type <columnType> column_definition_type
-%type <indexDefinition> index_definition primary_key_definition
-%type <foreignKeyDefinition> foreign_key_definition foreign_key_without_options
+%type <indexDefinition> index_definition primary_key_definition unique_constraint_definition
+%type <foreignKeyDefinition> foreign_key_definition foreign_key_with_reference_options foreign_key_without_options
+%type <checkDefinition> check_constraint_definition
+%type <exclusionDefinition> exclusion_constraint_definition
+%type <exclusionElement> exclusion_element
+%type <exclusionElements> exclusion_element_list
+%type <str> exclusion_using_opt exclusion_operator
 %type <colIdent> reference_option
 %type <colIdent> sql_id_opt
-%type <colIdents> sql_id_list
+%type <colIdents> sql_id_list include_columns_opt
 %type <str> index_or_key
 %type <str> equal_opt
 %type <TableSpec> table_spec table_column_list
 %type <str> table_option_list table_option table_opt_value
 %type <indexInfo> index_info
-%type <indexColumn> index_column
+%type <indexColumn> index_column index_column_without_collate
 %type <indexColumns> index_column_list
 %type <indexOption> index_option
-%type <indexOptions> index_option_list mssql_index_option_list
+%type <indexOptions> index_option_list mssql_index_option_list index_using_opt
 %type <partDefs> partition_definitions
 %type <partDef> partition_definition
 %type <partSpec> partition_operation
@@ -316,14 +342,14 @@ func forceEOF(yylex interface{}) {
 %type <vindexParams> vindex_param_list vindex_params_opt
 %type <colIdent> vindex_type vindex_type_opt
 %type <bytes> alter_object_type
-%type <bytes> policy_as_opt policy_for_opt character_cast_opt
+%type <bytes> policy_as_opt policy_for_opt character_cast_opt generated_kind_opt
 %type <expr> using_opt with_check_opt
 %left <bytes> TYPECAST CHECK
 %type <bytes> or_replace_opt
-%type <boolVal> no_inherit_opt
+%type <boolVal> no_inherit_opt deferrable_opt initially_deferred_opt
 %type <str> identity_behavior
 %type <sequence> sequence_opt
-%type <boolVal> clustered_opt
+%type <boolVal> clustered_opt nonclustered_opt
 %type <optVal> default_definition
 %type <optVal> on_off
 
@@ -362,6 +388,7 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| comment_statement
 | other_statement
 
 select_statement:
@@ -569,7 +596,7 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
-| CREATE unique_opt INDEX sql_id ON table_name '(' index_column_list ')' where_expression_opt
+| CREATE unique_opt INDEX sql_id ON table_name '(' index_column_list ')' include_columns_opt where_expression_opt
   {
     $$ = &DDL{
         Action: CreateIndexStr,
@@ -579,7 +606,8 @@ create_statement:
           Name: $4,
           Type: NewColIdent(""),
           Unique: bool($2),
-          Where: NewWhere(WhereStr, $10),
+          Include: $10,
+          Where: NewWhere(WhereStr, $11),
         },
         IndexCols: $8,
       }
@@ -600,7 +628,7 @@ create_statement:
       }
   }
 /* For PostgreSQL */
-| CREATE unique_opt INDEX sql_id ON table_name USING sql_id '(' index_column_list ')' where_expression_opt
+| CREATE unique_opt INDEX sql_id ON table_name USING sql_id '(' index_column_list ')' include_columns_opt where_expression_opt
   {
     $$ = &DDL{
         Action: CreateIndexStr,
@@ -610,11 +638,30 @@ create_statement:
           Name: $4,
           Type: $8,
           Unique: bool($2),
-          Where: NewWhere(WhereStr, $12),
+          Include: $12,
+          Where: NewWhere(WhereStr, $13),
         },
         IndexCols: $10,
       }
   }
+/* For PostgreSQL */
+| CREATE ID table_name AS column_definition_type
+  {
+    // TYPE and DOMAIN are not keywords to keep them available as column names
+    switch NewColIdent(string($2)).Lowered() {
+    case "type":
+      if $5.Type != "enum" {
+        yylex.Error("syntax error")
+        return 1
+      }
+      $$ = &DDL{Action: CreateTypeStr, Type: &Type{Name: $3, EnumValues: $5.EnumValues}}
+    case "domain":
+      $$ = &DDL{Action: CreateDomainStr, Domain: &Domain{Name: $3, Type: $5}}
+    default:
+      yylex.Error("syntax error")
+      return 1
+    }
+  }
 | CREATE or_replace_opt VIEW table_name AS select_statement
   {
     $$ = &DDL{Action: CreateViewStr, View: &View{
@@ -623,6 +670,16 @@ create_statement:
         Definition: $6,
     }}
   }
+/* For PostgreSQL */
+| CREATE MATERIALIZED VIEW table_name AS select_statement
+  {
+    $$ = &DDL{Action: CreateViewStr, View: &View{
+        Action: CreateViewStr,
+        Name: $4.ToViewName(),
+        Definition: $6,
+        Materialized: true,
+    }}
+  }
 | CREATE VINDEX sql_id vindex_type_opt vindex_params_opt
   {
     $$ = &DDL{Action: CreateVindexStr, VindexSpec: &VindexSpec{
@@ -780,6 +837,25 @@ table_spec:
     $$ = $2
     $$.Options = $4
   }
+/* For PostgreSQL storage parameters, followed by options like TABLESPACE */
+| '(' table_column_list ')' WITH '(' mssql_index_option_list ')' table_option_list
+  {
+    $$ = $2
+    $$.StorageParameters = $6
+    $$.Options = $8
+  }
+/* For PostgreSQL */
+| '(' table_column_list ')' PARTITION BY sql_id openb expression_list closeb
+  {
+    $$ = $2
+    $$.PartitionBy = &PartitionBy{Type: $6.Lowered(), Exprs: $8}
+  }
+/* For PostgreSQL */
+| '(' table_column_list ')' INHERITS openb table_name_list closeb
+  {
+    $$ = $2
+    $$.Inherits = $6
+  }
 
 table_column_list:
   column_definition
@@ -803,12 +879,29 @@ table_column_list:
   {
     $$.AddIndex($3)
   }
+| table_column_list ',' unique_constraint_definition
+  {
+    $$.AddIndex($3)
+  }
+| table_column_list ',' check_constraint_definition
+  {
+    $$.AddCheck($3)
+  }
+| table_column_list ',' exclusion_constraint_definition
+  {
+    $$.AddExclusion($3)
+  }
 
 column_definition:
   ID column_definition_type
   {
     $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
   }
+/* For SQL Server computed columns */
+| ID AS openb expression closeb generated_kind_opt
+  {
+    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: ColumnType{Generated: &GeneratedColumn{Expr: $4, Type: string($6)}}}
+  }
 column_type:
   numeric_type unsigned_opt zero_fill_opt
   {
@@ -820,6 +913,16 @@ column_type:
 | char_type
 | time_type
 | spatial_type
+/* For PostgreSQL user-defined types */
+| ID
+  {
+    if yylex.(*Tokenizer).mode != ParserModePostgres {
+      yylex.Error("syntax error")
+      return 1
+    }
+    $$ = ColumnType{Type: string($1)}
+  }
+
 column_definition_type:
   column_type array_opt
   {
@@ -853,6 +956,17 @@ column_definition_type:
     $1.Default = &DefaultDefinition{ConstraintName: $3, Value: $4}
     $$ = $1
   }
+| column_definition_type DEFAULT openb expression closeb
+  {
+    $1.Default = &DefaultDefinition{Expr: $4}
+    $$ = $1
+  }
+/* For PostgreSQL, like `DEFAULT nextval('users_id_seq'::regclass)` */
+| column_definition_type DEFAULT ID openb select_expression_list_opt closeb
+  {
+    $1.Default = &DefaultDefinition{Expr: &FuncExpr{Name: NewColIdent(string($3)), Exprs: $5}}
+    $$ = $1
+  }
 | column_definition_type ON UPDATE current_timestamp
   {
     $1.OnUpdate = $4
@@ -891,17 +1005,20 @@ column_definition_type:
 | column_definition_type CHECK openb expression closeb no_inherit_opt
   {
     $1.Check = &CheckDefinition{Where: *NewWhere(WhereStr, $4)}
+    $1.Checks = append($1.Checks, $1.Check)
     $1.CheckNoInherit = $6
     $$ = $1
   }
-| column_definition_type CONSTRAINT sql_id CHECK openb expression closeb
+| column_definition_type CONSTRAINT sql_id CHECK openb expression closeb no_inherit_opt
   {
     $1.Check = &CheckDefinition{Where: *NewWhere(WhereStr, $6), ConstraintName: $3}
+    $1.Checks = append($1.Checks, $1.Check)
+    $1.CheckNoInherit = $8
     $$ = $1
   }
 | column_definition_type COMMENT_KEYWORD STRING
   {
-    $1.Comment = NewStrVal($3)
+    $1.Comment = newStringVal(yylex, $3)
     $$ = $1
   }
 | column_definition_type REFERENCES table_id
@@ -932,11 +1049,29 @@ column_definition_type:
     $1.Identity = &IdentityOpt{Sequence: &Sequence{StartWith: NewIntVal($4), IncrementBy: NewIntVal($6)}}
     $$ = $1
   }
+| column_definition_type GENERATED identity_behavior AS openb expression closeb generated_kind_opt
+  {
+    $1.Generated = &GeneratedColumn{Expr: $6, Type: string($8)}
+    $$ = $1
+  }
+| column_definition_type AS openb expression closeb generated_kind_opt
+  {
+    $1.Generated = &GeneratedColumn{Expr: $4, Type: string($6)}
+    $$ = $1
+  }
+
+generated_kind_opt:
+  {
+    $$ = nil
+  }
+| STORED
+| VIRTUAL
+| PERSISTED
 
 default_definition:
   DEFAULT STRING character_cast_opt
   {
-    $$ = NewStrVal($2)
+    $$ = newStringVal(yylex, $2)
   }
 | DEFAULT INTEGRAL
   {
@@ -1082,10 +1217,8 @@ character_cast_opt:
   {
     $$ = nil
   }
-| TYPECAST CHARACTER VARYING
-| TYPECAST TIMESTAMP time_zone_opt
+| TYPECAST column_type array_opt
 | TYPECAST BPCHAR
-| TYPECAST TEXT
 
 numeric_type:
   int_type length_opt
@@ -1199,6 +1332,10 @@ time_type:
   {
     $$ = ColumnType{Type: string($1), Length: $2, Timezone: $3}
   }
+| TIMESTAMPTZ length_opt
+  {
+    $$ = ColumnType{Type: "timestamp", Length: $2, Timezone: BoolVal(true)}
+  }
 | DATETIME length_opt
   {
     $$ = ColumnType{Type: string($1), Length: $2}
@@ -1480,17 +1617,37 @@ collate_opt:
   }
 
 index_definition:
-  index_info '(' index_column_list ')' index_option_list
+  index_info index_using_opt '(' index_column_list ')' include_columns_opt index_option_list
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $4, Include: $6, Options: append($2, $7...)}
+  }
+| index_info index_using_opt '(' index_column_list ')' include_columns_opt WITH '(' mssql_index_option_list ')'
+  {
+    $$ = &IndexDefinition{Info: $1, Columns: $4, Include: $6, Options: append($2, $9...)}
+  }
+| index_info index_using_opt '(' index_column_list ')' include_columns_opt
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5}
+    $$ = &IndexDefinition{Info: $1, Columns: $4, Include: $6, Options: $2}
   }
-| index_info '(' index_column_list ')' WITH '(' mssql_index_option_list ')'
+
+/* For MySQL `USING BTREE` before key parts. It's formatted as an index option after them. */
+index_using_opt:
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $7}
+    $$ = nil
   }
-| index_info '(' index_column_list ')'
+| USING ID
   {
-    $$ = &IndexDefinition{Info: $1, Columns: $3}
+    $$ = []*IndexOption{&IndexOption{Name: string($1), Using: string($2)}}
+  }
+
+/* For PostgreSQL and SQL Server */
+include_columns_opt:
+  {
+    $$ = nil
+  }
+| INCLUDE '(' sql_id_list ')'
+  {
+    $$ = $3
   }
 
 index_option_list:
@@ -1525,7 +1682,7 @@ index_option:
   }
 | COMMENT_KEYWORD STRING
   {
-    $$ = &IndexOption{Name: string($1), Value: NewStrVal($2)}
+    $$ = &IndexOption{Name: string($1), Value: newStringVal(yylex, $2)}
   }
 | WITH PARSER sql_id
   {
@@ -1559,6 +1716,27 @@ index_option:
   {
     $$ = &IndexOption{Name: string($1), Value: $3}
   }
+/* For PostgreSQL storage parameters */
+| ID '=' INTEGRAL
+  {
+    $$ = &IndexOption{Name: string($1), Value: NewIntVal($3)}
+  }
+| ID '=' on_off
+  {
+    $$ = &IndexOption{Name: string($1), Value: $3}
+  }
+| ID '=' boolean_value
+  {
+    $$ = &IndexOption{Name: string($1), Value: NewBoolSQLVal(bool($3))}
+  }
+| ID '=' FLOAT
+  {
+    $$ = &IndexOption{Name: string($1), Value: NewFloatVal($3)}
+  }
+| ID '=' STRING
+  {
+    $$ = &IndexOption{Name: string($1), Value: newStringVal(yylex, $3)}
+  }
 
 equal_opt:
   /* empty */
@@ -1635,17 +1813,44 @@ index_column_list:
   }
 
 index_column:
-  sql_id length_opt
+  index_column_without_collate
+/* For PostgreSQL */
+| index_column_without_collate COLLATE charset
+  {
+    $1.Collate = $3
+    $$ = $1
+  }
+
+index_column_without_collate:
+  sql_id
+  {
+      $$ = IndexColumn{Column: $1}
+  }
+/* `name(10)` is a prefix length. Parsed together with a function to avoid a conflict. */
+| function_call_generic
   {
-      $$ = IndexColumn{Column: $1, Length: $2}
+    $$ = newIndexColumn($1)
   }
 /* For PostgreSQL */
 | KEY length_opt
   {
     $$ = IndexColumn{Column: NewColIdent(string($1)), Length: $2}
   }
+/* For functional indexes of MySQL 8 and PostgreSQL */
+| openb expression closeb
+  {
+    $$ = IndexColumn{Expression: $2}
+  }
 
 foreign_key_definition:
+  foreign_key_with_reference_options deferrable_opt initially_deferred_opt
+  {
+    $1.Deferrable = $2
+    $1.InitiallyDeferred = $3
+    $$ = $1
+  }
+
+foreign_key_with_reference_options:
   foreign_key_without_options
 | foreign_key_without_options ON DELETE reference_option
   {
@@ -1679,11 +1884,38 @@ foreign_key_without_options:
       ConstraintName: $2,
       IndexName: $5,
       IndexColumns: $7,
-      ReferenceName: NewColIdent($10.Name.String()),
+      ReferenceName: NewColIdent($10.QualifiedName()),
       ReferenceColumns: $12,
     }
   }
 
+/* For PostgreSQL */
+deferrable_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| DEFERRABLE
+  {
+    $$ = BoolVal(true)
+  }
+| NOT DEFERRABLE
+  {
+    $$ = BoolVal(false)
+  }
+
+initially_deferred_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| INITIALLY DEFERRED
+  {
+    $$ = BoolVal(true)
+  }
+| INITIALLY IMMEDIATE
+  {
+    $$ = BoolVal(false)
+  }
+
 reference_option:
   RESTRICT
   {
@@ -1697,24 +1929,109 @@ reference_option:
   {
     $$ = NewColIdent("SET NULL")
   }
+| SET DEFAULT
+  {
+    $$ = NewColIdent("SET DEFAULT")
+  }
 | NO ACTION
   {
     $$ = NewColIdent("NO ACTION")
   }
 
 primary_key_definition:
-  CONSTRAINT sql_id PRIMARY KEY clustered_opt '(' index_column_list ')'
+  CONSTRAINT sql_id PRIMARY KEY clustered_opt index_using_opt '(' index_column_list ')'
   {
     $$ = &IndexDefinition{
       Info: &IndexInfo{Type: string($3) + " " + string($4), Name: $2, Primary: true, Unique: true, Clustered: $5},
-      Columns: $7,
+      Columns: $8, Options: $6,
     }
   }
-| CONSTRAINT sql_id PRIMARY KEY clustered_opt '(' index_column_list ')'  WITH '(' mssql_index_option_list ')'
+| CONSTRAINT sql_id PRIMARY KEY clustered_opt index_using_opt '(' index_column_list ')'  WITH '(' mssql_index_option_list ')'
   {
     $$ = &IndexDefinition{
       Info: &IndexInfo{Type: string($3) + " " + string($4), Name: $2, Primary: true, Unique: true, Clustered: $5},
-      Columns: $7, Options: $11,
+      Columns: $8, Options: append($6, $12...),
+    }
+  }
+/* For MySQL */
+| CONSTRAINT sql_id PRIMARY KEY clustered_opt index_using_opt '(' index_column_list ')' index_option_list
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($3) + " " + string($4), Name: $2, Primary: true, Unique: true, Clustered: $5},
+      Columns: $8, Options: append($6, $10...),
+    }
+  }
+
+check_constraint_definition:
+  CONSTRAINT sql_id CHECK openb expression closeb no_inherit_opt
+  {
+    $$ = &CheckDefinition{Where: *NewWhere(WhereStr, $5), ConstraintName: $2, NoInherit: $7}
+  }
+| CHECK openb expression closeb no_inherit_opt
+  {
+    $$ = &CheckDefinition{Where: *NewWhere(WhereStr, $3), NoInherit: $5}
+  }
+
+/* For PostgreSQL */
+exclusion_constraint_definition:
+  CONSTRAINT sql_id EXCLUDE exclusion_using_opt '(' exclusion_element_list ')' where_expression_opt
+  {
+    $$ = &ExclusionDefinition{ConstraintName: $2, IndexType: $4, Elements: $6, Where: $8}
+  }
+| EXCLUDE exclusion_using_opt '(' exclusion_element_list ')' where_expression_opt
+  {
+    $$ = &ExclusionDefinition{IndexType: $2, Elements: $4, Where: $6}
+  }
+
+exclusion_using_opt:
+  {
+    $$ = ""
+  }
+| USING ID
+  {
+    $$ = string($2)
+  }
+
+exclusion_element_list:
+  exclusion_element
+  {
+    $$ = []ExclusionElement{$1}
+  }
+| exclusion_element_list ',' exclusion_element
+  {
+    $$ = append($1, $3)
+  }
+
+exclusion_element:
+  sql_id WITH exclusion_operator
+  {
+    $$ = ExclusionElement{Column: $1, Operator: $3}
+  }
+
+exclusion_operator:
+  compare
+  {
+    $$ = $1
+  }
+| AND
+  {
+    $$ = "&&"
+  }
+
+/* For SQL Server */
+unique_constraint_definition:
+  CONSTRAINT sql_id UNIQUE nonclustered_opt '(' index_column_list ')'
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($3), Name: $2, Unique: true, Constraint: true, Clustered: $4},
+      Columns: $6,
+    }
+  }
+| CONSTRAINT sql_id UNIQUE nonclustered_opt '(' index_column_list ')' WITH '(' mssql_index_option_list ')'
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($3), Name: $2, Unique: true, Constraint: true, Clustered: $4},
+      Columns: $6, Options: $10,
     }
   }
 
@@ -1732,6 +2049,20 @@ clustered_opt:
     $$ = BoolVal(false)
   }
 
+/* For SQL Server, where UNIQUE constraints are nonclustered by default */
+nonclustered_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| CLUSTERED
+  {
+    $$ = BoolVal(true)
+  }
+| NONCLUSTERED
+  {
+    $$ = BoolVal(false)
+  }
+
 sql_id_opt:
   {
     $$ = NewColIdent("")
@@ -1796,6 +2127,11 @@ alter_statement:
   {
     $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
   }
+/* For PostgreSQL */
+| ALTER ignore_opt TABLE table_name CLUSTER ON sql_id
+  {
+    $$ = &DDL{Action: ClusterOnStr, Table: $4, NewName: $4, IndexSpec: &IndexSpec{Name: $7}}
+  }
 | ALTER ignore_opt TABLE table_name ADD unique_opt alter_object_type_index sql_id '(' index_column_list ')'
   {
     $$ = &DDL{
@@ -1824,6 +2160,40 @@ alter_statement:
         IndexCols: $12,
       }
   }
+| ALTER ignore_opt TABLE table_name ADD PRIMARY KEY clustered_opt '(' index_column_list ')'
+  {
+    $$ = &DDL{
+        Action: AddPrimaryKeyStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: NewColIdent("PRIMARY"),
+          Unique: false,
+          Primary: true,
+          Clustered: $8,
+        },
+        IndexCols: $10,
+      }
+  }
+| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id PRIMARY KEY clustered_opt '(' index_column_list ')'
+  {
+    $$ = &DDL{
+        Action: AddPrimaryKeyStr,
+        Table: $4,
+        NewName: $4,
+        IndexSpec: &IndexSpec{
+          Name: $7,
+          Unique: false,
+          Primary: true,
+          Clustered: $10,
+        },
+        IndexCols: $12,
+      }
+  }
+| ALTER ignore_opt TABLE table_name ADD PRIMARY KEY force_eof
+  {
+    $$ = &DDL{Action: AlterStr, Table: $4, NewName: $4}
+  }
 | ALTER ignore_opt TABLE ONLY table_name ADD foreign_key_definition
   {
     $$ = &DDL{
@@ -2198,6 +2568,25 @@ rollback_statement:
     $$ = &Rollback{}
   }
 
+/* For PostgreSQL */
+comment_statement:
+  COMMENT_KEYWORD ON COLUMN column_name IS STRING
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4.Qualifier, ColumnComment: &ColumnComment{Column: $4.Name, Comment: newStringVal(yylex, $6)}}
+  }
+| COMMENT_KEYWORD ON COLUMN column_name IS NULL
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4.Qualifier, ColumnComment: &ColumnComment{Column: $4.Name}}
+  }
+| COMMENT_KEYWORD ON TABLE table_name IS STRING
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4, TableComment: &TableComment{Comment: newStringVal(yylex, $6)}}
+  }
+| COMMENT_KEYWORD ON TABLE table_name IS NULL
+  {
+    $$ = &DDL{Action: CommentStr, Table: $4, TableComment: &TableComment{}}
+  }
+
 other_statement:
   DESC force_eof
   {
@@ -2650,6 +3039,10 @@ condition:
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotLikeStr, Right: $4, Escape: $5}
   }
+| value_expression NOT_LIKE value_expression like_escape_opt
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: NotLikeStr, Right: $3, Escape: $4}
+  }
 | value_expression REGEXP value_expression
   {
     $$ = &ComparisonExpr{Left: $1, Operator: RegexpStr, Right: $3}
@@ -2658,6 +3051,10 @@ condition:
   {
     $$ = &ComparisonExpr{Left: $1, Operator: NotRegexpStr, Right: $4}
   }
+| value_expression '~' value_expression
+  {
+    $$ = &ComparisonExpr{Left: $1, Operator: PosixRegexpStr, Right: $3}
+  }
 | value_expression BETWEEN value_expression AND value_expression
   {
     $$ = &RangeCond{Left: $1, Operator: BetweenStr, From: $3, To: $5}
@@ -2895,7 +3292,7 @@ value_expression:
   }
 | value_expression TYPECAST simple_convert_type
   {
-    $$ = &ConvertExpr{Expr: $1, Type: $3}
+    $$ = &ConvertExpr{Expr: $1, Type: $3, TypeCast: true}
   }
 | function_call_generic
 | function_call_keyword
@@ -3179,6 +3576,11 @@ simple_convert_type:
   {
     $$ = &ConvertType{Type: string($1)}
   }
+/* For PostgreSQL, like `'users_id_seq'::regclass` */
+| ID
+  {
+    $$ = &ConvertType{Type: string($1)}
+  }
 
 expression_opt:
   {
@@ -3240,7 +3642,7 @@ column_name:
 value:
   STRING
   {
-    $$ = NewStrVal($1)
+    $$ = newStringVal(yylex, $1)
   }
 | HEX
   {
@@ -3533,7 +3935,7 @@ charset_value:
   }
 | STRING
   {
-    $$ = NewStrVal($1)
+    $$ = newStringVal(yylex, $1)
   }
 | DEFAULT
   {
@@ -3722,6 +4124,7 @@ reserved_keyword:
 | SEPARATOR
 | SET
 | SHOW
+| STORED
 | STRAIGHT_JOIN
 | TABLE
 | TABLES
@@ -3737,6 +4140,7 @@ reserved_keyword:
 | UTC_TIME
 | UTC_TIMESTAMP
 | VALUES
+| VIRTUAL
 | WHEN
 | WHERE
 | OFF
@@ -3774,6 +4178,7 @@ non_reserved_keyword:
 | DATETIME2
 | DATETIMEOFFSET
 | DECIMAL
+| DEFERRED
 | DOUBLE
 | DUPLICATE
 | ENUM
@@ -3783,7 +4188,12 @@ non_reserved_keyword:
 | GEOMETRY
 | GEOMETRYCOLLECTION
 | GLOBAL
+| IMMEDIATE
+| CLUSTER
+| EXCLUDE
+| INCLUDE
 | INHERIT
+| INHERITS
 | INT
 | INTEGER
 | ISOLATION
@@ -3797,6 +4207,7 @@ non_reserved_keyword:
 | LINESTRING
 | LONGBLOB
 | LONGTEXT
+| MATERIALIZED
 | MEDIUMBLOB
 | MEDIUMINT
 | MEDIUMTEXT
@@ -3819,6 +4230,7 @@ non_reserved_keyword:
 | POLYGON
 | PRECISION
 | PERMISSIVE
+| PERSISTED
 | PRIMARY
 | PROCEDURE
 | QUERY
@@ -3846,6 +4258,7 @@ non_reserved_keyword:
 | THAN
 | TIME
 | TIMESTAMP
+| TIMESTAMPTZ
 | TINYBLOB
 | TINYINT
 | TINYTEXT
@@ -132,6 +132,7 @@ var keywords = map[string]int{
 	"charset":                CHARSET,
 	"check":                  CHECK,
 	"citext":                 CITEXT,
+	"cluster":                CLUSTER,
 	"clustered":              CLUSTERED,
 	"nonclustered":           NONCLUSTERED,
 	"collate":                COLLATE,
@@ -167,6 +168,8 @@ var keywords = map[string]int{
 	"decimal":                DECIMAL,
 	"declare":                UNUSED,
 	"default":                DEFAULT,
+	"deferrable":             DEFERRABLE,
+	"deferred":               DEFERRED,
 	"delayed":                UNUSED,
 	"delete":                 DELETE,
 	"desc":                   DESC,
@@ -186,6 +189,7 @@ var keywords = map[string]int{
 	"enum":                   ENUM,
 	"escape":                 ESCAPE,
 	"escaped":                UNUSED,
+	"exclude":                EXCLUDE,
 	"exists":                 EXISTS,
 	"exit":                   UNUSED,
 	"explain":                EXPLAIN,
@@ -219,6 +223,7 @@ var keywords = map[string]int{
 	"if":                     IF,
 	"ignore":                 IGNORE,
 	"in":                     IN,
+	"include":                INCLUDE,
 	"increment":              INCREMENT,
 	"index":                  INDEX,
 	"infile":                 UNUSED,
@@ -239,6 +244,9 @@ var keywords = map[string]int{
 	"is":                     IS,
 	"isolation":              ISOLATION,
 	"inherit":                INHERIT,
+	"inherits":               INHERITS,
+	"initially":              INITIALLY,
+	"immediate":              IMMEDIATE,
 	"iterate":                UNUSED,
 	"join":                   JOIN,
 	"json":                   JSON,
@@ -270,6 +278,7 @@ var keywords = map[string]int{
 	"low_priority":           UNUSED,
 	"master_bind":            UNUSED,
 	"match":                  MATCH,
+	"materialized":           MATERIALIZED,
 	"maxvalue":               MAXVALUE,
 	"mediumblob":             MEDIUMBLOB,
 	"mediumint":              MEDIUMINT,
@@ -314,6 +323,7 @@ var keywords = map[string]int{
 	"parser":                 PARSER,
 	"partition":              PARTITION,
 	"permissive":             PERMISSIVE,
+	"persisted":              PERSISTED,
 	"point":                  POINT,
 	"policy":                 POLICY,
 	"polygon":                POLYGON,
@@ -353,6 +363,9 @@ var keywords = map[string]int{
 	"separator":              SEPARATOR,
 	"sequence":               UNUSED,
 	"serial":                 SERIAL,
+	"serial2":                SMALLSERIAL,
+	"serial4":                SERIAL,
+	"serial8":                BIGSERIAL,
 	"serializable":           SERIALIZABLE,
 	"session":                SESSION,
 	"set":                    SET,
@@ -379,7 +392,7 @@ var keywords = map[string]int{
 	"start":                  START,
 	"starting":               UNUSED,
 	"status":                 STATUS,
-	"stored":                 UNUSED,
+	"stored":                 STORED,
 	"straight_join":          STRAIGHT_JOIN,
 	"stream":                 STREAM,
 	"table":                  TABLE,
@@ -390,6 +403,7 @@ var keywords = map[string]int{
 	"then":                   THEN,
 	"time":                   TIME,
 	"timestamp":              TIMESTAMP,
+	"timestamptz":            TIMESTAMPTZ,
 	"tinyblob":               TINYBLOB,
 	"tinyint":                TINYINT,
 	"tinytext":               TINYTEXT,
@@ -419,7 +433,7 @@ var keywords = map[string]int{
 	"varchar":                VARCHAR,
 	"varcharacter":           UNUSED,
 	"varying":                VARYING,
-	"virtual":                UNUSED,
+	"virtual":                VIRTUAL,
 	"vindex":                 VINDEX,
 	"vindexes":               VINDEXES,
 	"view":                   VIEW,
@@ -561,6 +575,10 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 			if tkn.mode == ParserModeMssql && ch == '[' {
 				return tkn.scanLiteralIdentifier(']')
 			}
+			if ch == '~' && tkn.lastChar == '~' { // PostgreSQL shows LIKE as ~~
+				tkn.next()
+				return LIKE, []byte("~~")
+			}
 			return int(ch), nil
 		case '&':
 			if tkn.lastChar == '&' {
@@ -652,9 +670,17 @@ func (tkn *Tokenizer) Scan() (int, []byte) {
 				return int(ch), nil
 			}
 		case '!':
-			if tkn.lastChar == '=' {
+			switch tkn.lastChar {
+			case '=':
 				tkn.next()
 				return NE, nil
+			case '~': // PostgreSQL shows NOT LIKE as !~~
+				tkn.next()
+				if tkn.lastChar != '~' {
+					return LEX_ERROR, []byte("!~")
+				}
+				tkn.next()
+				return NOT_LIKE, []byte("!~~")
 			}
 			return int(ch), nil
 		case '\'':
@@ -703,6 +729,11 @@ func (tkn *Tokenizer) scanIdentifier(firstByte byte, isDbSystemVariable bool) (i
 	if keywordID, found := keywords[loweredStr]; found {
 		return keywordID, lowered
 	}
+	// MySQL shows a string with its charset introducer like _utf8mb4'str', which doesn't change the string
+	if tkn.mode == ParserModeMysql && firstByte == '_' && tkn.lastChar == '\'' {
+		tkn.next()
+		return tkn.scanString('\'', STRING)
+	}
 	// dual must always be case-insensitive
 	if loweredStr == "dual" {
 		return ID, lowered
@@ -844,6 +875,11 @@ exit:
 }
 
 func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
+	// PostgreSQL doesn't escape a backslash in a standard string, e.g. '\x1234' of bytea
+	var escape uint16 = '\\'
+	if tkn.mode == ParserModePostgres {
+		escape = eofChar
+	}
 	var buffer bytes2.Buffer
 	for {
 		ch := tkn.lastChar
@@ -852,14 +888,14 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 			return LEX_ERROR, buffer.Bytes()
 		}
 
-		if ch != delim && ch != '\\' {
+		if ch != delim && ch != escape {
 			buffer.WriteByte(byte(ch))
 
 			// Scan ahead to the next interesting character.
 			start := tkn.bufPos
 			for ; tkn.bufPos < tkn.bufSize; tkn.bufPos++ {
 				ch = uint16(tkn.buf[tkn.bufPos])
-				if ch == delim || ch == '\\' {
+				if ch == delim || ch == escape {
 					break
 				}
 			}
@@ -879,7 +915,7 @@ func (tkn *Tokenizer) scanString(delim uint16, typ int) (int, []byte) {
 		}
 		tkn.next() // Read one past the delim or escape character.
 
-		if ch == '\\' {
+		if ch == escape {
 			if tkn.lastChar == eofChar {
 				// String terminates mid escape character.
 				return LEX_ERROR, buffer.Bytes()
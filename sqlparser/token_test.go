@@ -137,6 +137,33 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestPostgresString(t *testing.T) {
+	testcases := []struct {
+		in   string
+		id   int
+		want string
+	}{{
+		in:   "'\\x1234'",
+		id:   STRING,
+		want: "\\x1234",
+	}, {
+		in:   "'a''b'",
+		id:   STRING,
+		want: "a'b",
+	}, {
+		in:   "'hello\\'",
+		id:   STRING,
+		want: "hello\\",
+	}}
+
+	for _, tcase := range testcases {
+		id, got := NewStringTokenizer(tcase.in, ParserModePostgres).Scan()
+		if tcase.id != id || string(got) != tcase.want {
+			t.Errorf("Scan(%q) = (%s, %q), want (%s, %q)", tcase.in, tokenName(id), got, tokenName(tcase.id), tcase.want)
+		}
+	}
+}
+
 func TestSplitStatement(t *testing.T) {
 	testcases := []struct {
 		in  string